@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"time"
+
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/rs/zerolog"
+	config "github.com/samwang0723/jarvis/configs"
+	"github.com/samwang0723/jarvis/internal/app/adapter"
+	"github.com/samwang0723/jarvis/internal/app/adapter/sqlc"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+	"github.com/samwang0723/jarvis/internal/app/services"
+	"github.com/samwang0723/jarvis/internal/db/pginit"
+	"github.com/samwang0723/jarvis/internal/helper"
+)
+
+func main() {
+	req := &dto.RunBacktestRequest{}
+	var quantity uint64
+	var holdingDays int
+	var stopLoss, takeProfit, capital float64
+
+	flag.StringVar(&req.StartDate, "start", "", "first selection date (YYYYMMDD)")
+	flag.StringVar(&req.EndDate, "end", helper.Today(), "last exchange date (YYYYMMDD)")
+	flag.StringVar(&req.Strategy, "strategy", "", "selection strategy name, empty for the default")
+	flag.BoolVar(&req.Strict, "strict", false, "apply the strict predicates of the strategy")
+	flag.BoolVar(&req.Adjusted, "adjusted", false, "screen on dividend and split adjusted prices")
+	flag.IntVar(&holdingDays, "holding-days", 0, "exchange days to hold a position")
+	flag.Float64Var(&stopLoss, "stop-loss", 0, "stop-loss percentage, 0 disables")
+	flag.Float64Var(&takeProfit, "take-profit", 0, "take-profit percentage, 0 disables")
+	flag.Uint64Var(&quantity, "quantity", 0, "lots bought per pick")
+	flag.Float64Var(&capital, "capital", 0, "initial capital")
+	flag.Parse()
+
+	req.HoldingDays = int32(holdingDays)
+	req.StopLoss = float32(stopLoss)
+	req.TakeProfit = float32(takeProfit)
+	req.Quantity = quantity
+	req.InitialCapital = float32(capital)
+
+	config.Load()
+	cfg := config.GetCurrentConfig()
+	zerolog.TimestampFieldName = "t"
+	logger := zerolog.New(os.Stderr).With().Str("app", "backtest").Timestamp().Logger()
+
+	var err error
+	time.Local, err = time.LoadLocation(helper.TimeZone)
+	if err != nil {
+		logger.Error().Msgf("error loading location '%s': %v\n", helper.TimeZone, err)
+	}
+
+	ctx := logger.WithContext(context.Background())
+
+	pgi, err := pginit.New(&pginit.Config{
+		User:         cfg.Database.User,
+		Password:     cfg.Database.Password,
+		Host:         cfg.Database.Host,
+		Port:         cfg.Database.Port,
+		Database:     cfg.Database.Database,
+		MaxConns:     int32(cfg.Database.MaxOpenConns),
+		MaxIdleConns: int32(cfg.Database.MaxIdleConns),
+		MaxLifeTime:  time.Duration(cfg.Database.MaxLifetime) * time.Second,
+	},
+		pginit.WithLogLevel(zerolog.WarnLevel),
+		pginit.WithLogger(&logger, "request-id"),
+		pginit.WithUUIDType(),
+		pginit.WithDecimalType(),
+	)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("could not init database")
+	}
+
+	pool, err := pgi.ConnPool(ctx)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("unable to create connection pool")
+	}
+	defer pool.Close()
+
+	dataService := services.New(
+		services.WithDAL(adapter.NewAdapterImp(sqlc.NewSqlcRepository(pool, &logger))),
+		services.WithLogger(&logger),
+	)
+
+	report, err := dataService.RunBacktest(ctx, req)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("backtest failed")
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		logger.Fatal().Err(err).Msg("unable to encode report")
+	}
+}
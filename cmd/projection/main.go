@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"time"
+
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/rs/zerolog"
+	config "github.com/samwang0723/jarvis/configs"
+	"github.com/samwang0723/jarvis/internal/app/adapter/sqlc"
+	"github.com/samwang0723/jarvis/internal/db/pginit"
+	"github.com/samwang0723/jarvis/internal/helper"
+)
+
+func main() {
+	var name string
+	var replay bool
+
+	flag.StringVar(&name, "rebuild", "", "projection to rebuild from the first event, positions or daily_profit_loss")
+	flag.BoolVar(&replay, "replay", true, "replay the recorded events instead of leaving them to the api")
+	flag.Parse()
+
+	config.Load()
+	cfg := config.GetCurrentConfig()
+	zerolog.TimestampFieldName = "t"
+	logger := zerolog.New(os.Stderr).With().Str("app", "projection").Timestamp().Logger()
+
+	if name == "" {
+		logger.Fatal().Msg("projection name is required")
+	}
+
+	var err error
+	time.Local, err = time.LoadLocation(helper.TimeZone)
+	if err != nil {
+		logger.Error().Msgf("error loading location '%s': %v\n", helper.TimeZone, err)
+	}
+
+	ctx := logger.WithContext(context.Background())
+
+	pgi, err := pginit.New(&pginit.Config{
+		User:         cfg.Database.User,
+		Password:     cfg.Database.Password,
+		Host:         cfg.Database.Host,
+		Port:         cfg.Database.Port,
+		Database:     cfg.Database.Database,
+		MaxConns:     int32(cfg.Database.MaxOpenConns),
+		MaxIdleConns: int32(cfg.Database.MaxIdleConns),
+		MaxLifeTime:  time.Duration(cfg.Database.MaxLifetime) * time.Second,
+	},
+		pginit.WithLogLevel(zerolog.WarnLevel),
+		pginit.WithLogger(&logger, "request-id"),
+		pginit.WithUUIDType(),
+		pginit.WithDecimalType(),
+	)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("could not init database")
+	}
+
+	pool, err := pgi.ConnPool(ctx)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("unable to create connection pool")
+	}
+	defer pool.Close()
+
+	repo := sqlc.NewSqlcRepository(pool, &logger, sqlc.WithReadModels())
+
+	if err = repo.RebuildProjection(ctx, name); err != nil {
+		logger.Fatal().Err(err).Msg("rebuild failed")
+	}
+	logger.Info().Str("projection", name).Msg("projection reset")
+
+	if !replay {
+		return
+	}
+
+	// a running api may hold the projection, it replays the events itself then
+	projected, err := repo.CatchUpProjection(ctx, name)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("replay failed")
+	}
+	logger.Info().Str("projection", name).Int("events", projected).Msg("projection replayed")
+}
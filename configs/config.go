@@ -27,6 +27,8 @@ const (
 	DbPassword    = "DB_PASSWD"
 	RedisPassword = "REDIS_PASSWD"
 	SmartProxy    = "SMART_PROXY"
+	QuoteReplay   = "QUOTE_REPLAY_FILE"
+	SMTPPassword  = "SMTP_PASSWD"
 	JwtSecret     = "JWT_SECRET"
 	Recaptcha     = "RECAPTCHA_SECRET"
 	EnvCoreKey    = "ENVIRONMENT"
@@ -61,6 +63,16 @@ type Config struct {
 		Password      string   `yaml:"password"`
 		SentinelAddrs []string `yaml:"sentinelAddrs"`
 	} `yaml:"redis"`
+	SMTP struct {
+		Host     string `yaml:"host"`
+		Username string `yaml:"username"`
+		Password string `yaml:"password"`
+		From     string `yaml:"from"`
+		Port     int    `yaml:"port"`
+	} `yaml:"smtp"`
+	Quote struct {
+		ReplayFile string `yaml:"replayFile"`
+	} `yaml:"quote"`
 	Server struct {
 		Name     string `yaml:"name"`
 		Host     string `yaml:"host"`
@@ -180,6 +192,14 @@ func Load() {
 		instance.RedisCache.Password = rdpasswd
 	}
 
+	if smtpPasswd := os.Getenv(SMTPPassword); smtpPasswd != "" {
+		instance.SMTP.Password = smtpPasswd
+	}
+
+	if replay := os.Getenv(QuoteReplay); replay != "" {
+		instance.Quote.ReplayFile = replay
+	}
+
 	if jwtsecret := os.Getenv(JwtSecret); jwtsecret != "" {
 		instance.JwtSecret = jwtsecret
 	} else {
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS screens;
+DROP INDEX IF EXISTS idx_unique_active_screen_name_per_user;
+DROP TRIGGER IF EXISTS update_screens_updated_at ON screens CASCADE;
@@ -0,0 +1,23 @@
+BEGIN;
+
+CREATE TABLE screens (
+    id uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    user_id uuid NOT NULL,
+    name varchar(64) NOT NULL,
+    expression text NOT NULL,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at timestamp NULL,
+    CONSTRAINT fk_user_id FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+CREATE UNIQUE INDEX idx_unique_active_screen_name_per_user
+ON screens (user_id, name)
+WHERE deleted_at IS NULL;
+
+CREATE TRIGGER update_screens_updated_at
+BEFORE UPDATE ON screens
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+COMMIT;
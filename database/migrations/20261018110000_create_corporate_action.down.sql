@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS corporate_actions;
+DROP INDEX IF EXISTS idx_corporate_actions_ex_date;
+DROP TRIGGER IF EXISTS update_corporate_actions_updated_at ON corporate_actions CASCADE;
@@ -0,0 +1,25 @@
+BEGIN;
+
+CREATE TABLE corporate_actions (
+    id uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    stock_id varchar(8) NOT NULL,
+    ex_date varchar(32) NOT NULL,
+    cash_dividend numeric(10,4) NOT NULL DEFAULT 0,
+    stock_dividend numeric(10,6) NOT NULL DEFAULT 0,
+    rights_ratio numeric(10,6) NOT NULL DEFAULT 0,
+    rights_price numeric(10,4) NOT NULL DEFAULT 0,
+    split_ratio numeric(10,6) NOT NULL DEFAULT 0,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at timestamp NULL,
+    UNIQUE (stock_id, ex_date)
+);
+
+CREATE INDEX idx_corporate_actions_ex_date ON corporate_actions (ex_date);
+
+CREATE TRIGGER update_corporate_actions_updated_at
+BEFORE UPDATE ON corporate_actions
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+COMMIT;
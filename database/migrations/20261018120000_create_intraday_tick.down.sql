@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS intraday_ticks;
+DROP INDEX IF EXISTS idx_intraday_ticks_exchange_date;
+DROP TRIGGER IF EXISTS update_intraday_ticks_updated_at ON intraday_ticks CASCADE;
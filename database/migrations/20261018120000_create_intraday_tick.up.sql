@@ -0,0 +1,26 @@
+BEGIN;
+
+CREATE TABLE intraday_ticks (
+    id uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    stock_id varchar(8) NOT NULL,
+    exchange_date varchar(32) NOT NULL,
+    tick_time varchar(16) NOT NULL,
+    open numeric(8,2) NOT NULL,
+    high numeric(8,2) NOT NULL,
+    low numeric(8,2) NOT NULL,
+    close numeric(8,2) NOT NULL,
+    volume bigint NOT NULL,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at timestamp NULL,
+    UNIQUE (stock_id, exchange_date, tick_time)
+);
+
+CREATE INDEX idx_intraday_ticks_exchange_date ON intraday_ticks (exchange_date);
+
+CREATE TRIGGER update_intraday_ticks_updated_at
+BEFORE UPDATE ON intraday_ticks
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+COMMIT;
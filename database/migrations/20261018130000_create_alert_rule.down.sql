@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS alert_rules;
+DROP INDEX IF EXISTS idx_alert_rules_user_id;
+DROP INDEX IF EXISTS idx_alert_rules_stock_id;
+DROP TRIGGER IF EXISTS update_alert_rules_updated_at ON alert_rules CASCADE;
@@ -0,0 +1,26 @@
+BEGIN;
+
+CREATE TABLE alert_rules (
+    id uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    user_id uuid NOT NULL,
+    stock_id varchar(8) NOT NULL,
+    condition varchar(32) NOT NULL,
+    threshold numeric(10,4) NOT NULL DEFAULT 0,
+    channel varchar(16) NOT NULL,
+    target varchar(256) NOT NULL,
+    last_triggered_date varchar(32) NOT NULL DEFAULT '',
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at timestamp NULL,
+    CONSTRAINT fk_user_id FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+CREATE INDEX idx_alert_rules_user_id ON alert_rules (user_id);
+CREATE INDEX idx_alert_rules_stock_id ON alert_rules (stock_id) WHERE deleted_at IS NULL;
+
+CREATE TRIGGER update_alert_rules_updated_at
+BEFORE UPDATE ON alert_rules
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+COMMIT;
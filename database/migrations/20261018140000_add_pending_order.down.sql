@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_order_pending_stock_id;
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS kind,
+    DROP COLUMN IF EXISTS side,
+    DROP COLUMN IF EXISTS quantity,
+    DROP COLUMN IF EXISTS limit_price,
+    DROP COLUMN IF EXISTS stop_price,
+    DROP COLUMN IF EXISTS position_id,
+    DROP COLUMN IF EXISTS placed_exchange_date,
+    DROP COLUMN IF EXISTS filled_price,
+    DROP COLUMN IF EXISTS filled_exchange_date;
@@ -0,0 +1,17 @@
+BEGIN;
+
+ALTER TABLE orders
+    ADD COLUMN kind varchar(16) NOT NULL DEFAULT 'market',
+    ADD COLUMN side varchar(8) NOT NULL DEFAULT '',
+    ADD COLUMN quantity bigint NOT NULL DEFAULT 0,
+    ADD COLUMN limit_price numeric(8, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN stop_price numeric(8, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN position_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+    ADD COLUMN placed_exchange_date varchar(32) NOT NULL DEFAULT '',
+    ADD COLUMN filled_price numeric(8, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN filled_exchange_date varchar(32) NOT NULL DEFAULT '';
+
+-- Create indexes
+CREATE INDEX idx_order_pending_stock_id ON orders(stock_id) WHERE status IN ('pending', 'triggered');
+
+COMMIT;
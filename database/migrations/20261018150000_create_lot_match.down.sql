@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS lot_matches;
+DROP INDEX IF EXISTS idx_lot_matches_order_id;
+DROP INDEX IF EXISTS idx_lot_matches_user_id;
+DROP TRIGGER IF EXISTS update_lot_matches_updated_at ON lot_matches CASCADE;
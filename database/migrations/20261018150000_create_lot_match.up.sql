@@ -0,0 +1,29 @@
+BEGIN;
+
+CREATE TABLE lot_matches (
+    id uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    user_id uuid NOT NULL,
+    order_id uuid NOT NULL,
+    stock_id varchar(8) NOT NULL,
+    order_type varchar(8) NOT NULL,
+    method varchar(16) NOT NULL,
+    exchange_date varchar(32) NOT NULL,
+    price numeric(8, 2) NOT NULL,
+    cost_price numeric(8, 2) NOT NULL,
+    quantity bigint NOT NULL,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at timestamp NULL,
+    CONSTRAINT fk_order_id FOREIGN KEY (order_id) REFERENCES orders (id),
+    CONSTRAINT fk_user_id FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+CREATE INDEX idx_lot_matches_order_id ON lot_matches (order_id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_lot_matches_user_id ON lot_matches (user_id);
+
+CREATE TRIGGER update_lot_matches_updated_at
+BEFORE UPDATE ON lot_matches
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+COMMIT;
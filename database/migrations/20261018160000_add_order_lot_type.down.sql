@@ -0,0 +1,17 @@
+UPDATE order_events
+SET payload = payload - 'LotType'
+WHERE event_type IN ('order.created', 'order.placed');
+UPDATE order_events
+SET payload = payload || jsonb_build_object('FillQuantity', (payload->>'FillQuantity')::bigint / 1000)
+WHERE payload ? 'FillQuantity';
+UPDATE order_events
+SET payload = payload || jsonb_build_object('Quantity', (payload->>'Quantity')::bigint / 1000)
+WHERE payload ? 'Quantity';
+UPDATE lot_matches
+SET quantity = quantity / 1000;
+UPDATE orders
+SET buy_quantity = buy_quantity / 1000,
+    sell_quantity = sell_quantity / 1000,
+    quantity = quantity / 1000;
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS lot_type;
@@ -0,0 +1,29 @@
+BEGIN;
+
+ALTER TABLE orders
+    ADD COLUMN lot_type varchar(16) NOT NULL DEFAULT 'board';
+
+-- Quantities are counted in shares rather than board lots
+UPDATE orders
+SET buy_quantity = buy_quantity * 1000,
+    sell_quantity = sell_quantity * 1000,
+    quantity = quantity * 1000;
+
+UPDATE lot_matches
+SET quantity = quantity * 1000;
+
+-- The recorded events are replayed into the orders, so their payloads are
+-- converted as well and the orders they open are board lots
+UPDATE order_events
+SET payload = payload || jsonb_build_object('Quantity', (payload->>'Quantity')::bigint * 1000)
+WHERE payload ? 'Quantity';
+
+UPDATE order_events
+SET payload = payload || jsonb_build_object('FillQuantity', (payload->>'FillQuantity')::bigint * 1000)
+WHERE payload ? 'FillQuantity';
+
+UPDATE order_events
+SET payload = payload || '{"LotType": "board"}'::jsonb
+WHERE event_type IN ('order.created', 'order.placed');
+
+COMMIT;
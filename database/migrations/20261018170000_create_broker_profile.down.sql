@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_transactions_unsettled_rebate;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS broker_profile_id;
+DROP TRIGGER IF EXISTS update_broker_profiles_updated_at ON broker_profiles;
+DROP TABLE IF EXISTS broker_profiles;
@@ -0,0 +1,35 @@
+BEGIN;
+
+CREATE TABLE broker_profiles (
+    id uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    user_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+    name varchar(64) NOT NULL,
+    rebate_timing varchar(16) NOT NULL DEFAULT 'immediate',
+    fee_rate numeric(8,6) NOT NULL,
+    fee_discount numeric(6,4) NOT NULL,
+    min_fee numeric(8,2) NOT NULL DEFAULT 0,
+    odd_lot_min_fee numeric(8,2) NOT NULL DEFAULT 0,
+    tax_rate numeric(8,6) NOT NULL,
+    day_trade_tax_rate numeric(8,6) NOT NULL,
+    etf_tax_rate numeric(8,6) NOT NULL,
+    bond_etf_tax_rate numeric(8,6) NOT NULL,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at timestamp NULL
+);
+
+CREATE INDEX idx_broker_profiles_user_id ON broker_profiles (user_id) WHERE deleted_at IS NULL;
+
+CREATE TRIGGER update_broker_profiles_updated_at
+BEFORE UPDATE ON broker_profiles
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+-- Users without a profile are charged by the default schedule
+ALTER TABLE users
+    ADD COLUMN broker_profile_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+
+CREATE INDEX idx_transactions_unsettled_rebate ON transactions (created_at)
+WHERE order_type = 'Rebate' AND status = 'created';
+
+COMMIT;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_orders_open_margined;
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS trade_type;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE orders
+    ADD COLUMN trade_type varchar(16) NOT NULL DEFAULT 'cash';
+
+-- Margined positions are checked against every realtime quote
+CREATE INDEX idx_orders_open_margined ON orders (stock_id)
+WHERE trade_type <> 'cash' AND status IN ('created', 'changed');
+
+COMMIT;
@@ -0,0 +1,8 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS dividends;
+DROP TABLE IF EXISTS corporate_action_entitlements;
+DROP INDEX IF EXISTS idx_corporate_actions_undistributed;
+ALTER TABLE corporate_actions
+    DROP COLUMN IF EXISTS record_date,
+    DROP COLUMN IF EXISTS payment_date,
+    DROP COLUMN IF EXISTS distributed_at;
@@ -0,0 +1,34 @@
+BEGIN;
+
+ALTER TABLE corporate_actions
+    ADD COLUMN record_date varchar(32) NOT NULL DEFAULT '',
+    ADD COLUMN payment_date varchar(32) NOT NULL DEFAULT '',
+    ADD COLUMN distributed_at timestamp NULL;
+
+CREATE INDEX idx_corporate_actions_undistributed ON corporate_actions (payment_date)
+WHERE distributed_at IS NULL AND deleted_at IS NULL;
+
+-- One entitlement per position keeps the distribution of an action idempotent
+CREATE TABLE corporate_action_entitlements (
+    id uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    corporate_action_id uuid NOT NULL,
+    order_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    held_quantity bigint NOT NULL,
+    stock_shares bigint NOT NULL DEFAULT 0,
+    rights_shares bigint NOT NULL DEFAULT 0,
+    cash_dividend numeric(20,4) NOT NULL DEFAULT 0,
+    cash_in_lieu numeric(20,4) NOT NULL DEFAULT 0,
+    premium numeric(20,4) NOT NULL DEFAULT 0,
+    rights_cost numeric(20,4) NOT NULL DEFAULT 0,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (corporate_action_id, order_id)
+);
+
+CREATE INDEX idx_corporate_action_entitlements_user_id ON corporate_action_entitlements (user_id);
+
+-- Cash the position received from corporate actions, part of its total return
+ALTER TABLE orders
+    ADD COLUMN dividends numeric(20,4) NOT NULL DEFAULT 0;
+
+COMMIT;
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS update_equity_snapshots_updated_at ON equity_snapshots;
+DROP TABLE IF EXISTS equity_snapshots;
@@ -0,0 +1,22 @@
+BEGIN;
+
+CREATE TABLE equity_snapshots (
+    user_id uuid NOT NULL,
+    exchange_date varchar(8) NOT NULL,
+    cash numeric(20,4) NOT NULL,
+    market_value numeric(20,4) NOT NULL,
+    equity numeric(20,4) NOT NULL,
+    net_deposits numeric(20,4) NOT NULL,
+    benchmark_close numeric(12,4) NOT NULL DEFAULT 0,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, exchange_date),
+    CONSTRAINT fk_user_id FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TRIGGER update_equity_snapshots_updated_at
+BEFORE UPDATE ON equity_snapshots
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+COMMIT;
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS update_journal_entries_updated_at ON journal_entries;
+DROP TABLE IF EXISTS journal_entries;
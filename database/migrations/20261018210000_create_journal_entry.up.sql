@@ -0,0 +1,26 @@
+BEGIN;
+
+CREATE TABLE journal_entries (
+    id uuid NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    user_id uuid NOT NULL,
+    order_id uuid NOT NULL,
+    notes text NOT NULL DEFAULT '',
+    tags text[] NOT NULL DEFAULT '{}',
+    strategy varchar(64) NOT NULL DEFAULT '',
+    screen_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at timestamp NULL,
+    CONSTRAINT fk_order_id FOREIGN KEY (order_id) REFERENCES orders (id),
+    CONSTRAINT fk_user_id FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+CREATE INDEX idx_journal_entries_order_id ON journal_entries (order_id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_journal_entries_user_id ON journal_entries (user_id) WHERE deleted_at IS NULL;
+
+CREATE TRIGGER update_journal_entries_updated_at
+BEFORE UPDATE ON journal_entries
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+COMMIT;
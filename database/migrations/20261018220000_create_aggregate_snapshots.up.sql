@@ -0,0 +1,11 @@
+BEGIN;
+
+CREATE TABLE balance_snapshots (
+    aggregate_id uuid NOT NULL,
+    version integer NOT NULL,
+    payload jsonb NOT NULL,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (aggregate_id)
+);
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE TABLE outbox_messages (
+    id bigserial PRIMARY KEY,
+    topic varchar(100) NOT NULL,
+    aggregate_id uuid NOT NULL,
+    parent_id uuid NOT NULL,
+    version integer NOT NULL,
+    event_type varchar(50) NOT NULL,
+    payload jsonb NOT NULL,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    claimed_until timestamp
+);
+
+COMMIT;
@@ -0,0 +1,4 @@
+ALTER TABLE balance_events DROP COLUMN IF EXISTS global_position;
+ALTER TABLE order_events DROP COLUMN IF EXISTS global_position;
+ALTER TABLE transaction_events DROP COLUMN IF EXISTS global_position;
+DROP SEQUENCE IF EXISTS event_global_position_seq;
@@ -0,0 +1,59 @@
+BEGIN;
+
+-- the event tables share the sequence so the projections can tail them in
+-- a single global order
+CREATE SEQUENCE event_global_position_seq;
+
+ALTER TABLE balance_events ADD COLUMN global_position bigint;
+ALTER TABLE order_events ADD COLUMN global_position bigint;
+ALTER TABLE transaction_events ADD COLUMN global_position bigint;
+
+-- number the recorded events in the order they were created
+WITH positions AS (
+    SELECT event_table,
+           aggregate_id,
+           version,
+           row_number() OVER (ORDER BY created_at, version, event_table, aggregate_id) AS global_position
+    FROM (
+        SELECT 'balance_events' AS event_table, aggregate_id, version, created_at FROM balance_events
+        UNION ALL
+        SELECT 'order_events' AS event_table, aggregate_id, version, created_at FROM order_events
+        UNION ALL
+        SELECT 'transaction_events' AS event_table, aggregate_id, version, created_at FROM transaction_events
+    ) recorded
+), balance AS (
+    UPDATE balance_events e
+    SET global_position = p.global_position
+    FROM positions p
+    WHERE p.event_table = 'balance_events' AND p.aggregate_id = e.aggregate_id AND p.version = e.version
+), orders AS (
+    UPDATE order_events e
+    SET global_position = p.global_position
+    FROM positions p
+    WHERE p.event_table = 'order_events' AND p.aggregate_id = e.aggregate_id AND p.version = e.version
+)
+UPDATE transaction_events e
+SET global_position = p.global_position
+FROM positions p
+WHERE p.event_table = 'transaction_events' AND p.aggregate_id = e.aggregate_id AND p.version = e.version;
+
+SELECT setval('event_global_position_seq',
+    (SELECT count(*) FROM balance_events) +
+    (SELECT count(*) FROM order_events) +
+    (SELECT count(*) FROM transaction_events) + 1, false);
+
+ALTER TABLE balance_events
+    ALTER COLUMN global_position SET DEFAULT nextval('event_global_position_seq'),
+    ALTER COLUMN global_position SET NOT NULL;
+ALTER TABLE order_events
+    ALTER COLUMN global_position SET DEFAULT nextval('event_global_position_seq'),
+    ALTER COLUMN global_position SET NOT NULL;
+ALTER TABLE transaction_events
+    ALTER COLUMN global_position SET DEFAULT nextval('event_global_position_seq'),
+    ALTER COLUMN global_position SET NOT NULL;
+
+CREATE UNIQUE INDEX idx_balance_events_global_position ON balance_events (global_position);
+CREATE UNIQUE INDEX idx_order_events_global_position ON order_events (global_position);
+CREATE UNIQUE INDEX idx_transaction_events_global_position ON transaction_events (global_position);
+
+COMMIT;
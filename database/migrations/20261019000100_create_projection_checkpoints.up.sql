@@ -0,0 +1,11 @@
+BEGIN;
+
+CREATE TABLE projection_checkpoints (
+    name varchar(100) PRIMARY KEY,
+    global_position bigint NOT NULL DEFAULT 0,
+    retries integer NOT NULL DEFAULT 0,
+    last_error text,
+    updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMIT;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS daily_profit_losses;
+DROP TABLE IF EXISTS position_views;
@@ -0,0 +1,32 @@
+BEGIN;
+
+-- read models the projections rebuild from the order events
+CREATE TABLE position_views (
+    order_id uuid NOT NULL PRIMARY KEY,
+    user_id uuid NOT NULL,
+    stock_id varchar(8) NOT NULL,
+    status varchar(16) NOT NULL,
+    buy_price numeric(8, 2) NOT NULL DEFAULT 0,
+    buy_quantity bigint NOT NULL DEFAULT 0,
+    buy_exchange_date varchar(32) NOT NULL DEFAULT '',
+    sell_price numeric(8, 2) NOT NULL DEFAULT 0,
+    sell_quantity bigint NOT NULL DEFAULT 0,
+    sell_exchange_date varchar(32) NOT NULL DEFAULT '',
+    version integer NOT NULL,
+    updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_position_views_user_id ON position_views (user_id, stock_id);
+
+CREATE TABLE daily_profit_losses (
+    order_id uuid NOT NULL,
+    exchange_date varchar(32) NOT NULL,
+    user_id uuid NOT NULL,
+    stock_id varchar(8) NOT NULL,
+    profit_loss numeric(16, 2) NOT NULL,
+    PRIMARY KEY (order_id, exchange_date)
+);
+
+CREATE INDEX idx_daily_profit_losses_user_id ON daily_profit_losses (user_id, exchange_date);
+
+COMMIT;
@@ -0,0 +1,2 @@
+ALTER TABLE corporate_action_entitlements
+    DROP COLUMN IF EXISTS rights_subscribed_at;
@@ -0,0 +1,12 @@
+BEGIN;
+
+-- Rights are offered with the entitlement and booked once the holder subscribes
+ALTER TABLE corporate_action_entitlements
+    ADD COLUMN rights_subscribed_at timestamp NULL;
+
+-- The rights distributed so far were booked as subscribed in full
+UPDATE corporate_action_entitlements
+SET rights_subscribed_at = created_at
+WHERE rights_shares > 0;
+
+COMMIT;
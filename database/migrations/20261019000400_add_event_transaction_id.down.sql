@@ -0,0 +1,7 @@
+ALTER TABLE projection_checkpoints DROP COLUMN IF EXISTS transaction_id;
+DROP INDEX IF EXISTS idx_transaction_events_transaction_id;
+DROP INDEX IF EXISTS idx_order_events_transaction_id;
+DROP INDEX IF EXISTS idx_balance_events_transaction_id;
+ALTER TABLE transaction_events DROP COLUMN IF EXISTS transaction_id;
+ALTER TABLE order_events DROP COLUMN IF EXISTS transaction_id;
+ALTER TABLE balance_events DROP COLUMN IF EXISTS transaction_id;
@@ -0,0 +1,20 @@
+BEGIN;
+
+-- the projections read the events in the order of the transactions appending
+-- them, a transaction in flight holds back the events committed after it. The
+-- recorded events keep their global order ahead of the new ones.
+ALTER TABLE balance_events ADD COLUMN transaction_id xid8 NOT NULL DEFAULT '0';
+ALTER TABLE order_events ADD COLUMN transaction_id xid8 NOT NULL DEFAULT '0';
+ALTER TABLE transaction_events ADD COLUMN transaction_id xid8 NOT NULL DEFAULT '0';
+
+ALTER TABLE balance_events ALTER COLUMN transaction_id SET DEFAULT pg_current_xact_id();
+ALTER TABLE order_events ALTER COLUMN transaction_id SET DEFAULT pg_current_xact_id();
+ALTER TABLE transaction_events ALTER COLUMN transaction_id SET DEFAULT pg_current_xact_id();
+
+CREATE INDEX idx_balance_events_transaction_id ON balance_events (transaction_id, global_position);
+CREATE INDEX idx_order_events_transaction_id ON order_events (transaction_id, global_position);
+CREATE INDEX idx_transaction_events_transaction_id ON transaction_events (transaction_id, global_position);
+
+ALTER TABLE projection_checkpoints ADD COLUMN transaction_id xid8 NOT NULL DEFAULT '0';
+
+COMMIT;
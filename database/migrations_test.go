@@ -0,0 +1,48 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/common/remotetest"
+	esdb "github.com/samwang0723/jarvis/internal/eventsourcing/db"
+	"github.com/stretchr/testify/assert"
+)
+
+// lotMatchMigration is the last migration recording the order quantities in
+// board lots, the next one switches them to shares.
+const lotMatchMigration = 20261018150000
+
+func TestMigration_OrderEventsInShares(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	server, pool := remotetest.SetupPostgresServer(t)
+	assert.Nil(t, server.MigrateTo(lotMatchMigration))
+
+	// an order of 2 lots, 1 of which was sold, recorded before the migration
+	orderID, userID := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+	_, err := pool.Exec(ctx, `INSERT INTO order_events (aggregate_id, parent_id, event_type, payload, version)
+		VALUES ($1, $2, 'order.created', $3, 1), ($1, $2, 'order.completed', $4, 2)`,
+		orderID, userID,
+		`{"OrderType":"buy","StockID":"2330","ExchangeDate":"20240102","Quantity":2,"TradePrice":500}`,
+		`{"OrderType":"sell","StockID":"2330","ExchangeDate":"20240105","Quantity":1,"FillQuantity":1,`+
+			`"FillPrice":520,"TradePrice":520,"LotMethod":"fifo"}`)
+	assert.Nil(t, err)
+
+	assert.Nil(t, server.RunMigrations())
+
+	// replaying the events yields the quantities in shares
+	repo := esdb.NewAggregateRepository(&domain.Order{}, pool)
+	aggregate, err := repo.Load(ctx, orderID)
+	assert.Nil(t, err)
+
+	order, ok := aggregate.(*domain.Order)
+	assert.True(t, ok)
+	assert.Equal(t, domain.LotTypeBoard, order.LotType)
+	assert.Equal(t, uint64(2000), order.BuyQuantity)
+	assert.Equal(t, uint64(1000), order.SellQuantity)
+}
@@ -0,0 +1,34 @@
+-- name: CreateAlertRule :exec
+INSERT INTO alert_rules (id, user_id, stock_id, condition, threshold, channel, target)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: ListAlertRules :many
+SELECT * FROM alert_rules
+WHERE user_id = $1 AND deleted_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: UpdateAlertRule :execrows
+UPDATE alert_rules
+SET stock_id = $3,
+    condition = $4,
+    threshold = $5,
+    channel = $6,
+    target = $7,
+    last_triggered_date = ''
+WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL;
+
+-- name: DeleteAlertRule :execrows
+UPDATE alert_rules
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL;
+
+-- name: ListPendingAlertRules :many
+SELECT * FROM alert_rules
+WHERE stock_id = $1
+  AND last_triggered_date <> @exchange_date::VARCHAR
+  AND deleted_at IS NULL;
+
+-- name: MarkAlertRuleTriggered :exec
+UPDATE alert_rules
+SET last_triggered_date = $2
+WHERE id = $1;
@@ -0,0 +1,20 @@
+-- name: CreateBrokerProfile :exec
+INSERT INTO broker_profiles (id, user_id, name, rebate_timing, fee_rate, fee_discount, min_fee,
+odd_lot_min_fee, tax_rate, day_trade_tax_rate, etf_tax_rate, bond_etf_tax_rate)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+
+-- name: GetBrokerProfile :one
+SELECT * FROM broker_profiles
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetUserBrokerProfile :one
+SELECT broker_profiles.*
+FROM broker_profiles
+JOIN users ON users.broker_profile_id = broker_profiles.id
+WHERE users.id = $1 AND broker_profiles.deleted_at IS NULL;
+
+-- name: ListBrokerProfiles :many
+SELECT * FROM broker_profiles
+WHERE (user_id = $1 OR user_id = '00000000-0000-0000-0000-000000000000')
+  AND deleted_at IS NULL
+ORDER BY created_at ASC;
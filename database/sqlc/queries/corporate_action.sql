@@ -0,0 +1,82 @@
+-- name: BatchUpsertCorporateActions :exec
+INSERT INTO corporate_actions (
+    id, stock_id, ex_date, cash_dividend, stock_dividend, rights_ratio, rights_price, split_ratio,
+    record_date, payment_date
+) VALUES (
+    unnest(@id::uuid[]),
+    unnest(@stock_id::varchar[]),
+    unnest(@ex_date::varchar[]),
+    unnest(@cash_dividend::numeric[]),
+    unnest(@stock_dividend::numeric[]),
+    unnest(@rights_ratio::numeric[]),
+    unnest(@rights_price::numeric[]),
+    unnest(@split_ratio::numeric[]),
+    unnest(@record_date::varchar[]),
+    unnest(@payment_date::varchar[])
+)
+ON CONFLICT (stock_id, ex_date) DO UPDATE
+SET
+    cash_dividend = EXCLUDED.cash_dividend,
+    stock_dividend = EXCLUDED.stock_dividend,
+    rights_ratio = EXCLUDED.rights_ratio,
+    rights_price = EXCLUDED.rights_price,
+    split_ratio = EXCLUDED.split_ratio,
+    record_date = EXCLUDED.record_date,
+    payment_date = EXCLUDED.payment_date;
+
+-- name: GetCorporateActionEntitlement :one
+SELECT e.id, e.corporate_action_id, e.order_id, e.user_id, e.held_quantity, e.stock_shares,
+       e.rights_shares, e.cash_dividend, e.cash_in_lieu, e.premium, e.rights_cost,
+       e.rights_subscribed_at, c.stock_id, c.payment_date
+FROM corporate_action_entitlements e
+JOIN corporate_actions c ON c.id = e.corporate_action_id
+WHERE e.id = @id
+AND e.user_id = @user_id;
+
+-- name: ListCorporateActionEntitlements :many
+SELECT e.id, e.corporate_action_id, e.order_id, e.user_id, e.held_quantity, e.stock_shares,
+       e.rights_shares, e.cash_dividend, e.cash_in_lieu, e.premium, e.rights_cost,
+       e.rights_subscribed_at, c.stock_id, c.payment_date
+FROM corporate_action_entitlements e
+JOIN corporate_actions c ON c.id = e.corporate_action_id
+WHERE e.user_id = @user_id
+ORDER BY c.payment_date DESC, c.stock_id;
+
+-- name: ListCorporateActions :many
+SELECT id, stock_id, ex_date, cash_dividend, stock_dividend, rights_ratio, rights_price, split_ratio,
+       created_at, updated_at, deleted_at, record_date, payment_date, distributed_at
+FROM corporate_actions
+WHERE stock_id = ANY(@stock_ids::text[])
+AND ex_date > @start_date
+AND ex_date <= @end_date
+AND deleted_at IS NULL
+ORDER BY stock_id, ex_date;
+
+-- name: ListDueCorporateActions :many
+SELECT id, stock_id, ex_date, cash_dividend, stock_dividend, rights_ratio, rights_price, split_ratio,
+       created_at, updated_at, deleted_at, record_date, payment_date, distributed_at
+FROM corporate_actions
+WHERE payment_date <> ''
+AND payment_date <= @payment_date
+AND distributed_at IS NULL
+AND deleted_at IS NULL
+ORDER BY payment_date, stock_id;
+
+-- name: MarkCorporateActionDistributed :exec
+UPDATE corporate_actions
+SET distributed_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: CreateCorporateActionEntitlement :execrows
+INSERT INTO corporate_action_entitlements (
+    corporate_action_id, order_id, user_id, held_quantity, stock_shares, rights_shares,
+    cash_dividend, cash_in_lieu, premium, rights_cost
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (corporate_action_id, order_id) DO NOTHING;
+
+-- name: SubscribeCorporateActionRights :execrows
+UPDATE corporate_action_entitlements
+SET rights_subscribed_at = CURRENT_TIMESTAMP
+WHERE id = $1
+AND rights_shares > 0
+AND rights_subscribed_at IS NULL;
@@ -0,0 +1,18 @@
+-- name: CreateDailyProfitLoss :exec
+INSERT INTO daily_profit_losses (order_id, exchange_date, user_id, stock_id, profit_loss)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListDailyProfitLosses :many
+SELECT exchange_date, SUM(profit_loss)::numeric AS profit_loss
+FROM daily_profit_losses
+WHERE user_id = $1
+  AND exchange_date >= @start_date
+  AND exchange_date <= @end_date
+GROUP BY exchange_date
+ORDER BY exchange_date ASC;
+
+-- name: DeleteOrderDailyProfitLosses :exec
+DELETE FROM daily_profit_losses WHERE order_id = $1;
+
+-- name: DeleteDailyProfitLosses :exec
+DELETE FROM daily_profit_losses;
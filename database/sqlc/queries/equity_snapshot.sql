@@ -0,0 +1,17 @@
+-- name: UpsertEquitySnapshot :exec
+INSERT INTO equity_snapshots (user_id, exchange_date, cash, market_value, equity, net_deposits, benchmark_close)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (user_id, exchange_date) DO UPDATE
+SET cash = EXCLUDED.cash,
+  market_value = EXCLUDED.market_value,
+  equity = EXCLUDED.equity,
+  net_deposits = EXCLUDED.net_deposits,
+  benchmark_close = EXCLUDED.benchmark_close;
+
+-- name: ListEquitySnapshots :many
+SELECT *
+FROM equity_snapshots
+WHERE user_id = $1
+  AND (@start_date::VARCHAR = '' OR exchange_date >= @start_date)
+  AND (@end_date::VARCHAR = '' OR exchange_date <= @end_date)
+ORDER BY exchange_date ASC;
@@ -0,0 +1,16 @@
+-- name: CreateIntradayTick :exec
+INSERT INTO intraday_ticks (
+    stock_id, exchange_date, tick_time, open, high, low, close, volume
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+ON CONFLICT (stock_id, exchange_date, tick_time) DO NOTHING;
+
+-- name: ListIntradayTicks :many
+SELECT id, stock_id, exchange_date, tick_time, open, high, low, close, volume,
+       created_at, updated_at, deleted_at
+FROM intraday_ticks
+WHERE stock_id = $1
+AND exchange_date = $2
+AND deleted_at IS NULL
+ORDER BY tick_time;
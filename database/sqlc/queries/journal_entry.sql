@@ -0,0 +1,13 @@
+-- name: CreateJournalEntry :exec
+INSERT INTO journal_entries (id, user_id, order_id, notes, tags, strategy, screen_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: ListOrderJournalEntries :many
+SELECT * FROM journal_entries
+WHERE order_id = $1 AND user_id = $2 AND deleted_at IS NULL
+ORDER BY created_at ASC;
+
+-- name: ListUserJournalEntries :many
+SELECT * FROM journal_entries
+WHERE user_id = $1 AND deleted_at IS NULL
+ORDER BY created_at ASC;
@@ -0,0 +1,15 @@
+-- name: CreateLotMatch :exec
+INSERT INTO lot_matches (id, user_id, order_id, stock_id, order_type, method,
+exchange_date, price, cost_price, quantity)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: ListLotMatches :many
+SELECT * FROM lot_matches
+WHERE order_id = ANY(@order_ids::uuid[])
+  AND deleted_at IS NULL
+ORDER BY created_at ASC;
+
+-- name: DeleteLotMatches :exec
+UPDATE lot_matches
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE order_id = $1 AND deleted_at IS NULL;
@@ -11,7 +11,8 @@ WHERE user_id = $1
   AND (@status::VARCHAR = '' OR status = @status)
   AND (@exchange_month::VARCHAR = '' 
     OR sell_exchange_date LIKE @exchange_month::VARCHAR || '%' 
-    OR buy_exchange_date LIKE @exchange_month::VARCHAR || '%')
+    OR buy_exchange_date LIKE @exchange_month::VARCHAR || '%'
+    OR placed_exchange_date LIKE @exchange_month::VARCHAR || '%')
 LIMIT $2 OFFSET $3;
 
 -- name: ListOpenOrders :many
@@ -27,12 +28,49 @@ WHERE user_id = $1
   )
 ORDER BY created_at ASC;
 
+-- name: ListOpenMarginedOrders :many
+SELECT id
+FROM orders
+WHERE stock_id = $1
+  AND trade_type <> 'cash'
+  AND status IN ('created', 'changed')
+  AND buy_quantity <> sell_quantity
+ORDER BY created_at ASC;
+
+-- name: ListOpenPositions :many
+SELECT id
+FROM orders
+WHERE user_id = $1
+  AND kind IN ('', 'market')
+  AND status IN ('created', 'changed')
+  AND buy_quantity <> sell_quantity
+ORDER BY stock_id, created_at ASC;
+
+-- name: ListEntitledOrders :many
+SELECT id
+FROM orders
+WHERE stock_id = $1
+  AND kind IN ('', 'market')
+  AND status IN ('created', 'changed', 'closed')
+  AND buy_exchange_date <> ''
+  AND buy_exchange_date < @cutoff_date::VARCHAR
+  AND (status <> 'closed' OR sell_exchange_date >= @cutoff_date::VARCHAR)
+ORDER BY created_at ASC;
+
+-- name: ListPendingOrders :many
+SELECT id
+FROM orders
+WHERE stock_id = ANY(@stock_ids::text[])
+  AND status IN ('pending', 'triggered')
+ORDER BY created_at ASC;
 
 -- name: UpsertOrder :exec
 INSERT INTO orders (id, user_id, stock_id, buy_price, buy_quantity,
 buy_exchange_date, sell_price, sell_quantity, sell_exchange_date, profitable_price,
-status, version)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+status, version, kind, side, quantity, limit_price, stop_price, position_id,
+placed_exchange_date, filled_price, filled_exchange_date, lot_type, trade_type, dividends)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16,
+$17, $18, $19, $20, $21, $22, $23, $24)
 ON CONFLICT (id) DO UPDATE
 SET user_id = EXCLUDED.user_id, 
   stock_id = EXCLUDED.stock_id,
@@ -44,4 +82,16 @@ SET user_id = EXCLUDED.user_id,
   sell_exchange_date = EXCLUDED.sell_exchange_date,
   profitable_price = EXCLUDED.profitable_price, 
   status = EXCLUDED.status, 
-  version = EXCLUDED.version;
+  version = EXCLUDED.version,
+  kind = EXCLUDED.kind,
+  side = EXCLUDED.side,
+  quantity = EXCLUDED.quantity,
+  limit_price = EXCLUDED.limit_price,
+  stop_price = EXCLUDED.stop_price,
+  position_id = EXCLUDED.position_id,
+  placed_exchange_date = EXCLUDED.placed_exchange_date,
+  filled_price = EXCLUDED.filled_price,
+  filled_exchange_date = EXCLUDED.filled_exchange_date,
+  lot_type = EXCLUDED.lot_type,
+  trade_type = EXCLUDED.trade_type,
+  dividends = EXCLUDED.dividends;
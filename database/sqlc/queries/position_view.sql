@@ -0,0 +1,24 @@
+-- name: UpsertPositionView :exec
+INSERT INTO position_views (order_id, user_id, stock_id, status, buy_price, buy_quantity,
+buy_exchange_date, sell_price, sell_quantity, sell_exchange_date, version)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT (order_id) DO UPDATE
+SET status = EXCLUDED.status,
+    buy_price = EXCLUDED.buy_price,
+    buy_quantity = EXCLUDED.buy_quantity,
+    buy_exchange_date = EXCLUDED.buy_exchange_date,
+    sell_price = EXCLUDED.sell_price,
+    sell_quantity = EXCLUDED.sell_quantity,
+    sell_exchange_date = EXCLUDED.sell_exchange_date,
+    version = EXCLUDED.version,
+    updated_at = CURRENT_TIMESTAMP;
+
+-- name: ListOpenPositionViews :many
+SELECT * FROM position_views
+WHERE user_id = $1
+  AND status IN ('created', 'changed')
+  AND buy_quantity <> sell_quantity
+ORDER BY stock_id ASC, buy_exchange_date ASC, sell_exchange_date ASC;
+
+-- name: DeletePositionViews :exec
+DELETE FROM position_views;
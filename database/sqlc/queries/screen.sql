@@ -0,0 +1,12 @@
+-- name: CreateScreen :exec
+INSERT INTO screens (id, user_id, name, expression)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListScreens :many
+SELECT * FROM screens
+WHERE user_id = $1 AND deleted_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: GetScreenByID :one
+SELECT * FROM screens
+WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL;
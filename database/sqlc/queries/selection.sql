@@ -74,7 +74,7 @@ WHERE p.deleted_at is null;
 SELECT o.stock_id, c.market
 FROM orders o
 LEFT JOIN stocks c ON c.id = o.stock_id 
-WHERE o.status != 'closed';
+WHERE o.status IN ('created', 'changed', 'pending', 'triggered');
 
 -- name: ListSelectionsFromPicked :many
 WITH latest AS (
@@ -121,8 +121,8 @@ WITH average AS (
   FROM 
     daily_closes
   WHERE 
-    exchange_date BETWEEN TO_CHAR(TO_DATE($1, 'YYYYMMDD') - INTERVAL '5' day, 'YYYYMMDD') 
-      AND TO_CHAR(TO_DATE($1, 'YYYYMMDD') - INTERVAL '1' day, 'YYYYMMDD')
+    exchange_date BETWEEN TO_CHAR(TO_DATE(@exchange_date, 'YYYYMMDD') - INTERVAL '5' day, 'YYYYMMDD') 
+      AND TO_CHAR(TO_DATE(@exchange_date, 'YYYYMMDD') - INTERVAL '1' day, 'YYYYMMDD')
   GROUP BY 
     stock_id
 )
@@ -150,8 +150,8 @@ SELECT
 FROM 
   stake_concentration s
 LEFT JOIN stocks c ON c.id = s.stock_id
-LEFT JOIN daily_closes d ON (d.stock_id = s.stock_id AND d.exchange_date = $1)
-LEFT JOIN three_primary t ON (t.stock_id = s.stock_id AND t.exchange_date = $1)
+LEFT JOIN daily_closes d ON (d.stock_id = s.stock_id AND d.exchange_date = @exchange_date)
+LEFT JOIN three_primary t ON (t.stock_id = s.stock_id AND t.exchange_date = @exchange_date)
 LEFT JOIN average a ON a.stock_id = s.stock_id
 WHERE (
    CASE WHEN s.concentration_1 > 0 THEN 1 ELSE 0 END +
@@ -159,11 +159,11 @@ WHERE (
    CASE WHEN s.concentration_10 > 0 THEN 1 ELSE 0 END +
    CASE WHEN s.concentration_20 > 0 THEN 1 ELSE 0 END +
    CASE WHEN s.concentration_60 > 0 THEN 1 ELSE 0 END
-) >= 4
+) >= @min_concentration_days::int
 AND c.name IS NOT NULL
-AND s.exchange_date = $1
-AND d.trade_shares >= 3000000
-AND a.avg_volume >= 1000000
+AND s.exchange_date = @exchange_date
+AND d.trade_shares >= @min_trade_shares::bigint
+AND COALESCE(a.avg_volume, 0) >= @min_avg_volume::bigint
 ORDER BY s.stock_id;
 
 -- name: GetStartDate :one
@@ -186,7 +186,7 @@ AND stock_id = ANY(@stock_ids::text[])
 GROUP BY stock_id;
 
 -- name: RetrieveDailyCloseHistoryWithDate :many
-SELECT stock_id, exchange_date, close, trade_shares 
+SELECT stock_id, exchange_date, open, high, low, close, trade_shares 
 FROM daily_closes 
 WHERE exchange_date >= @start_date 
 AND exchange_date <= @end_date 
@@ -194,7 +194,7 @@ AND stock_id = ANY(@stock_ids::text[])
 ORDER BY stock_id, exchange_date DESC;
 
 -- name: RetrieveDailyCloseHistory :many
-SELECT stock_id, exchange_date, close, trade_shares 
+SELECT stock_id, exchange_date, open, high, low, close, trade_shares 
 FROM daily_closes 
 WHERE exchange_date >= @start_date 
 AND exchange_date < @end_date
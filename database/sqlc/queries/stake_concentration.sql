@@ -63,3 +63,10 @@ SELECT EXISTS (
 -- name: GetStakeConcentrationLatestDataPoint :one
 SELECT exchange_date FROM stake_concentration
 ORDER BY exchange_date DESC LIMIT 1;
+
+-- name: ListExchangeDates :many
+SELECT DISTINCT exchange_date
+FROM stake_concentration
+WHERE exchange_date >= @start_date
+AND exchange_date <= @end_date
+ORDER BY exchange_date;
@@ -3,6 +3,38 @@ SELECT *
 FROM transactions
 WHERE id = $1;
 
+-- name: ListOrderTransactions :many
+SELECT id
+FROM transactions
+WHERE order_id = $1
+  AND status = 'completed'
+ORDER BY created_at ASC;
+
+-- name: ListOrderRebates :many
+SELECT id
+FROM transactions
+WHERE order_id = $1
+  AND order_type = 'Rebate'
+  AND status = 'created'
+ORDER BY created_at ASC;
+
+-- name: ListUnsettledRebates :many
+SELECT id
+FROM transactions
+WHERE order_type = 'Rebate'
+  AND status = 'created'
+  AND created_at < $1
+ORDER BY created_at ASC;
+
+-- name: ListUserTransactions :many
+SELECT *
+FROM transactions
+WHERE user_id = $1
+  AND status = 'completed'
+  AND created_at >= @start_time
+  AND created_at < @end_time
+ORDER BY created_at ASC;
+
 -- name: UpsertTransaction :exec
 INSERT INTO transactions (id, user_id, order_id, order_type, credit_amount, debit_amount, status, version)
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
@@ -14,3 +46,10 @@ SET user_id = EXCLUDED.user_id,
   debit_amount = EXCLUDED.debit_amount, 
   status = EXCLUDED.status, 
   version = EXCLUDED.version;
+
+-- name: GetNetDeposits :one
+SELECT COALESCE(SUM(credit_amount::numeric - debit_amount::numeric), 0)::numeric AS net_deposits
+FROM transactions
+WHERE user_id = $1
+  AND order_type IN ('Deposit', 'Withdraw')
+  AND status = 'completed';
@@ -7,6 +7,9 @@ UPDATE users
 SET first_name = $2, last_name = $3, email = $4, phone = $5, password = $6
 WHERE id = $1;
 
+-- name: UpdateUserBrokerProfile :exec
+UPDATE users SET broker_profile_id = $2 WHERE id = $1;
+
 -- name: UpdateSessionID :exec
 UPDATE users SET session_id = $1, session_expired_at = $2 WHERE id = $3;
 
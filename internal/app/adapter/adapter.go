@@ -2,12 +2,15 @@ package adapter
 
 import (
 	"context"
+	"time"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/samwang0723/jarvis/internal/app/adapter/sqlc"
 	"github.com/samwang0723/jarvis/internal/app/domain"
+	esdb "github.com/samwang0723/jarvis/internal/eventsourcing/db"
 )
 
+//go:generate mockgen -source=adapter.go -destination=mocks/adapter.go -package=adapter
 type Adapter interface {
 	BatchUpsertStocks(ctx context.Context, objs []*domain.Stock) error
 	CreateStock(ctx context.Context, obj *domain.Stock) error
@@ -52,7 +55,11 @@ type Adapter interface {
 	GetUserByID(ctx context.Context, userID uuid.UUID) (*domain.User, error)
 	ListUsers(ctx context.Context, limit, offset int32) ([]*domain.User, error)
 	GetBalanceView(ctx context.Context, id uuid.UUID) (*domain.BalanceView, error)
-	ListSelections(ctx context.Context, date string, strict bool) ([]*domain.Selection, error)
+	ListSelections(
+		ctx context.Context,
+		date string,
+		candidates *domain.SelectionCandidates,
+	) ([]*domain.Selection, error)
 	ListSelectionsFromPicked(
 		ctx context.Context,
 		stockIDs []string,
@@ -88,6 +95,75 @@ type Adapter interface {
 		date string,
 		rewindWeek int,
 	) (map[string]float32, error)
+	CreateScreen(ctx context.Context, obj *domain.Screen) error
+	ListScreens(ctx context.Context, userID uuid.UUID) ([]*domain.Screen, error)
+	GetScreenByID(ctx context.Context, userID, id uuid.UUID) (*domain.Screen, error)
+	ListExchangeDates(ctx context.Context, startDate, endDate string) ([]string, error)
+	RetrieveDailyCloseBetween(
+		ctx context.Context,
+		stockIDs []string,
+		startDate, endDate string,
+	) ([]*domain.DailyClose, error)
+	BatchUpsertCorporateActions(ctx context.Context, objs []*domain.CorporateAction) error
+	ListCorporateActions(
+		ctx context.Context,
+		stockIDs []string,
+		startDate, endDate string,
+	) ([]*domain.CorporateAction, error)
+	CreateIntradayTick(ctx context.Context, obj *domain.IntradayTick) error
+	ListIntradayTicks(ctx context.Context, stockID, date string) ([]*domain.IntradayTick, error)
+	CreateAlertRule(ctx context.Context, obj *domain.AlertRule) error
+	ListAlertRules(ctx context.Context, userID uuid.UUID) ([]*domain.AlertRule, error)
+	UpdateAlertRule(ctx context.Context, obj *domain.AlertRule) error
+	DeleteAlertRule(ctx context.Context, userID, id uuid.UUID) error
+	ListPendingAlertRules(ctx context.Context, stockID, date string) ([]*domain.AlertRule, error)
+	MarkAlertRuleTriggered(ctx context.Context, id uuid.UUID, date string) error
+	GetOrder(ctx context.Context, id uuid.UUID) (*domain.Order, error)
+	ListPendingOrders(ctx context.Context, stockIDs []string) ([]*domain.Order, error)
+	CancelOrder(ctx context.Context, order *domain.Order) error
+	AmendOrder(
+		ctx context.Context,
+		order *domain.Order,
+		transactions []*domain.Transaction,
+	) error
+	ListLotMatches(ctx context.Context, orderIDs []uuid.UUID) ([]*domain.LotMatch, error)
+	CreateBrokerProfile(ctx context.Context, obj *domain.BrokerProfile) error
+	GetBrokerProfile(ctx context.Context, id uuid.UUID) (*domain.BrokerProfile, error)
+	GetUserBrokerProfile(ctx context.Context, userID uuid.UUID) (*domain.BrokerProfile, error)
+	ListBrokerProfiles(ctx context.Context, userID uuid.UUID) ([]*domain.BrokerProfile, error)
+	UpdateUserBrokerProfile(ctx context.Context, userID, profileID uuid.UUID) error
+	SettleFeeRebates(ctx context.Context, before time.Time) error
+	ListOpenMarginedOrders(ctx context.Context, stockID string) ([]*domain.Order, error)
+	ListDueCorporateActions(ctx context.Context, paymentDate string) ([]*domain.CorporateAction, error)
+	ListEntitledOrders(ctx context.Context, stockID, cutoffDate string) ([]*domain.Order, error)
+	DistributeEntitlement(
+		ctx context.Context,
+		entitlement *domain.Entitlement,
+		order *domain.Order,
+		transactions []*domain.Transaction,
+	) error
+	GetEntitlement(ctx context.Context, id, userID uuid.UUID) (*domain.Entitlement, error)
+	ListEntitlements(ctx context.Context, userID uuid.UUID) ([]*domain.Entitlement, error)
+	SubscribeRights(
+		ctx context.Context,
+		entitlement *domain.Entitlement,
+		order *domain.Order,
+		transactions []*domain.Transaction,
+	) error
+	MarkCorporateActionDistributed(ctx context.Context, id uuid.UUID) error
+	ListOpenPositions(ctx context.Context, userID uuid.UUID) ([]*domain.Order, error)
+	UpsertEquitySnapshot(ctx context.Context, snapshot *domain.EquitySnapshot) error
+	ListEquitySnapshots(ctx context.Context, userID uuid.UUID, startDate, endDate string) ([]*domain.EquitySnapshot, error)
+	GetNetDeposits(ctx context.Context, userID uuid.UUID) (float32, error)
+	CreateJournalEntry(ctx context.Context, obj *domain.JournalEntry) error
+	ListOrderJournalEntries(ctx context.Context, userID, orderID uuid.UUID) ([]*domain.JournalEntry, error)
+	ListUserJournalEntries(ctx context.Context, userID uuid.UUID) ([]*domain.JournalEntry, error)
+	ListUserTransactions(ctx context.Context, userID uuid.UUID, start, end time.Time) ([]*domain.Transaction, error)
+	RelayOutbox(
+		ctx context.Context,
+		limit int,
+		publish func(ctx context.Context, messages []*esdb.OutboxMessage) error,
+	) (int, error)
 }
 
 var _ Adapter = (*Imp)(nil)
@@ -258,9 +334,9 @@ func (a *Imp) GetBalanceView(ctx context.Context, id uuid.UUID) (*domain.Balance
 func (a *Imp) ListSelections(
 	ctx context.Context,
 	date string,
-	strict bool,
+	candidates *domain.SelectionCandidates,
 ) ([]*domain.Selection, error) {
-	return a.repo.ListSelections(ctx, date, strict)
+	return a.repo.ListSelections(ctx, date, candidates)
 }
 
 func (a *Imp) ListSelectionsFromPicked(
@@ -330,3 +406,224 @@ func (a *Imp) GetHighestPrice(
 ) (map[string]float32, error) {
 	return a.repo.GetHighestPrice(ctx, stockIDs, date, rewindWeek)
 }
+
+func (a *Imp) CreateScreen(ctx context.Context, obj *domain.Screen) error {
+	return a.repo.CreateScreen(ctx, obj)
+}
+
+func (a *Imp) ListScreens(ctx context.Context, userID uuid.UUID) ([]*domain.Screen, error) {
+	return a.repo.ListScreens(ctx, userID)
+}
+
+func (a *Imp) GetScreenByID(ctx context.Context, userID, id uuid.UUID) (*domain.Screen, error) {
+	return a.repo.GetScreenByID(ctx, userID, id)
+}
+
+func (a *Imp) ListExchangeDates(
+	ctx context.Context,
+	startDate, endDate string,
+) ([]string, error) {
+	return a.repo.ListExchangeDates(ctx, startDate, endDate)
+}
+
+func (a *Imp) RetrieveDailyCloseBetween(
+	ctx context.Context,
+	stockIDs []string,
+	startDate, endDate string,
+) ([]*domain.DailyClose, error) {
+	return a.repo.RetrieveDailyCloseBetween(ctx, stockIDs, startDate, endDate)
+}
+
+func (a *Imp) BatchUpsertCorporateActions(
+	ctx context.Context,
+	objs []*domain.CorporateAction,
+) error {
+	return a.repo.BatchUpsertCorporateActions(ctx, objs)
+}
+
+func (a *Imp) ListCorporateActions(
+	ctx context.Context,
+	stockIDs []string,
+	startDate, endDate string,
+) ([]*domain.CorporateAction, error) {
+	return a.repo.ListCorporateActions(ctx, stockIDs, startDate, endDate)
+}
+
+func (a *Imp) CreateIntradayTick(ctx context.Context, obj *domain.IntradayTick) error {
+	return a.repo.CreateIntradayTick(ctx, obj)
+}
+
+func (a *Imp) ListIntradayTicks(
+	ctx context.Context,
+	stockID, date string,
+) ([]*domain.IntradayTick, error) {
+	return a.repo.ListIntradayTicks(ctx, stockID, date)
+}
+
+func (a *Imp) CreateAlertRule(ctx context.Context, obj *domain.AlertRule) error {
+	return a.repo.CreateAlertRule(ctx, obj)
+}
+
+func (a *Imp) ListAlertRules(ctx context.Context, userID uuid.UUID) ([]*domain.AlertRule, error) {
+	return a.repo.ListAlertRules(ctx, userID)
+}
+
+func (a *Imp) UpdateAlertRule(ctx context.Context, obj *domain.AlertRule) error {
+	return a.repo.UpdateAlertRule(ctx, obj)
+}
+
+func (a *Imp) DeleteAlertRule(ctx context.Context, userID, id uuid.UUID) error {
+	return a.repo.DeleteAlertRule(ctx, userID, id)
+}
+
+func (a *Imp) ListPendingAlertRules(
+	ctx context.Context,
+	stockID, date string,
+) ([]*domain.AlertRule, error) {
+	return a.repo.ListPendingAlertRules(ctx, stockID, date)
+}
+
+func (a *Imp) MarkAlertRuleTriggered(ctx context.Context, id uuid.UUID, date string) error {
+	return a.repo.MarkAlertRuleTriggered(ctx, id, date)
+}
+
+func (a *Imp) GetOrder(ctx context.Context, id uuid.UUID) (*domain.Order, error) {
+	return a.repo.GetOrder(ctx, id)
+}
+
+func (a *Imp) ListPendingOrders(ctx context.Context, stockIDs []string) ([]*domain.Order, error) {
+	return a.repo.ListPendingOrders(ctx, stockIDs)
+}
+
+func (a *Imp) CancelOrder(ctx context.Context, order *domain.Order) error {
+	return a.repo.CancelOrder(ctx, order)
+}
+
+func (a *Imp) AmendOrder(
+	ctx context.Context,
+	order *domain.Order,
+	transactions []*domain.Transaction,
+) error {
+	return a.repo.AmendOrder(ctx, order, transactions)
+}
+
+func (a *Imp) ListLotMatches(ctx context.Context, orderIDs []uuid.UUID) ([]*domain.LotMatch, error) {
+	return a.repo.ListLotMatches(ctx, orderIDs)
+}
+
+func (a *Imp) CreateBrokerProfile(ctx context.Context, obj *domain.BrokerProfile) error {
+	return a.repo.CreateBrokerProfile(ctx, obj)
+}
+
+func (a *Imp) GetBrokerProfile(ctx context.Context, id uuid.UUID) (*domain.BrokerProfile, error) {
+	return a.repo.GetBrokerProfile(ctx, id)
+}
+
+func (a *Imp) GetUserBrokerProfile(ctx context.Context, userID uuid.UUID) (*domain.BrokerProfile, error) {
+	return a.repo.GetUserBrokerProfile(ctx, userID)
+}
+
+func (a *Imp) ListBrokerProfiles(ctx context.Context, userID uuid.UUID) ([]*domain.BrokerProfile, error) {
+	return a.repo.ListBrokerProfiles(ctx, userID)
+}
+
+func (a *Imp) UpdateUserBrokerProfile(ctx context.Context, userID, profileID uuid.UUID) error {
+	return a.repo.UpdateUserBrokerProfile(ctx, userID, profileID)
+}
+
+func (a *Imp) SettleFeeRebates(ctx context.Context, before time.Time) error {
+	return a.repo.SettleFeeRebates(ctx, before)
+}
+
+func (a *Imp) ListOpenMarginedOrders(ctx context.Context, stockID string) ([]*domain.Order, error) {
+	return a.repo.ListOpenMarginedOrders(ctx, stockID)
+}
+
+func (a *Imp) ListDueCorporateActions(ctx context.Context, paymentDate string) ([]*domain.CorporateAction, error) {
+	return a.repo.ListDueCorporateActions(ctx, paymentDate)
+}
+
+func (a *Imp) ListEntitledOrders(ctx context.Context, stockID, cutoffDate string) ([]*domain.Order, error) {
+	return a.repo.ListEntitledOrders(ctx, stockID, cutoffDate)
+}
+
+func (a *Imp) DistributeEntitlement(
+	ctx context.Context,
+	entitlement *domain.Entitlement,
+	order *domain.Order,
+	transactions []*domain.Transaction,
+) error {
+	return a.repo.DistributeEntitlement(ctx, entitlement, order, transactions)
+}
+
+func (a *Imp) GetEntitlement(ctx context.Context, id, userID uuid.UUID) (*domain.Entitlement, error) {
+	return a.repo.GetEntitlement(ctx, id, userID)
+}
+
+func (a *Imp) ListEntitlements(ctx context.Context, userID uuid.UUID) ([]*domain.Entitlement, error) {
+	return a.repo.ListEntitlements(ctx, userID)
+}
+
+func (a *Imp) SubscribeRights(
+	ctx context.Context,
+	entitlement *domain.Entitlement,
+	order *domain.Order,
+	transactions []*domain.Transaction,
+) error {
+	return a.repo.SubscribeRights(ctx, entitlement, order, transactions)
+}
+
+func (a *Imp) MarkCorporateActionDistributed(ctx context.Context, id uuid.UUID) error {
+	return a.repo.MarkCorporateActionDistributed(ctx, id)
+}
+
+func (a *Imp) ListOpenPositions(ctx context.Context, userID uuid.UUID) ([]*domain.Order, error) {
+	return a.repo.ListOpenPositions(ctx, userID)
+}
+
+func (a *Imp) UpsertEquitySnapshot(ctx context.Context, snapshot *domain.EquitySnapshot) error {
+	return a.repo.UpsertEquitySnapshot(ctx, snapshot)
+}
+
+func (a *Imp) ListEquitySnapshots(
+	ctx context.Context,
+	userID uuid.UUID,
+	startDate, endDate string,
+) ([]*domain.EquitySnapshot, error) {
+	return a.repo.ListEquitySnapshots(ctx, userID, startDate, endDate)
+}
+
+func (a *Imp) GetNetDeposits(ctx context.Context, userID uuid.UUID) (float32, error) {
+	return a.repo.GetNetDeposits(ctx, userID)
+}
+
+func (a *Imp) CreateJournalEntry(ctx context.Context, obj *domain.JournalEntry) error {
+	return a.repo.CreateJournalEntry(ctx, obj)
+}
+
+func (a *Imp) ListOrderJournalEntries(
+	ctx context.Context,
+	userID, orderID uuid.UUID,
+) ([]*domain.JournalEntry, error) {
+	return a.repo.ListOrderJournalEntries(ctx, userID, orderID)
+}
+
+func (a *Imp) ListUserJournalEntries(ctx context.Context, userID uuid.UUID) ([]*domain.JournalEntry, error) {
+	return a.repo.ListUserJournalEntries(ctx, userID)
+}
+
+func (a *Imp) ListUserTransactions(
+	ctx context.Context,
+	userID uuid.UUID,
+	start, end time.Time,
+) ([]*domain.Transaction, error) {
+	return a.repo.ListUserTransactions(ctx, userID, start, end)
+}
+
+func (a *Imp) RelayOutbox(
+	ctx context.Context,
+	limit int,
+	publish func(ctx context.Context, messages []*esdb.OutboxMessage) error,
+) (int, error) {
+	return a.repo.RelayOutbox(ctx, limit, publish)
+}
@@ -0,0 +1,1289 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: adapter.go
+
+// Package adapter is a generated GoMock package.
+package adapter
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/gofrs/uuid/v5"
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/samwang0723/jarvis/internal/app/domain"
+	db "github.com/samwang0723/jarvis/internal/eventsourcing/db"
+)
+
+// MockAdapter is a mock of Adapter interface.
+type MockAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdapterMockRecorder
+}
+
+// MockAdapterMockRecorder is the mock recorder for MockAdapter.
+type MockAdapterMockRecorder struct {
+	mock *MockAdapter
+}
+
+// NewMockAdapter creates a new mock instance.
+func NewMockAdapter(ctrl *gomock.Controller) *MockAdapter {
+	mock := &MockAdapter{ctrl: ctrl}
+	mock.recorder = &MockAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdapter) EXPECT() *MockAdapterMockRecorder {
+	return m.recorder
+}
+
+// AmendOrder mocks base method.
+func (m *MockAdapter) AmendOrder(ctx context.Context, order *domain.Order, transactions []*domain.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AmendOrder", ctx, order, transactions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AmendOrder indicates an expected call of AmendOrder.
+func (mr *MockAdapterMockRecorder) AmendOrder(ctx, order, transactions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AmendOrder", reflect.TypeOf((*MockAdapter)(nil).AmendOrder), ctx, order, transactions)
+}
+
+// BatchUpsertCorporateActions mocks base method.
+func (m *MockAdapter) BatchUpsertCorporateActions(ctx context.Context, objs []*domain.CorporateAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpsertCorporateActions", ctx, objs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchUpsertCorporateActions indicates an expected call of BatchUpsertCorporateActions.
+func (mr *MockAdapterMockRecorder) BatchUpsertCorporateActions(ctx, objs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpsertCorporateActions", reflect.TypeOf((*MockAdapter)(nil).BatchUpsertCorporateActions), ctx, objs)
+}
+
+// BatchUpsertDailyClose mocks base method.
+func (m *MockAdapter) BatchUpsertDailyClose(ctx context.Context, objs []*domain.DailyClose) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpsertDailyClose", ctx, objs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchUpsertDailyClose indicates an expected call of BatchUpsertDailyClose.
+func (mr *MockAdapterMockRecorder) BatchUpsertDailyClose(ctx, objs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpsertDailyClose", reflect.TypeOf((*MockAdapter)(nil).BatchUpsertDailyClose), ctx, objs)
+}
+
+// BatchUpsertStakeConcentration mocks base method.
+func (m *MockAdapter) BatchUpsertStakeConcentration(ctx context.Context, objs []*domain.StakeConcentration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpsertStakeConcentration", ctx, objs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchUpsertStakeConcentration indicates an expected call of BatchUpsertStakeConcentration.
+func (mr *MockAdapterMockRecorder) BatchUpsertStakeConcentration(ctx, objs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpsertStakeConcentration", reflect.TypeOf((*MockAdapter)(nil).BatchUpsertStakeConcentration), ctx, objs)
+}
+
+// BatchUpsertStocks mocks base method.
+func (m *MockAdapter) BatchUpsertStocks(ctx context.Context, objs []*domain.Stock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpsertStocks", ctx, objs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchUpsertStocks indicates an expected call of BatchUpsertStocks.
+func (mr *MockAdapterMockRecorder) BatchUpsertStocks(ctx, objs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpsertStocks", reflect.TypeOf((*MockAdapter)(nil).BatchUpsertStocks), ctx, objs)
+}
+
+// BatchUpsertThreePrimary mocks base method.
+func (m *MockAdapter) BatchUpsertThreePrimary(ctx context.Context, objs []*domain.ThreePrimary) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpsertThreePrimary", ctx, objs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchUpsertThreePrimary indicates an expected call of BatchUpsertThreePrimary.
+func (mr *MockAdapterMockRecorder) BatchUpsertThreePrimary(ctx, objs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpsertThreePrimary", reflect.TypeOf((*MockAdapter)(nil).BatchUpsertThreePrimary), ctx, objs)
+}
+
+// CancelOrder mocks base method.
+func (m *MockAdapter) CancelOrder(ctx context.Context, order *domain.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrder", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelOrder indicates an expected call of CancelOrder.
+func (mr *MockAdapterMockRecorder) CancelOrder(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrder", reflect.TypeOf((*MockAdapter)(nil).CancelOrder), ctx, order)
+}
+
+// CreateAlertRule mocks base method.
+func (m *MockAdapter) CreateAlertRule(ctx context.Context, obj *domain.AlertRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlertRule", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAlertRule indicates an expected call of CreateAlertRule.
+func (mr *MockAdapterMockRecorder) CreateAlertRule(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertRule", reflect.TypeOf((*MockAdapter)(nil).CreateAlertRule), ctx, obj)
+}
+
+// CreateBrokerProfile mocks base method.
+func (m *MockAdapter) CreateBrokerProfile(ctx context.Context, obj *domain.BrokerProfile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBrokerProfile", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBrokerProfile indicates an expected call of CreateBrokerProfile.
+func (mr *MockAdapterMockRecorder) CreateBrokerProfile(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBrokerProfile", reflect.TypeOf((*MockAdapter)(nil).CreateBrokerProfile), ctx, obj)
+}
+
+// CreateDailyClose mocks base method.
+func (m *MockAdapter) CreateDailyClose(ctx context.Context, obj *domain.DailyClose) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDailyClose", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDailyClose indicates an expected call of CreateDailyClose.
+func (mr *MockAdapterMockRecorder) CreateDailyClose(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDailyClose", reflect.TypeOf((*MockAdapter)(nil).CreateDailyClose), ctx, obj)
+}
+
+// CreateIntradayTick mocks base method.
+func (m *MockAdapter) CreateIntradayTick(ctx context.Context, obj *domain.IntradayTick) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIntradayTick", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIntradayTick indicates an expected call of CreateIntradayTick.
+func (mr *MockAdapterMockRecorder) CreateIntradayTick(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIntradayTick", reflect.TypeOf((*MockAdapter)(nil).CreateIntradayTick), ctx, obj)
+}
+
+// CreateJournalEntry mocks base method.
+func (m *MockAdapter) CreateJournalEntry(ctx context.Context, obj *domain.JournalEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJournalEntry", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateJournalEntry indicates an expected call of CreateJournalEntry.
+func (mr *MockAdapterMockRecorder) CreateJournalEntry(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJournalEntry", reflect.TypeOf((*MockAdapter)(nil).CreateJournalEntry), ctx, obj)
+}
+
+// CreateOrder mocks base method.
+func (m *MockAdapter) CreateOrder(ctx context.Context, orders []*domain.Order, transactions []*domain.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrder", ctx, orders, transactions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrder indicates an expected call of CreateOrder.
+func (mr *MockAdapterMockRecorder) CreateOrder(ctx, orders, transactions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockAdapter)(nil).CreateOrder), ctx, orders, transactions)
+}
+
+// CreatePickedStocks mocks base method.
+func (m *MockAdapter) CreatePickedStocks(ctx context.Context, objs []*domain.PickedStock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePickedStocks", ctx, objs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePickedStocks indicates an expected call of CreatePickedStocks.
+func (mr *MockAdapterMockRecorder) CreatePickedStocks(ctx, objs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePickedStocks", reflect.TypeOf((*MockAdapter)(nil).CreatePickedStocks), ctx, objs)
+}
+
+// CreateScreen mocks base method.
+func (m *MockAdapter) CreateScreen(ctx context.Context, obj *domain.Screen) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScreen", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateScreen indicates an expected call of CreateScreen.
+func (mr *MockAdapterMockRecorder) CreateScreen(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScreen", reflect.TypeOf((*MockAdapter)(nil).CreateScreen), ctx, obj)
+}
+
+// CreateStock mocks base method.
+func (m *MockAdapter) CreateStock(ctx context.Context, obj *domain.Stock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStock", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateStock indicates an expected call of CreateStock.
+func (mr *MockAdapterMockRecorder) CreateStock(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStock", reflect.TypeOf((*MockAdapter)(nil).CreateStock), ctx, obj)
+}
+
+// CreateThreePrimary mocks base method.
+func (m *MockAdapter) CreateThreePrimary(ctx context.Context, arg *domain.ThreePrimary) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateThreePrimary", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateThreePrimary indicates an expected call of CreateThreePrimary.
+func (mr *MockAdapterMockRecorder) CreateThreePrimary(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateThreePrimary", reflect.TypeOf((*MockAdapter)(nil).CreateThreePrimary), ctx, arg)
+}
+
+// CreateTransaction mocks base method.
+func (m *MockAdapter) CreateTransaction(ctx context.Context, transaction *domain.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransaction", ctx, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTransaction indicates an expected call of CreateTransaction.
+func (mr *MockAdapterMockRecorder) CreateTransaction(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockAdapter)(nil).CreateTransaction), ctx, transaction)
+}
+
+// CreateUser mocks base method.
+func (m *MockAdapter) CreateUser(ctx context.Context, obj *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockAdapterMockRecorder) CreateUser(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockAdapter)(nil).CreateUser), ctx, obj)
+}
+
+// DeleteAlertRule mocks base method.
+func (m *MockAdapter) DeleteAlertRule(ctx context.Context, userID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertRule", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlertRule indicates an expected call of DeleteAlertRule.
+func (mr *MockAdapterMockRecorder) DeleteAlertRule(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertRule", reflect.TypeOf((*MockAdapter)(nil).DeleteAlertRule), ctx, userID, id)
+}
+
+// DeletePickedStock mocks base method.
+func (m *MockAdapter) DeletePickedStock(ctx context.Context, userID uuid.UUID, stockID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePickedStock", ctx, userID, stockID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePickedStock indicates an expected call of DeletePickedStock.
+func (mr *MockAdapterMockRecorder) DeletePickedStock(ctx, userID, stockID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePickedStock", reflect.TypeOf((*MockAdapter)(nil).DeletePickedStock), ctx, userID, stockID)
+}
+
+// DeleteSessionID mocks base method.
+func (m *MockAdapter) DeleteSessionID(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessionID", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSessionID indicates an expected call of DeleteSessionID.
+func (mr *MockAdapterMockRecorder) DeleteSessionID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionID", reflect.TypeOf((*MockAdapter)(nil).DeleteSessionID), ctx, userID)
+}
+
+// DeleteStockByID mocks base method.
+func (m *MockAdapter) DeleteStockByID(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStockByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStockByID indicates an expected call of DeleteStockByID.
+func (mr *MockAdapterMockRecorder) DeleteStockByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStockByID", reflect.TypeOf((*MockAdapter)(nil).DeleteStockByID), ctx, id)
+}
+
+// DeleteUserByID mocks base method.
+func (m *MockAdapter) DeleteUserByID(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserByID", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserByID indicates an expected call of DeleteUserByID.
+func (mr *MockAdapterMockRecorder) DeleteUserByID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserByID", reflect.TypeOf((*MockAdapter)(nil).DeleteUserByID), ctx, userID)
+}
+
+// DistributeEntitlement mocks base method.
+func (m *MockAdapter) DistributeEntitlement(ctx context.Context, entitlement *domain.Entitlement, order *domain.Order, transactions []*domain.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DistributeEntitlement", ctx, entitlement, order, transactions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeEntitlement indicates an expected call of DistributeEntitlement.
+func (mr *MockAdapterMockRecorder) DistributeEntitlement(ctx, entitlement, order, transactions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeEntitlement", reflect.TypeOf((*MockAdapter)(nil).DistributeEntitlement), ctx, entitlement, order, transactions)
+}
+
+// GetBalanceView mocks base method.
+func (m *MockAdapter) GetBalanceView(ctx context.Context, id uuid.UUID) (*domain.BalanceView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceView", ctx, id)
+	ret0, _ := ret[0].(*domain.BalanceView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalanceView indicates an expected call of GetBalanceView.
+func (mr *MockAdapterMockRecorder) GetBalanceView(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceView", reflect.TypeOf((*MockAdapter)(nil).GetBalanceView), ctx, id)
+}
+
+// GetBrokerProfile mocks base method.
+func (m *MockAdapter) GetBrokerProfile(ctx context.Context, id uuid.UUID) (*domain.BrokerProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrokerProfile", ctx, id)
+	ret0, _ := ret[0].(*domain.BrokerProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBrokerProfile indicates an expected call of GetBrokerProfile.
+func (mr *MockAdapterMockRecorder) GetBrokerProfile(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrokerProfile", reflect.TypeOf((*MockAdapter)(nil).GetBrokerProfile), ctx, id)
+}
+
+// GetEntitlement mocks base method.
+func (m *MockAdapter) GetEntitlement(ctx context.Context, id, userID uuid.UUID) (*domain.Entitlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntitlement", ctx, id, userID)
+	ret0, _ := ret[0].(*domain.Entitlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntitlement indicates an expected call of GetEntitlement.
+func (mr *MockAdapterMockRecorder) GetEntitlement(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntitlement", reflect.TypeOf((*MockAdapter)(nil).GetEntitlement), ctx, id, userID)
+}
+
+// GetHighestPrice mocks base method.
+func (m *MockAdapter) GetHighestPrice(ctx context.Context, stockIDs []string, date string, rewindWeek int) (map[string]float32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHighestPrice", ctx, stockIDs, date, rewindWeek)
+	ret0, _ := ret[0].(map[string]float32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHighestPrice indicates an expected call of GetHighestPrice.
+func (mr *MockAdapterMockRecorder) GetHighestPrice(ctx, stockIDs, date, rewindWeek interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHighestPrice", reflect.TypeOf((*MockAdapter)(nil).GetHighestPrice), ctx, stockIDs, date, rewindWeek)
+}
+
+// GetNetDeposits mocks base method.
+func (m *MockAdapter) GetNetDeposits(ctx context.Context, userID uuid.UUID) (float32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetDeposits", ctx, userID)
+	ret0, _ := ret[0].(float32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetDeposits indicates an expected call of GetNetDeposits.
+func (mr *MockAdapterMockRecorder) GetNetDeposits(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetDeposits", reflect.TypeOf((*MockAdapter)(nil).GetNetDeposits), ctx, userID)
+}
+
+// GetOrder mocks base method.
+func (m *MockAdapter) GetOrder(ctx context.Context, id uuid.UUID) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrder", ctx, id)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrder indicates an expected call of GetOrder.
+func (mr *MockAdapterMockRecorder) GetOrder(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockAdapter)(nil).GetOrder), ctx, id)
+}
+
+// GetRealTimeMonitoringKeys mocks base method.
+func (m *MockAdapter) GetRealTimeMonitoringKeys(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRealTimeMonitoringKeys", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRealTimeMonitoringKeys indicates an expected call of GetRealTimeMonitoringKeys.
+func (mr *MockAdapterMockRecorder) GetRealTimeMonitoringKeys(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRealTimeMonitoringKeys", reflect.TypeOf((*MockAdapter)(nil).GetRealTimeMonitoringKeys), ctx)
+}
+
+// GetScreenByID mocks base method.
+func (m *MockAdapter) GetScreenByID(ctx context.Context, userID, id uuid.UUID) (*domain.Screen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScreenByID", ctx, userID, id)
+	ret0, _ := ret[0].(*domain.Screen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScreenByID indicates an expected call of GetScreenByID.
+func (mr *MockAdapterMockRecorder) GetScreenByID(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScreenByID", reflect.TypeOf((*MockAdapter)(nil).GetScreenByID), ctx, userID, id)
+}
+
+// GetStakeConcentrationByStockID mocks base method.
+func (m *MockAdapter) GetStakeConcentrationByStockID(ctx context.Context, stockID, date string) (*domain.StakeConcentration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakeConcentrationByStockID", ctx, stockID, date)
+	ret0, _ := ret[0].(*domain.StakeConcentration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStakeConcentrationByStockID indicates an expected call of GetStakeConcentrationByStockID.
+func (mr *MockAdapterMockRecorder) GetStakeConcentrationByStockID(ctx, stockID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakeConcentrationByStockID", reflect.TypeOf((*MockAdapter)(nil).GetStakeConcentrationByStockID), ctx, stockID, date)
+}
+
+// GetStakeConcentrationLatestDataPoint mocks base method.
+func (m *MockAdapter) GetStakeConcentrationLatestDataPoint(ctx context.Context) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakeConcentrationLatestDataPoint", ctx)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetStakeConcentrationLatestDataPoint indicates an expected call of GetStakeConcentrationLatestDataPoint.
+func (mr *MockAdapterMockRecorder) GetStakeConcentrationLatestDataPoint(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakeConcentrationLatestDataPoint", reflect.TypeOf((*MockAdapter)(nil).GetStakeConcentrationLatestDataPoint), ctx)
+}
+
+// GetStakeConcentrationsWithVolumes mocks base method.
+func (m *MockAdapter) GetStakeConcentrationsWithVolumes(ctx context.Context, stockID, date string) ([]*domain.CalculationBase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakeConcentrationsWithVolumes", ctx, stockID, date)
+	ret0, _ := ret[0].([]*domain.CalculationBase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStakeConcentrationsWithVolumes indicates an expected call of GetStakeConcentrationsWithVolumes.
+func (mr *MockAdapterMockRecorder) GetStakeConcentrationsWithVolumes(ctx, stockID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakeConcentrationsWithVolumes", reflect.TypeOf((*MockAdapter)(nil).GetStakeConcentrationsWithVolumes), ctx, stockID, date)
+}
+
+// GetUserBrokerProfile mocks base method.
+func (m *MockAdapter) GetUserBrokerProfile(ctx context.Context, userID uuid.UUID) (*domain.BrokerProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserBrokerProfile", ctx, userID)
+	ret0, _ := ret[0].(*domain.BrokerProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserBrokerProfile indicates an expected call of GetUserBrokerProfile.
+func (mr *MockAdapterMockRecorder) GetUserBrokerProfile(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBrokerProfile", reflect.TypeOf((*MockAdapter)(nil).GetUserBrokerProfile), ctx, userID)
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockAdapter) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockAdapterMockRecorder) GetUserByEmail(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockAdapter)(nil).GetUserByEmail), ctx, email)
+}
+
+// GetUserByID mocks base method.
+func (m *MockAdapter) GetUserByID(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, userID)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockAdapterMockRecorder) GetUserByID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockAdapter)(nil).GetUserByID), ctx, userID)
+}
+
+// GetUserByPhone mocks base method.
+func (m *MockAdapter) GetUserByPhone(ctx context.Context, phone string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByPhone", ctx, phone)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByPhone indicates an expected call of GetUserByPhone.
+func (mr *MockAdapterMockRecorder) GetUserByPhone(ctx, phone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByPhone", reflect.TypeOf((*MockAdapter)(nil).GetUserByPhone), ctx, phone)
+}
+
+// HasDailyClose mocks base method.
+func (m *MockAdapter) HasDailyClose(ctx context.Context, date string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDailyClose", ctx, date)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasDailyClose indicates an expected call of HasDailyClose.
+func (mr *MockAdapterMockRecorder) HasDailyClose(ctx, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDailyClose", reflect.TypeOf((*MockAdapter)(nil).HasDailyClose), ctx, date)
+}
+
+// HasStakeConcentration mocks base method.
+func (m *MockAdapter) HasStakeConcentration(ctx context.Context, exchangeDate string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasStakeConcentration", ctx, exchangeDate)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasStakeConcentration indicates an expected call of HasStakeConcentration.
+func (mr *MockAdapterMockRecorder) HasStakeConcentration(ctx, exchangeDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasStakeConcentration", reflect.TypeOf((*MockAdapter)(nil).HasStakeConcentration), ctx, exchangeDate)
+}
+
+// LatestStockStatSnapshot mocks base method.
+func (m *MockAdapter) LatestStockStatSnapshot(ctx context.Context) ([]*domain.Selection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestStockStatSnapshot", ctx)
+	ret0, _ := ret[0].([]*domain.Selection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestStockStatSnapshot indicates an expected call of LatestStockStatSnapshot.
+func (mr *MockAdapterMockRecorder) LatestStockStatSnapshot(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestStockStatSnapshot", reflect.TypeOf((*MockAdapter)(nil).LatestStockStatSnapshot), ctx)
+}
+
+// ListAlertRules mocks base method.
+func (m *MockAdapter) ListAlertRules(ctx context.Context, userID uuid.UUID) ([]*domain.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertRules", ctx, userID)
+	ret0, _ := ret[0].([]*domain.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertRules indicates an expected call of ListAlertRules.
+func (mr *MockAdapterMockRecorder) ListAlertRules(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertRules", reflect.TypeOf((*MockAdapter)(nil).ListAlertRules), ctx, userID)
+}
+
+// ListBrokerProfiles mocks base method.
+func (m *MockAdapter) ListBrokerProfiles(ctx context.Context, userID uuid.UUID) ([]*domain.BrokerProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBrokerProfiles", ctx, userID)
+	ret0, _ := ret[0].([]*domain.BrokerProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBrokerProfiles indicates an expected call of ListBrokerProfiles.
+func (mr *MockAdapterMockRecorder) ListBrokerProfiles(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrokerProfiles", reflect.TypeOf((*MockAdapter)(nil).ListBrokerProfiles), ctx, userID)
+}
+
+// ListCategories mocks base method.
+func (m *MockAdapter) ListCategories(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockAdapterMockRecorder) ListCategories(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockAdapter)(nil).ListCategories), ctx)
+}
+
+// ListCorporateActions mocks base method.
+func (m *MockAdapter) ListCorporateActions(ctx context.Context, stockIDs []string, startDate, endDate string) ([]*domain.CorporateAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCorporateActions", ctx, stockIDs, startDate, endDate)
+	ret0, _ := ret[0].([]*domain.CorporateAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCorporateActions indicates an expected call of ListCorporateActions.
+func (mr *MockAdapterMockRecorder) ListCorporateActions(ctx, stockIDs, startDate, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCorporateActions", reflect.TypeOf((*MockAdapter)(nil).ListCorporateActions), ctx, stockIDs, startDate, endDate)
+}
+
+// ListDailyClose mocks base method.
+func (m *MockAdapter) ListDailyClose(ctx context.Context, arg *domain.ListDailyCloseParams) ([]*domain.DailyClose, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDailyClose", ctx, arg)
+	ret0, _ := ret[0].([]*domain.DailyClose)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDailyClose indicates an expected call of ListDailyClose.
+func (mr *MockAdapterMockRecorder) ListDailyClose(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDailyClose", reflect.TypeOf((*MockAdapter)(nil).ListDailyClose), ctx, arg)
+}
+
+// ListDueCorporateActions mocks base method.
+func (m *MockAdapter) ListDueCorporateActions(ctx context.Context, paymentDate string) ([]*domain.CorporateAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueCorporateActions", ctx, paymentDate)
+	ret0, _ := ret[0].([]*domain.CorporateAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueCorporateActions indicates an expected call of ListDueCorporateActions.
+func (mr *MockAdapterMockRecorder) ListDueCorporateActions(ctx, paymentDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueCorporateActions", reflect.TypeOf((*MockAdapter)(nil).ListDueCorporateActions), ctx, paymentDate)
+}
+
+// ListEntitledOrders mocks base method.
+func (m *MockAdapter) ListEntitledOrders(ctx context.Context, stockID, cutoffDate string) ([]*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntitledOrders", ctx, stockID, cutoffDate)
+	ret0, _ := ret[0].([]*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntitledOrders indicates an expected call of ListEntitledOrders.
+func (mr *MockAdapterMockRecorder) ListEntitledOrders(ctx, stockID, cutoffDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntitledOrders", reflect.TypeOf((*MockAdapter)(nil).ListEntitledOrders), ctx, stockID, cutoffDate)
+}
+
+// ListEntitlements mocks base method.
+func (m *MockAdapter) ListEntitlements(ctx context.Context, userID uuid.UUID) ([]*domain.Entitlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntitlements", ctx, userID)
+	ret0, _ := ret[0].([]*domain.Entitlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntitlements indicates an expected call of ListEntitlements.
+func (mr *MockAdapterMockRecorder) ListEntitlements(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntitlements", reflect.TypeOf((*MockAdapter)(nil).ListEntitlements), ctx, userID)
+}
+
+// ListEquitySnapshots mocks base method.
+func (m *MockAdapter) ListEquitySnapshots(ctx context.Context, userID uuid.UUID, startDate, endDate string) ([]*domain.EquitySnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEquitySnapshots", ctx, userID, startDate, endDate)
+	ret0, _ := ret[0].([]*domain.EquitySnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEquitySnapshots indicates an expected call of ListEquitySnapshots.
+func (mr *MockAdapterMockRecorder) ListEquitySnapshots(ctx, userID, startDate, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEquitySnapshots", reflect.TypeOf((*MockAdapter)(nil).ListEquitySnapshots), ctx, userID, startDate, endDate)
+}
+
+// ListExchangeDates mocks base method.
+func (m *MockAdapter) ListExchangeDates(ctx context.Context, startDate, endDate string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExchangeDates", ctx, startDate, endDate)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExchangeDates indicates an expected call of ListExchangeDates.
+func (mr *MockAdapterMockRecorder) ListExchangeDates(ctx, startDate, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExchangeDates", reflect.TypeOf((*MockAdapter)(nil).ListExchangeDates), ctx, startDate, endDate)
+}
+
+// ListIntradayTicks mocks base method.
+func (m *MockAdapter) ListIntradayTicks(ctx context.Context, stockID, date string) ([]*domain.IntradayTick, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIntradayTicks", ctx, stockID, date)
+	ret0, _ := ret[0].([]*domain.IntradayTick)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIntradayTicks indicates an expected call of ListIntradayTicks.
+func (mr *MockAdapterMockRecorder) ListIntradayTicks(ctx, stockID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIntradayTicks", reflect.TypeOf((*MockAdapter)(nil).ListIntradayTicks), ctx, stockID, date)
+}
+
+// ListLatestPrice mocks base method.
+func (m *MockAdapter) ListLatestPrice(ctx context.Context, stockIDs []string) ([]*domain.StockPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLatestPrice", ctx, stockIDs)
+	ret0, _ := ret[0].([]*domain.StockPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLatestPrice indicates an expected call of ListLatestPrice.
+func (mr *MockAdapterMockRecorder) ListLatestPrice(ctx, stockIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLatestPrice", reflect.TypeOf((*MockAdapter)(nil).ListLatestPrice), ctx, stockIDs)
+}
+
+// ListLotMatches mocks base method.
+func (m *MockAdapter) ListLotMatches(ctx context.Context, orderIDs []uuid.UUID) ([]*domain.LotMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLotMatches", ctx, orderIDs)
+	ret0, _ := ret[0].([]*domain.LotMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLotMatches indicates an expected call of ListLotMatches.
+func (mr *MockAdapterMockRecorder) ListLotMatches(ctx, orderIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLotMatches", reflect.TypeOf((*MockAdapter)(nil).ListLotMatches), ctx, orderIDs)
+}
+
+// ListOpenMarginedOrders mocks base method.
+func (m *MockAdapter) ListOpenMarginedOrders(ctx context.Context, stockID string) ([]*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenMarginedOrders", ctx, stockID)
+	ret0, _ := ret[0].([]*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenMarginedOrders indicates an expected call of ListOpenMarginedOrders.
+func (mr *MockAdapterMockRecorder) ListOpenMarginedOrders(ctx, stockID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenMarginedOrders", reflect.TypeOf((*MockAdapter)(nil).ListOpenMarginedOrders), ctx, stockID)
+}
+
+// ListOpenOrders mocks base method.
+func (m *MockAdapter) ListOpenOrders(ctx context.Context, userID uuid.UUID, stockID, orderType string) ([]*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenOrders", ctx, userID, stockID, orderType)
+	ret0, _ := ret[0].([]*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenOrders indicates an expected call of ListOpenOrders.
+func (mr *MockAdapterMockRecorder) ListOpenOrders(ctx, userID, stockID, orderType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenOrders", reflect.TypeOf((*MockAdapter)(nil).ListOpenOrders), ctx, userID, stockID, orderType)
+}
+
+// ListOpenPositions mocks base method.
+func (m *MockAdapter) ListOpenPositions(ctx context.Context, userID uuid.UUID) ([]*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenPositions", ctx, userID)
+	ret0, _ := ret[0].([]*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenPositions indicates an expected call of ListOpenPositions.
+func (mr *MockAdapterMockRecorder) ListOpenPositions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenPositions", reflect.TypeOf((*MockAdapter)(nil).ListOpenPositions), ctx, userID)
+}
+
+// ListOrderJournalEntries mocks base method.
+func (m *MockAdapter) ListOrderJournalEntries(ctx context.Context, userID, orderID uuid.UUID) ([]*domain.JournalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrderJournalEntries", ctx, userID, orderID)
+	ret0, _ := ret[0].([]*domain.JournalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrderJournalEntries indicates an expected call of ListOrderJournalEntries.
+func (mr *MockAdapterMockRecorder) ListOrderJournalEntries(ctx, userID, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrderJournalEntries", reflect.TypeOf((*MockAdapter)(nil).ListOrderJournalEntries), ctx, userID, orderID)
+}
+
+// ListOrders mocks base method.
+func (m *MockAdapter) ListOrders(ctx context.Context, arg *domain.ListOrdersParams) ([]*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrders", ctx, arg)
+	ret0, _ := ret[0].([]*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrders indicates an expected call of ListOrders.
+func (mr *MockAdapterMockRecorder) ListOrders(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockAdapter)(nil).ListOrders), ctx, arg)
+}
+
+// ListPendingAlertRules mocks base method.
+func (m *MockAdapter) ListPendingAlertRules(ctx context.Context, stockID, date string) ([]*domain.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingAlertRules", ctx, stockID, date)
+	ret0, _ := ret[0].([]*domain.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingAlertRules indicates an expected call of ListPendingAlertRules.
+func (mr *MockAdapterMockRecorder) ListPendingAlertRules(ctx, stockID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingAlertRules", reflect.TypeOf((*MockAdapter)(nil).ListPendingAlertRules), ctx, stockID, date)
+}
+
+// ListPendingOrders mocks base method.
+func (m *MockAdapter) ListPendingOrders(ctx context.Context, stockIDs []string) ([]*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingOrders", ctx, stockIDs)
+	ret0, _ := ret[0].([]*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingOrders indicates an expected call of ListPendingOrders.
+func (mr *MockAdapterMockRecorder) ListPendingOrders(ctx, stockIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingOrders", reflect.TypeOf((*MockAdapter)(nil).ListPendingOrders), ctx, stockIDs)
+}
+
+// ListPickedStocks mocks base method.
+func (m *MockAdapter) ListPickedStocks(ctx context.Context, userID uuid.UUID) ([]domain.PickedStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPickedStocks", ctx, userID)
+	ret0, _ := ret[0].([]domain.PickedStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPickedStocks indicates an expected call of ListPickedStocks.
+func (mr *MockAdapterMockRecorder) ListPickedStocks(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPickedStocks", reflect.TypeOf((*MockAdapter)(nil).ListPickedStocks), ctx, userID)
+}
+
+// ListScreens mocks base method.
+func (m *MockAdapter) ListScreens(ctx context.Context, userID uuid.UUID) ([]*domain.Screen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScreens", ctx, userID)
+	ret0, _ := ret[0].([]*domain.Screen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScreens indicates an expected call of ListScreens.
+func (mr *MockAdapterMockRecorder) ListScreens(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScreens", reflect.TypeOf((*MockAdapter)(nil).ListScreens), ctx, userID)
+}
+
+// ListSelections mocks base method.
+func (m *MockAdapter) ListSelections(ctx context.Context, date string, candidates *domain.SelectionCandidates) ([]*domain.Selection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSelections", ctx, date, candidates)
+	ret0, _ := ret[0].([]*domain.Selection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSelections indicates an expected call of ListSelections.
+func (mr *MockAdapterMockRecorder) ListSelections(ctx, date, candidates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSelections", reflect.TypeOf((*MockAdapter)(nil).ListSelections), ctx, date, candidates)
+}
+
+// ListSelectionsFromPicked mocks base method.
+func (m *MockAdapter) ListSelectionsFromPicked(ctx context.Context, stockIDs []string) ([]*domain.Selection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSelectionsFromPicked", ctx, stockIDs)
+	ret0, _ := ret[0].([]*domain.Selection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSelectionsFromPicked indicates an expected call of ListSelectionsFromPicked.
+func (mr *MockAdapterMockRecorder) ListSelectionsFromPicked(ctx, stockIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSelectionsFromPicked", reflect.TypeOf((*MockAdapter)(nil).ListSelectionsFromPicked), ctx, stockIDs)
+}
+
+// ListStocks mocks base method.
+func (m *MockAdapter) ListStocks(ctx context.Context, arg *domain.ListStocksParams) ([]*domain.Stock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStocks", ctx, arg)
+	ret0, _ := ret[0].([]*domain.Stock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStocks indicates an expected call of ListStocks.
+func (mr *MockAdapterMockRecorder) ListStocks(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStocks", reflect.TypeOf((*MockAdapter)(nil).ListStocks), ctx, arg)
+}
+
+// ListThreePrimary mocks base method.
+func (m *MockAdapter) ListThreePrimary(ctx context.Context, arg *domain.ListThreePrimaryParams) ([]*domain.ThreePrimary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListThreePrimary", ctx, arg)
+	ret0, _ := ret[0].([]*domain.ThreePrimary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListThreePrimary indicates an expected call of ListThreePrimary.
+func (mr *MockAdapterMockRecorder) ListThreePrimary(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListThreePrimary", reflect.TypeOf((*MockAdapter)(nil).ListThreePrimary), ctx, arg)
+}
+
+// ListUserJournalEntries mocks base method.
+func (m *MockAdapter) ListUserJournalEntries(ctx context.Context, userID uuid.UUID) ([]*domain.JournalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserJournalEntries", ctx, userID)
+	ret0, _ := ret[0].([]*domain.JournalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserJournalEntries indicates an expected call of ListUserJournalEntries.
+func (mr *MockAdapterMockRecorder) ListUserJournalEntries(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserJournalEntries", reflect.TypeOf((*MockAdapter)(nil).ListUserJournalEntries), ctx, userID)
+}
+
+// ListUserTransactions mocks base method.
+func (m *MockAdapter) ListUserTransactions(ctx context.Context, userID uuid.UUID, start, end time.Time) ([]*domain.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserTransactions", ctx, userID, start, end)
+	ret0, _ := ret[0].([]*domain.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserTransactions indicates an expected call of ListUserTransactions.
+func (mr *MockAdapterMockRecorder) ListUserTransactions(ctx, userID, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserTransactions", reflect.TypeOf((*MockAdapter)(nil).ListUserTransactions), ctx, userID, start, end)
+}
+
+// ListUsers mocks base method.
+func (m *MockAdapter) ListUsers(ctx context.Context, limit, offset int32) ([]*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, limit, offset)
+	ret0, _ := ret[0].([]*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockAdapterMockRecorder) ListUsers(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockAdapter)(nil).ListUsers), ctx, limit, offset)
+}
+
+// MarkAlertRuleTriggered mocks base method.
+func (m *MockAdapter) MarkAlertRuleTriggered(ctx context.Context, id uuid.UUID, date string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAlertRuleTriggered", ctx, id, date)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAlertRuleTriggered indicates an expected call of MarkAlertRuleTriggered.
+func (mr *MockAdapterMockRecorder) MarkAlertRuleTriggered(ctx, id, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAlertRuleTriggered", reflect.TypeOf((*MockAdapter)(nil).MarkAlertRuleTriggered), ctx, id, date)
+}
+
+// MarkCorporateActionDistributed mocks base method.
+func (m *MockAdapter) MarkCorporateActionDistributed(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkCorporateActionDistributed", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkCorporateActionDistributed indicates an expected call of MarkCorporateActionDistributed.
+func (mr *MockAdapterMockRecorder) MarkCorporateActionDistributed(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkCorporateActionDistributed", reflect.TypeOf((*MockAdapter)(nil).MarkCorporateActionDistributed), ctx, id)
+}
+
+// RelayOutbox mocks base method.
+func (m *MockAdapter) RelayOutbox(ctx context.Context, limit int, publish func(context.Context, []*db.OutboxMessage) error) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayOutbox", ctx, limit, publish)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelayOutbox indicates an expected call of RelayOutbox.
+func (mr *MockAdapterMockRecorder) RelayOutbox(ctx, limit, publish interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayOutbox", reflect.TypeOf((*MockAdapter)(nil).RelayOutbox), ctx, limit, publish)
+}
+
+// RetrieveDailyCloseBetween mocks base method.
+func (m *MockAdapter) RetrieveDailyCloseBetween(ctx context.Context, stockIDs []string, startDate, endDate string) ([]*domain.DailyClose, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveDailyCloseBetween", ctx, stockIDs, startDate, endDate)
+	ret0, _ := ret[0].([]*domain.DailyClose)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveDailyCloseBetween indicates an expected call of RetrieveDailyCloseBetween.
+func (mr *MockAdapterMockRecorder) RetrieveDailyCloseBetween(ctx, stockIDs, startDate, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveDailyCloseBetween", reflect.TypeOf((*MockAdapter)(nil).RetrieveDailyCloseBetween), ctx, stockIDs, startDate, endDate)
+}
+
+// RetrieveDailyCloseHistory mocks base method.
+func (m *MockAdapter) RetrieveDailyCloseHistory(ctx context.Context, stockIDs []string, opts ...string) ([]*domain.DailyClose, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, stockIDs}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RetrieveDailyCloseHistory", varargs...)
+	ret0, _ := ret[0].([]*domain.DailyClose)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveDailyCloseHistory indicates an expected call of RetrieveDailyCloseHistory.
+func (mr *MockAdapterMockRecorder) RetrieveDailyCloseHistory(ctx, stockIDs interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, stockIDs}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveDailyCloseHistory", reflect.TypeOf((*MockAdapter)(nil).RetrieveDailyCloseHistory), varargs...)
+}
+
+// RetrieveThreePrimaryHistory mocks base method.
+func (m *MockAdapter) RetrieveThreePrimaryHistory(ctx context.Context, stockIDs []string, opts ...string) ([]*domain.ThreePrimary, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, stockIDs}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RetrieveThreePrimaryHistory", varargs...)
+	ret0, _ := ret[0].([]*domain.ThreePrimary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveThreePrimaryHistory indicates an expected call of RetrieveThreePrimaryHistory.
+func (mr *MockAdapterMockRecorder) RetrieveThreePrimaryHistory(ctx, stockIDs interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, stockIDs}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveThreePrimaryHistory", reflect.TypeOf((*MockAdapter)(nil).RetrieveThreePrimaryHistory), varargs...)
+}
+
+// SettleFeeRebates mocks base method.
+func (m *MockAdapter) SettleFeeRebates(ctx context.Context, before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleFeeRebates", ctx, before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SettleFeeRebates indicates an expected call of SettleFeeRebates.
+func (mr *MockAdapterMockRecorder) SettleFeeRebates(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleFeeRebates", reflect.TypeOf((*MockAdapter)(nil).SettleFeeRebates), ctx, before)
+}
+
+// SubscribeRights mocks base method.
+func (m *MockAdapter) SubscribeRights(ctx context.Context, entitlement *domain.Entitlement, order *domain.Order, transactions []*domain.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeRights", ctx, entitlement, order, transactions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeRights indicates an expected call of SubscribeRights.
+func (mr *MockAdapterMockRecorder) SubscribeRights(ctx, entitlement, order, transactions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeRights", reflect.TypeOf((*MockAdapter)(nil).SubscribeRights), ctx, entitlement, order, transactions)
+}
+
+// UpdateAlertRule mocks base method.
+func (m *MockAdapter) UpdateAlertRule(ctx context.Context, obj *domain.AlertRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlertRule", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAlertRule indicates an expected call of UpdateAlertRule.
+func (mr *MockAdapterMockRecorder) UpdateAlertRule(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlertRule", reflect.TypeOf((*MockAdapter)(nil).UpdateAlertRule), ctx, obj)
+}
+
+// UpdateSessionID mocks base method.
+func (m *MockAdapter) UpdateSessionID(ctx context.Context, params *domain.UpdateSessionIDParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSessionID", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSessionID indicates an expected call of UpdateSessionID.
+func (mr *MockAdapterMockRecorder) UpdateSessionID(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSessionID", reflect.TypeOf((*MockAdapter)(nil).UpdateSessionID), ctx, params)
+}
+
+// UpdateUser mocks base method.
+func (m *MockAdapter) UpdateUser(ctx context.Context, obj *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockAdapterMockRecorder) UpdateUser(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockAdapter)(nil).UpdateUser), ctx, obj)
+}
+
+// UpdateUserBrokerProfile mocks base method.
+func (m *MockAdapter) UpdateUserBrokerProfile(ctx context.Context, userID, profileID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserBrokerProfile", ctx, userID, profileID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserBrokerProfile indicates an expected call of UpdateUserBrokerProfile.
+func (mr *MockAdapterMockRecorder) UpdateUserBrokerProfile(ctx, userID, profileID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserBrokerProfile", reflect.TypeOf((*MockAdapter)(nil).UpdateUserBrokerProfile), ctx, userID, profileID)
+}
+
+// UpsertEquitySnapshot mocks base method.
+func (m *MockAdapter) UpsertEquitySnapshot(ctx context.Context, snapshot *domain.EquitySnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertEquitySnapshot", ctx, snapshot)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertEquitySnapshot indicates an expected call of UpsertEquitySnapshot.
+func (mr *MockAdapterMockRecorder) UpsertEquitySnapshot(ctx, snapshot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertEquitySnapshot", reflect.TypeOf((*MockAdapter)(nil).UpsertEquitySnapshot), ctx, snapshot)
+}
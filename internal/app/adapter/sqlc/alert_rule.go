@@ -0,0 +1,120 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	sqlcdb "github.com/samwang0723/jarvis/internal/db/main/sqlc"
+	"github.com/samwang0723/jarvis/internal/helper"
+)
+
+func (repo *Repo) CreateAlertRule(ctx context.Context, obj *domain.AlertRule) error {
+	obj.ID.ID = uuid.Must(uuid.NewV4())
+
+	return repo.primary().CreateAlertRule(ctx, &sqlcdb.CreateAlertRuleParams{
+		ID:        obj.ID.ID,
+		UserID:    obj.UserID,
+		StockID:   obj.StockID,
+		Condition: obj.Condition,
+		Threshold: helper.Float32ToDecimal(obj.Threshold),
+		Channel:   obj.Channel,
+		Target:    obj.Target,
+	})
+}
+
+func (repo *Repo) ListAlertRules(ctx context.Context, userID uuid.UUID) ([]*domain.AlertRule, error) {
+	rows, err := repo.primary().ListAlertRules(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainAlertRuleList(rows), nil
+}
+
+func (repo *Repo) UpdateAlertRule(ctx context.Context, obj *domain.AlertRule) error {
+	affected, err := repo.primary().UpdateAlertRule(ctx, &sqlcdb.UpdateAlertRuleParams{
+		ID:        obj.ID.ID,
+		UserID:    obj.UserID,
+		StockID:   obj.StockID,
+		Condition: obj.Condition,
+		Threshold: helper.Float32ToDecimal(obj.Threshold),
+		Channel:   obj.Channel,
+		Target:    obj.Target,
+	})
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return newRecordNotFoundError(pgx.ErrNoRows)
+	}
+
+	return nil
+}
+
+func (repo *Repo) DeleteAlertRule(ctx context.Context, userID, id uuid.UUID) error {
+	affected, err := repo.primary().DeleteAlertRule(ctx, &sqlcdb.DeleteAlertRuleParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return newRecordNotFoundError(pgx.ErrNoRows)
+	}
+
+	return nil
+}
+
+func (repo *Repo) ListPendingAlertRules(
+	ctx context.Context,
+	stockID, date string,
+) ([]*domain.AlertRule, error) {
+	rows, err := repo.primary().ListPendingAlertRules(ctx, &sqlcdb.ListPendingAlertRulesParams{
+		StockID:      stockID,
+		ExchangeDate: date,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainAlertRuleList(rows), nil
+}
+
+func (repo *Repo) MarkAlertRuleTriggered(ctx context.Context, id uuid.UUID, date string) error {
+	return repo.primary().MarkAlertRuleTriggered(ctx, &sqlcdb.MarkAlertRuleTriggeredParams{
+		ID:                id,
+		LastTriggeredDate: date,
+	})
+}
+
+func toDomainAlertRuleList(rows []*sqlcdb.AlertRule) []*domain.AlertRule {
+	result := make([]*domain.AlertRule, 0, len(rows))
+	for _, row := range rows {
+		time := domain.Time{
+			CreatedAt: &row.CreatedAt,
+			UpdatedAt: &row.UpdatedAt,
+		}
+		if row.DeletedAt.Valid {
+			time.DeletedAt = &row.DeletedAt.Time
+		}
+
+		result = append(result, &domain.AlertRule{
+			ID:                domain.ID{ID: row.ID},
+			UserID:            row.UserID,
+			StockID:           row.StockID,
+			Condition:         row.Condition,
+			Threshold:         helper.DecimalToFloat32(row.Threshold),
+			Channel:           row.Channel,
+			Target:            row.Target,
+			LastTriggeredDate: row.LastTriggeredDate,
+			Time:              time,
+		})
+	}
+
+	return result
+}
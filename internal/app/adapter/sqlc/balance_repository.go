@@ -13,6 +13,7 @@ import (
 	"github.com/samwang0723/jarvis/internal/eventsourcing"
 	esdb "github.com/samwang0723/jarvis/internal/eventsourcing/db"
 	"github.com/samwang0723/jarvis/internal/helper"
+	"github.com/samwang0723/jarvis/internal/kafka/ikafka"
 )
 
 type balanceLoaderSaver struct {
@@ -83,8 +84,15 @@ func fromSqlcBalanceView(sqlcBalance *sqlcdb.GetBalanceViewRow) *domain.BalanceV
 	}
 }
 
+// balanceSnapshotFrequency bounds the events replayed to load a balance,
+// every transaction appends one.
+const balanceSnapshotFrequency = 100
+
+// balanceRepository loads the balances from their latest snapshot and the
+// events after it, the balance views are kept for reading.
 type balanceRepository struct {
 	repo *esdb.AggregateRepository
+	view *balanceLoaderSaver
 }
 
 func newBalanceRepository(dbPool *pgxpool.Pool) *balanceRepository {
@@ -96,9 +104,11 @@ func newBalanceRepository(dbPool *pgxpool.Pool) *balanceRepository {
 		repo: esdb.NewAggregateRepository(
 			&domain.BalanceView{},
 			dbPool,
-			esdb.WithAggregateLoader(loaderSaver),
 			esdb.WithAggregateSaver(loaderSaver),
+			esdb.WithSnapshotFrequency(balanceSnapshotFrequency),
+			esdb.WithOutbox(ikafka.BalanceEventsV1, &domain.BalanceChanged{}),
 		),
+		view: loaderSaver,
 	}
 }
 
@@ -108,6 +118,20 @@ func (br *balanceRepository) Load(ctx context.Context, id uuid.UUID) (*domain.Ba
 		return nil, fmt.Errorf("failed to balanceRepository.Load: %w", err)
 	}
 
+	return toBalanceView(aggregate)
+}
+
+// LoadView reads the balance view without replaying the events.
+func (br *balanceRepository) LoadView(ctx context.Context, id uuid.UUID) (*domain.BalanceView, error) {
+	aggregate, err := br.view.Load(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to balanceRepository.LoadView: %w", err)
+	}
+
+	return toBalanceView(aggregate)
+}
+
+func toBalanceView(aggregate eventsourcing.Aggregate) (*domain.BalanceView, error) {
 	balanceView, ok := aggregate.(*domain.BalanceView)
 	if !ok {
 		return nil, &TypeMismatchError{expect: &domain.BalanceView{}, got: aggregate}
@@ -126,7 +150,7 @@ func (br *balanceRepository) Save(ctx context.Context, balanceView *domain.Balan
 }
 
 func (repo *Repo) GetBalanceView(ctx context.Context, id uuid.UUID) (*domain.BalanceView, error) {
-	return repo.balanceRepository.Load(ctx, id)
+	return repo.balanceRepository.LoadView(ctx, id)
 }
 
 func (repo *Repo) createBalance(
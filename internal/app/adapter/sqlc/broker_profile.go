@@ -0,0 +1,106 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	sqlcdb "github.com/samwang0723/jarvis/internal/db/main/sqlc"
+	"github.com/samwang0723/jarvis/internal/helper"
+)
+
+func (repo *Repo) CreateBrokerProfile(ctx context.Context, obj *domain.BrokerProfile) error {
+	obj.ID.ID = uuid.Must(uuid.NewV4())
+
+	return repo.primary().CreateBrokerProfile(ctx, &sqlcdb.CreateBrokerProfileParams{
+		ID:              obj.ID.ID,
+		UserID:          obj.UserID,
+		Name:            obj.Name,
+		RebateTiming:    obj.RebateTiming,
+		FeeRate:         helper.Float32ToDecimal(obj.FeeRate),
+		FeeDiscount:     helper.Float32ToDecimal(obj.FeeDiscount),
+		MinFee:          helper.Float32ToDecimal(obj.MinFee),
+		OddLotMinFee:    helper.Float32ToDecimal(obj.OddLotMinFee),
+		TaxRate:         helper.Float32ToDecimal(obj.TaxRate),
+		DayTradeTaxRate: helper.Float32ToDecimal(obj.DayTradeTaxRate),
+		EtfTaxRate:      helper.Float32ToDecimal(obj.ETFTaxRate),
+		BondEtfTaxRate:  helper.Float32ToDecimal(obj.BondETFTaxRate),
+	})
+}
+
+func (repo *Repo) GetBrokerProfile(ctx context.Context, id uuid.UUID) (*domain.BrokerProfile, error) {
+	row, err := repo.primary().GetBrokerProfile(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newRecordNotFoundError(err)
+		}
+
+		return nil, err
+	}
+
+	return toDomainBrokerProfile(row), nil
+}
+
+// GetUserBrokerProfile returns the profile the user picked, users who never
+// picked one are charged by the default schedule.
+func (repo *Repo) GetUserBrokerProfile(ctx context.Context, userID uuid.UUID) (*domain.BrokerProfile, error) {
+	row, err := repo.primary().GetUserBrokerProfile(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.DefaultBrokerProfile(), nil
+		}
+
+		return nil, err
+	}
+
+	return toDomainBrokerProfile(row), nil
+}
+
+func (repo *Repo) ListBrokerProfiles(ctx context.Context, userID uuid.UUID) ([]*domain.BrokerProfile, error) {
+	rows, err := repo.primary().ListBrokerProfiles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.BrokerProfile, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toDomainBrokerProfile(row))
+	}
+
+	return result, nil
+}
+
+func (repo *Repo) UpdateUserBrokerProfile(ctx context.Context, userID, profileID uuid.UUID) error {
+	return repo.primary().UpdateUserBrokerProfile(ctx, &sqlcdb.UpdateUserBrokerProfileParams{
+		ID:              userID,
+		BrokerProfileID: profileID,
+	})
+}
+
+func toDomainBrokerProfile(row *sqlcdb.BrokerProfile) *domain.BrokerProfile {
+	time := domain.Time{
+		CreatedAt: &row.CreatedAt,
+		UpdatedAt: &row.UpdatedAt,
+	}
+	if row.DeletedAt.Valid {
+		time.DeletedAt = &row.DeletedAt.Time
+	}
+
+	return &domain.BrokerProfile{
+		ID:              domain.ID{ID: row.ID},
+		UserID:          row.UserID,
+		Name:            row.Name,
+		RebateTiming:    row.RebateTiming,
+		FeeRate:         helper.DecimalToFloat32(row.FeeRate),
+		FeeDiscount:     helper.DecimalToFloat32(row.FeeDiscount),
+		MinFee:          helper.DecimalToFloat32(row.MinFee),
+		OddLotMinFee:    helper.DecimalToFloat32(row.OddLotMinFee),
+		TaxRate:         helper.DecimalToFloat32(row.TaxRate),
+		DayTradeTaxRate: helper.DecimalToFloat32(row.DayTradeTaxRate),
+		ETFTaxRate:      helper.DecimalToFloat32(row.EtfTaxRate),
+		BondETFTaxRate:  helper.DecimalToFloat32(row.BondEtfTaxRate),
+		Time:            time,
+	}
+}
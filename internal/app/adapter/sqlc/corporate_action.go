@@ -0,0 +1,247 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	sqlcdb "github.com/samwang0723/jarvis/internal/db/main/sqlc"
+	esdb "github.com/samwang0723/jarvis/internal/eventsourcing/db"
+	"github.com/samwang0723/jarvis/internal/helper"
+)
+
+func (repo *Repo) BatchUpsertCorporateActions(
+	ctx context.Context,
+	objs []*domain.CorporateAction,
+) error {
+	return repo.primary().
+		BatchUpsertCorporateActions(ctx, toSqlcBatchUpsertCorporateActionsParams(objs))
+}
+
+func (repo *Repo) ListCorporateActions(
+	ctx context.Context,
+	stockIDs []string,
+	startDate, endDate string,
+) ([]*domain.CorporateAction, error) {
+	result, err := repo.primary().ListCorporateActions(ctx, &sqlcdb.ListCorporateActionsParams{
+		StockIds:  stockIDs,
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainCorporateActionList(result), nil
+}
+
+// ListDueCorporateActions returns the actions paid by the date which are not
+// distributed yet.
+func (repo *Repo) ListDueCorporateActions(
+	ctx context.Context,
+	paymentDate string,
+) ([]*domain.CorporateAction, error) {
+	result, err := repo.primary().ListDueCorporateActions(ctx, paymentDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListDueCorporateActions: %w", err)
+	}
+
+	return toDomainCorporateActionList(result), nil
+}
+
+func (repo *Repo) MarkCorporateActionDistributed(ctx context.Context, id uuid.UUID) error {
+	return repo.primary().MarkCorporateActionDistributed(ctx, id)
+}
+
+// DistributeEntitlement books the entitlement of a position along with its
+// transactions, an entitlement already booked is skipped.
+func (repo *Repo) DistributeEntitlement(
+	ctx context.Context,
+	entitlement *domain.Entitlement,
+	order *domain.Order,
+	transactions []*domain.Transaction,
+) error {
+	return repo.RunInTransaction(ctx, func(ctx context.Context) error {
+		queries := repo.primary()
+		if trans, ok := esdb.GetTx(ctx); ok {
+			queries = queries.WithTx(trans)
+		}
+
+		rows, err := queries.CreateCorporateActionEntitlement(ctx, &sqlcdb.CreateCorporateActionEntitlementParams{
+			CorporateActionID: entitlement.CorporateActionID,
+			OrderID:           entitlement.OrderID,
+			UserID:            entitlement.UserID,
+			HeldQuantity:      int64(entitlement.HeldQuantity),
+			StockShares:       int64(entitlement.StockShares),
+			RightsShares:      int64(entitlement.RightsShares),
+			CashDividend:      helper.Float32ToDecimal(entitlement.CashDividend),
+			CashInLieu:        helper.Float32ToDecimal(entitlement.CashInLieu),
+			Premium:           helper.Float32ToDecimal(entitlement.Premium),
+			RightsCost:        helper.Float32ToDecimal(entitlement.RightsCost),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to CreateCorporateActionEntitlement: %w", err)
+		}
+
+		if rows == 0 {
+			return nil
+		}
+
+		if err := repo.orderRepository.Save(ctx, order); err != nil {
+			return fmt.Errorf("failed to orderRepository.Save: %w", err)
+		}
+
+		if len(transactions) == 0 {
+			return nil
+		}
+
+		return repo.createChainTransactions(ctx, transactions)
+	})
+}
+
+func (repo *Repo) GetEntitlement(ctx context.Context, id, userID uuid.UUID) (*domain.Entitlement, error) {
+	row, err := repo.primary().GetCorporateActionEntitlement(ctx, &sqlcdb.GetCorporateActionEntitlementParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newRecordNotFoundError(err)
+		}
+
+		return nil, fmt.Errorf("failed to GetCorporateActionEntitlement: %w", err)
+	}
+
+	return toDomainEntitlement((*sqlcdb.ListCorporateActionEntitlementsRow)(row)), nil
+}
+
+// ListEntitlements returns the entitlements the positions of the user
+// received, the latest payment first.
+func (repo *Repo) ListEntitlements(ctx context.Context, userID uuid.UUID) ([]*domain.Entitlement, error) {
+	rows, err := repo.primary().ListCorporateActionEntitlements(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListCorporateActionEntitlements: %w", err)
+	}
+
+	result := make([]*domain.Entitlement, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toDomainEntitlement(row))
+	}
+
+	return result, nil
+}
+
+// SubscribeRights books the rights the holder subscribed along with the
+// transaction paying them, the rights of an entitlement are subscribed once.
+func (repo *Repo) SubscribeRights(
+	ctx context.Context,
+	entitlement *domain.Entitlement,
+	order *domain.Order,
+	transactions []*domain.Transaction,
+) error {
+	return repo.RunInTransaction(ctx, func(ctx context.Context) error {
+		queries := repo.primary()
+		if trans, ok := esdb.GetTx(ctx); ok {
+			queries = queries.WithTx(trans)
+		}
+
+		rows, err := queries.SubscribeCorporateActionRights(ctx, entitlement.ID)
+		if err != nil {
+			return fmt.Errorf("failed to SubscribeCorporateActionRights: %w", err)
+		}
+
+		if rows == 0 {
+			return DuplicatedRecordError("rights subscription")
+		}
+
+		if err := repo.orderRepository.Save(ctx, order); err != nil {
+			return fmt.Errorf("failed to orderRepository.Save: %w", err)
+		}
+
+		return repo.createChainTransactions(ctx, transactions)
+	})
+}
+
+func toDomainEntitlement(row *sqlcdb.ListCorporateActionEntitlementsRow) *domain.Entitlement {
+	return &domain.Entitlement{
+		PaymentDate:       row.PaymentDate,
+		StockID:           row.StockID,
+		ID:                row.ID,
+		CorporateActionID: row.CorporateActionID,
+		OrderID:           row.OrderID,
+		UserID:            row.UserID,
+		HeldQuantity:      uint64(row.HeldQuantity),
+		StockShares:       uint64(row.StockShares),
+		RightsShares:      uint64(row.RightsShares),
+		CashDividend:      helper.DecimalToFloat32(row.CashDividend),
+		CashInLieu:        helper.DecimalToFloat32(row.CashInLieu),
+		Premium:           helper.DecimalToFloat32(row.Premium),
+		RightsCost:        helper.DecimalToFloat32(row.RightsCost),
+		RightsSubscribed:  row.RightsSubscribedAt.Valid,
+	}
+}
+
+func toSqlcBatchUpsertCorporateActionsParams(
+	objs []*domain.CorporateAction,
+) *sqlcdb.BatchUpsertCorporateActionsParams {
+	result := &sqlcdb.BatchUpsertCorporateActionsParams{
+		ID:            make([]uuid.UUID, 0, len(objs)),
+		StockID:       make([]string, 0, len(objs)),
+		ExDate:        make([]string, 0, len(objs)),
+		CashDividend:  make([]decimal.Big, 0, len(objs)),
+		StockDividend: make([]decimal.Big, 0, len(objs)),
+		RightsRatio:   make([]decimal.Big, 0, len(objs)),
+		RightsPrice:   make([]decimal.Big, 0, len(objs)),
+		SplitRatio:    make([]decimal.Big, 0, len(objs)),
+		RecordDate:    make([]string, 0, len(objs)),
+		PaymentDate:   make([]string, 0, len(objs)),
+	}
+	for _, obj := range objs {
+		result.ID = append(result.ID, obj.ID.ID)
+		result.StockID = append(result.StockID, obj.StockID)
+		result.ExDate = append(result.ExDate, obj.ExDate)
+		result.CashDividend = append(result.CashDividend, helper.Float32ToDecimal(obj.CashDividend))
+		result.StockDividend = append(result.StockDividend, helper.Float32ToDecimal(obj.StockDividend))
+		result.RightsRatio = append(result.RightsRatio, helper.Float32ToDecimal(obj.RightsRatio))
+		result.RightsPrice = append(result.RightsPrice, helper.Float32ToDecimal(obj.RightsPrice))
+		result.SplitRatio = append(result.SplitRatio, helper.Float32ToDecimal(obj.SplitRatio))
+		result.RecordDate = append(result.RecordDate, obj.RecordDate)
+		result.PaymentDate = append(result.PaymentDate, obj.PaymentDate)
+	}
+
+	return result
+}
+
+func toDomainCorporateActionList(res []*sqlcdb.CorporateAction) []*domain.CorporateAction {
+	result := make([]*domain.CorporateAction, 0, len(res))
+	for _, r := range res {
+		time := domain.Time{
+			CreatedAt: &r.CreatedAt,
+			UpdatedAt: &r.UpdatedAt,
+		}
+		if r.DeletedAt.Valid {
+			time.DeletedAt = &r.DeletedAt.Time
+		}
+		result = append(result, &domain.CorporateAction{
+			ID: domain.ID{
+				ID: r.ID,
+			},
+			StockID:       r.StockID,
+			ExDate:        r.ExDate,
+			RecordDate:    r.RecordDate,
+			PaymentDate:   r.PaymentDate,
+			CashDividend:  helper.DecimalToFloat32(r.CashDividend),
+			StockDividend: helper.DecimalToFloat32(r.StockDividend),
+			RightsRatio:   helper.DecimalToFloat32(r.RightsRatio),
+			RightsPrice:   helper.DecimalToFloat32(r.RightsPrice),
+			SplitRatio:    helper.DecimalToFloat32(r.SplitRatio),
+			Time:          time,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,70 @@
+package sqlc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	sqlcdb "github.com/samwang0723/jarvis/internal/db/main/sqlc"
+	"github.com/samwang0723/jarvis/internal/helper"
+)
+
+// UpsertEquitySnapshot records the snapshot, a rerun on the same exchange
+// date overwrites it.
+func (repo *Repo) UpsertEquitySnapshot(ctx context.Context, snapshot *domain.EquitySnapshot) error {
+	err := repo.primary().UpsertEquitySnapshot(ctx, &sqlcdb.UpsertEquitySnapshotParams{
+		UserID:         snapshot.UserID,
+		ExchangeDate:   snapshot.ExchangeDate,
+		Cash:           helper.Float32ToDecimal(snapshot.Cash),
+		MarketValue:    helper.Float32ToDecimal(snapshot.MarketValue),
+		Equity:         helper.Float32ToDecimal(snapshot.Equity),
+		NetDeposits:    helper.Float32ToDecimal(snapshot.NetDeposits),
+		BenchmarkClose: helper.Float32ToDecimal(snapshot.BenchmarkClose),
+	})
+	if err != nil {
+		return fmt.Errorf("queries.UpsertEquitySnapshot error: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *Repo) ListEquitySnapshots(
+	ctx context.Context,
+	userID uuid.UUID,
+	startDate, endDate string,
+) ([]*domain.EquitySnapshot, error) {
+	rows, err := repo.primary().ListEquitySnapshots(ctx, &sqlcdb.ListEquitySnapshotsParams{
+		UserID:    userID,
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.EquitySnapshot, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, &domain.EquitySnapshot{
+			ExchangeDate:   row.ExchangeDate,
+			UserID:         row.UserID,
+			Cash:           helper.DecimalToFloat32(row.Cash),
+			MarketValue:    helper.DecimalToFloat32(row.MarketValue),
+			Equity:         helper.DecimalToFloat32(row.Equity),
+			NetDeposits:    helper.DecimalToFloat32(row.NetDeposits),
+			BenchmarkClose: helper.DecimalToFloat32(row.BenchmarkClose),
+		})
+	}
+
+	return result, nil
+}
+
+// GetNetDeposits sums the settled deposits less the withdrawals of the user.
+func (repo *Repo) GetNetDeposits(ctx context.Context, userID uuid.UUID) (float32, error) {
+	netDeposits, err := repo.primary().GetNetDeposits(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("queries.GetNetDeposits error: %w", err)
+	}
+
+	return helper.DecimalToFloat32(netDeposits), nil
+}
@@ -0,0 +1,66 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	sqlcdb "github.com/samwang0723/jarvis/internal/db/main/sqlc"
+	"github.com/samwang0723/jarvis/internal/helper"
+)
+
+func (repo *Repo) CreateIntradayTick(ctx context.Context, obj *domain.IntradayTick) error {
+	return repo.primary().CreateIntradayTick(ctx, &sqlcdb.CreateIntradayTickParams{
+		StockID:      obj.StockID,
+		ExchangeDate: obj.ExchangeDate,
+		TickTime:     obj.TickTime,
+		Open:         helper.Float32ToDecimal(obj.Open),
+		High:         helper.Float32ToDecimal(obj.High),
+		Low:          helper.Float32ToDecimal(obj.Low),
+		Close:        helper.Float32ToDecimal(obj.Close),
+		Volume:       int64(obj.Volume),
+	})
+}
+
+func (repo *Repo) ListIntradayTicks(
+	ctx context.Context,
+	stockID, date string,
+) ([]*domain.IntradayTick, error) {
+	result, err := repo.primary().ListIntradayTicks(ctx, &sqlcdb.ListIntradayTicksParams{
+		StockID:      stockID,
+		ExchangeDate: date,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainIntradayTickList(result), nil
+}
+
+func toDomainIntradayTickList(res []*sqlcdb.IntradayTick) []*domain.IntradayTick {
+	result := make([]*domain.IntradayTick, 0, len(res))
+	for _, r := range res {
+		time := domain.Time{
+			CreatedAt: &r.CreatedAt,
+			UpdatedAt: &r.UpdatedAt,
+		}
+		if r.DeletedAt.Valid {
+			time.DeletedAt = &r.DeletedAt.Time
+		}
+		result = append(result, &domain.IntradayTick{
+			ID: domain.ID{
+				ID: r.ID,
+			},
+			StockID:      r.StockID,
+			ExchangeDate: r.ExchangeDate,
+			TickTime:     r.TickTime,
+			Open:         helper.DecimalToFloat32(r.Open),
+			High:         helper.DecimalToFloat32(r.High),
+			Low:          helper.DecimalToFloat32(r.Low),
+			Close:        helper.DecimalToFloat32(r.Close),
+			Volume:       uint64(r.Volume),
+			Time:         time,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,78 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	sqlcdb "github.com/samwang0723/jarvis/internal/db/main/sqlc"
+)
+
+func (repo *Repo) CreateJournalEntry(ctx context.Context, obj *domain.JournalEntry) error {
+	obj.ID.ID = uuid.Must(uuid.NewV4())
+
+	return repo.primary().CreateJournalEntry(ctx, &sqlcdb.CreateJournalEntryParams{
+		ID:       obj.ID.ID,
+		UserID:   obj.UserID,
+		OrderID:  obj.OrderID,
+		Notes:    obj.Notes,
+		Tags:     obj.Tags,
+		Strategy: obj.Strategy,
+		ScreenID: obj.ScreenID,
+	})
+}
+
+func (repo *Repo) ListOrderJournalEntries(
+	ctx context.Context,
+	userID, orderID uuid.UUID,
+) ([]*domain.JournalEntry, error) {
+	rows, err := repo.primary().ListOrderJournalEntries(ctx, &sqlcdb.ListOrderJournalEntriesParams{
+		OrderID: orderID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainJournalEntries(rows), nil
+}
+
+func (repo *Repo) ListUserJournalEntries(ctx context.Context, userID uuid.UUID) ([]*domain.JournalEntry, error) {
+	rows, err := repo.primary().ListUserJournalEntries(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainJournalEntries(rows), nil
+}
+
+func toDomainJournalEntries(rows []*sqlcdb.JournalEntry) []*domain.JournalEntry {
+	result := make([]*domain.JournalEntry, 0, len(rows))
+	for _, row := range rows {
+		time := domain.Time{
+			CreatedAt: &row.CreatedAt,
+			UpdatedAt: &row.UpdatedAt,
+		}
+		if row.DeletedAt.Valid {
+			time.DeletedAt = &row.DeletedAt.Time
+		}
+
+		tags := row.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+
+		result = append(result, &domain.JournalEntry{
+			ID:       domain.ID{ID: row.ID},
+			UserID:   row.UserID,
+			OrderID:  row.OrderID,
+			Notes:    row.Notes,
+			Tags:     tags,
+			Strategy: row.Strategy,
+			ScreenID: row.ScreenID,
+			Time:     time,
+		})
+	}
+
+	return result
+}
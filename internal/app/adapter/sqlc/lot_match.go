@@ -0,0 +1,131 @@
+package sqlc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	sqlcdb "github.com/samwang0723/jarvis/internal/db/main/sqlc"
+	"github.com/samwang0723/jarvis/internal/helper"
+)
+
+// saveLotMatches records the closing fills of the uncommitted order events in
+// the lot ledger. An amended position keeps a single match rebuilt from its
+// amended trades, a cancelled one keeps none.
+func saveLotMatches(ctx context.Context, queries *sqlcdb.Queries, order *domain.Order) error {
+	rebuild := false
+	for _, event := range order.GetChanges() {
+		switch event := event.(type) {
+		case *domain.OrderChanged:
+			if event.FillQuantity == 0 {
+				continue
+			}
+
+			err := createLotMatch(ctx, queries, &domain.LotMatch{
+				StockID:      order.StockID,
+				OrderType:    event.OrderType,
+				Method:       event.LotMethod,
+				ExchangeDate: event.ExchangeDate,
+				UserID:       order.UserID,
+				OrderID:      order.ID,
+				Quantity:     event.FillQuantity,
+				Price:        event.FillPrice,
+				CostPrice:    event.CostPrice,
+			})
+			if err != nil {
+				return err
+			}
+		case *domain.OrderAmended, *domain.OrderCancelled:
+			rebuild = true
+		}
+	}
+
+	if !rebuild {
+		return nil
+	}
+
+	if err := queries.DeleteLotMatches(ctx, order.ID); err != nil {
+		return fmt.Errorf("queries.DeleteLotMatches error: %w", err)
+	}
+
+	match := amendedLotMatch(order)
+	if match == nil {
+		return nil
+	}
+
+	return createLotMatch(ctx, queries, match)
+}
+
+// amendedLotMatch is the single closing fill an amended position keeps, none
+// once it is cancelled or has nothing closed.
+func amendedLotMatch(order *domain.Order) *domain.LotMatch {
+	if order.IsCancelled() || !order.IsPosition() {
+		return nil
+	}
+
+	match := &domain.LotMatch{
+		StockID:   order.StockID,
+		OrderType: order.ClosingSide(),
+		Method:    domain.LotMethodAmended,
+		UserID:    order.UserID,
+		OrderID:   order.ID,
+		CostPrice: order.OpeningPrice(),
+	}
+	if match.OrderType == domain.OrderTypeBuy {
+		match.ExchangeDate, match.Quantity, match.Price = order.BuyExchangeDate, order.BuyQuantity, order.BuyPrice
+	} else {
+		match.ExchangeDate, match.Quantity, match.Price = order.SellExchangeDate, order.SellQuantity, order.SellPrice
+	}
+
+	if match.Quantity == 0 {
+		return nil
+	}
+
+	return match
+}
+
+func createLotMatch(ctx context.Context, queries *sqlcdb.Queries, match *domain.LotMatch) error {
+	if err := queries.CreateLotMatch(ctx, &sqlcdb.CreateLotMatchParams{
+		ID:           uuid.Must(uuid.NewV4()),
+		UserID:       match.UserID,
+		OrderID:      match.OrderID,
+		StockID:      match.StockID,
+		OrderType:    match.OrderType,
+		Method:       match.Method,
+		ExchangeDate: match.ExchangeDate,
+		Price:        helper.Float32ToDecimal(match.Price),
+		CostPrice:    helper.Float32ToDecimal(match.CostPrice),
+		Quantity:     int64(match.Quantity),
+	}); err != nil {
+		return fmt.Errorf("queries.CreateLotMatch error: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *Repo) ListLotMatches(ctx context.Context, orderIDs []uuid.UUID) ([]*domain.LotMatch, error) {
+	rows, err := repo.primary().ListLotMatches(ctx, orderIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListLotMatches: %w", err)
+	}
+
+	objs := make([]*domain.LotMatch, 0, len(rows))
+	for _, row := range rows {
+		objs = append(objs, &domain.LotMatch{
+			CreatedAt:    row.CreatedAt,
+			StockID:      row.StockID,
+			OrderType:    row.OrderType,
+			Method:       row.Method,
+			ExchangeDate: row.ExchangeDate,
+			ID:           row.ID,
+			UserID:       row.UserID,
+			OrderID:      row.OrderID,
+			Quantity:     uint64(row.Quantity),
+			Price:        helper.DecimalToFloat32(row.Price),
+			CostPrice:    helper.DecimalToFloat32(row.CostPrice),
+		})
+	}
+
+	return objs, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/samwang0723/jarvis/internal/eventsourcing"
 	esdb "github.com/samwang0723/jarvis/internal/eventsourcing/db"
 	"github.com/samwang0723/jarvis/internal/helper"
+	"github.com/samwang0723/jarvis/internal/kafka/ikafka"
 )
 
 type orderLoaderSaver struct {
@@ -57,23 +58,35 @@ func (ols *orderLoaderSaver) Save(ctx context.Context, aggregate eventsourcing.A
 	}
 
 	if err := queries.UpsertOrder(ctx, &sqlcdb.UpsertOrderParams{
-		ID:               order.ID,
-		UserID:           order.UserID,
-		StockID:          order.StockID,
-		BuyPrice:         helper.Float32ToDecimal(order.BuyPrice),
-		BuyQuantity:      int64(order.BuyQuantity),
-		BuyExchangeDate:  order.BuyExchangeDate,
-		SellPrice:        helper.Float32ToDecimal(order.SellPrice),
-		SellQuantity:     int64(order.SellQuantity),
-		SellExchangeDate: order.SellExchangeDate,
-		ProfitablePrice:  helper.Float32ToDecimal(order.ProfitablePrice),
-		Status:           order.Status,
-		Version:          int32(order.Version),
+		ID:                 order.ID,
+		UserID:             order.UserID,
+		StockID:            order.StockID,
+		BuyPrice:           helper.Float32ToDecimal(order.BuyPrice),
+		BuyQuantity:        int64(order.BuyQuantity),
+		BuyExchangeDate:    order.BuyExchangeDate,
+		SellPrice:          helper.Float32ToDecimal(order.SellPrice),
+		SellQuantity:       int64(order.SellQuantity),
+		SellExchangeDate:   order.SellExchangeDate,
+		ProfitablePrice:    helper.Float32ToDecimal(order.ProfitablePrice),
+		Status:             order.Status,
+		Version:            int32(order.Version),
+		Kind:               order.Kind,
+		Side:               order.Side,
+		Quantity:           int64(order.Quantity),
+		LimitPrice:         helper.Float32ToDecimal(order.LimitPrice),
+		StopPrice:          helper.Float32ToDecimal(order.StopPrice),
+		PositionID:         order.PositionID,
+		PlacedExchangeDate: order.PlacedExchangeDate,
+		FilledPrice:        helper.Float32ToDecimal(order.FilledPrice),
+		FilledExchangeDate: order.FilledExchangeDate,
+		LotType:            order.LotType,
+		TradeType:          order.TradeType,
+		Dividends:          helper.Float32ToDecimal(order.Dividends),
 	}); err != nil {
 		return fmt.Errorf("queries.UpsertOrderView error: %w", err)
 	}
 
-	return nil
+	return saveLotMatches(ctx, queries, order)
 }
 
 func fromSqlcOrderView(sqlcOrder *sqlcdb.Order) *domain.Order {
@@ -84,16 +97,28 @@ func fromSqlcOrderView(sqlcOrder *sqlcdb.Order) *domain.Order {
 			ID:      sqlcOrder.ID,
 			Version: int(sqlcOrder.Version),
 		},
-		StockID:          sqlcOrder.StockID,
-		BuyExchangeDate:  sqlcOrder.BuyExchangeDate,
-		Status:           sqlcOrder.Status,
-		SellExchangeDate: sqlcOrder.SellExchangeDate,
-		SellQuantity:     uint64(sqlcOrder.SellQuantity),
-		BuyQuantity:      uint64(sqlcOrder.BuyQuantity),
-		UserID:           sqlcOrder.UserID,
-		ProfitablePrice:  helper.DecimalToFloat32(sqlcOrder.ProfitablePrice),
-		SellPrice:        helper.DecimalToFloat32(sqlcOrder.SellPrice),
-		BuyPrice:         helper.DecimalToFloat32(sqlcOrder.BuyPrice),
+		StockID:            sqlcOrder.StockID,
+		BuyExchangeDate:    sqlcOrder.BuyExchangeDate,
+		Status:             sqlcOrder.Status,
+		SellExchangeDate:   sqlcOrder.SellExchangeDate,
+		SellQuantity:       uint64(sqlcOrder.SellQuantity),
+		BuyQuantity:        uint64(sqlcOrder.BuyQuantity),
+		UserID:             sqlcOrder.UserID,
+		ProfitablePrice:    helper.DecimalToFloat32(sqlcOrder.ProfitablePrice),
+		SellPrice:          helper.DecimalToFloat32(sqlcOrder.SellPrice),
+		BuyPrice:           helper.DecimalToFloat32(sqlcOrder.BuyPrice),
+		Kind:               sqlcOrder.Kind,
+		Side:               sqlcOrder.Side,
+		LotType:            sqlcOrder.LotType,
+		TradeType:          sqlcOrder.TradeType,
+		Dividends:          helper.DecimalToFloat32(sqlcOrder.Dividends),
+		PlacedExchangeDate: sqlcOrder.PlacedExchangeDate,
+		FilledExchangeDate: sqlcOrder.FilledExchangeDate,
+		Quantity:           uint64(sqlcOrder.Quantity),
+		PositionID:         sqlcOrder.PositionID,
+		LimitPrice:         helper.DecimalToFloat32(sqlcOrder.LimitPrice),
+		StopPrice:          helper.DecimalToFloat32(sqlcOrder.StopPrice),
+		FilledPrice:        helper.DecimalToFloat32(sqlcOrder.FilledPrice),
 	}
 }
 
@@ -112,6 +137,7 @@ func newOrderRepository(dbPool *pgxpool.Pool) *orderRepository {
 			dbPool,
 			esdb.WithAggregateLoader(loaderSaver),
 			esdb.WithAggregateSaver(loaderSaver),
+			esdb.WithOutbox(ikafka.OrderEventsV1, &domain.OrderCreated{}),
 		),
 	}
 }
@@ -199,6 +225,90 @@ func (repo *Repo) ListOpenOrders(
 	return objs, nil
 }
 
+func (repo *Repo) GetOrder(ctx context.Context, id uuid.UUID) (*domain.Order, error) {
+	return repo.orderRepository.Load(ctx, id)
+}
+
+// ListEntitledOrders returns the long positions of the stock opened before the
+// cutoff date of the record date and still held on it.
+func (repo *Repo) ListEntitledOrders(ctx context.Context, stockID, cutoffDate string) ([]*domain.Order, error) {
+	rows, err := repo.primary().ListEntitledOrders(ctx, &sqlcdb.ListEntitledOrdersParams{
+		StockID:    stockID,
+		CutoffDate: cutoffDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListEntitledOrders: %w", err)
+	}
+
+	objs := make([]*domain.Order, len(rows))
+	for idx, orderID := range rows {
+		obj, err := repo.orderRepository.Load(ctx, orderID)
+		if err != nil {
+			return objs, err
+		}
+		objs[idx] = obj
+	}
+
+	return objs, nil
+}
+
+// ListOpenMarginedOrders returns the open margin and short positions of the
+// stock.
+func (repo *Repo) ListOpenMarginedOrders(ctx context.Context, stockID string) ([]*domain.Order, error) {
+	rows, err := repo.primary().ListOpenMarginedOrders(ctx, stockID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListOpenMarginedOrders: %w", err)
+	}
+
+	objs := make([]*domain.Order, len(rows))
+	for idx, orderID := range rows {
+		obj, err := repo.orderRepository.Load(ctx, orderID)
+		if err != nil {
+			return objs, err
+		}
+		objs[idx] = obj
+	}
+
+	return objs, nil
+}
+
+// ListOpenPositions returns the open positions of the user ordered by stock.
+func (repo *Repo) ListOpenPositions(ctx context.Context, userID uuid.UUID) ([]*domain.Order, error) {
+	rows, err := repo.primary().ListOpenPositions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListOpenPositions: %w", err)
+	}
+
+	objs := make([]*domain.Order, len(rows))
+	for idx, orderID := range rows {
+		obj, err := repo.orderRepository.Load(ctx, orderID)
+		if err != nil {
+			return objs, err
+		}
+		objs[idx] = obj
+	}
+
+	return objs, nil
+}
+
+func (repo *Repo) ListPendingOrders(ctx context.Context, stockIDs []string) ([]*domain.Order, error) {
+	rows, err := repo.primary().ListPendingOrders(ctx, stockIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ListPendingOrders: %w", err)
+	}
+
+	objs := make([]*domain.Order, len(rows))
+	for idx, orderID := range rows {
+		obj, err := repo.orderRepository.Load(ctx, orderID)
+		if err != nil {
+			return objs, err
+		}
+		objs[idx] = obj
+	}
+
+	return objs, nil
+}
+
 func (repo *Repo) CreateOrder(
 	ctx context.Context,
 	orders []*domain.Order,
@@ -211,8 +321,44 @@ func (repo *Repo) CreateOrder(
 			}
 		}
 
+		// pending orders move no funds until they are filled
+		if len(transactions) == 0 {
+			return nil
+		}
+
 		return repo.createChainTransactions(ctx, transactions)
 	})
 
 	return err
 }
+
+// CancelOrder saves the cancelled order and reverses its transactions.
+func (repo *Repo) CancelOrder(ctx context.Context, order *domain.Order) error {
+	return repo.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := repo.orderRepository.Save(ctx, order); err != nil {
+			return fmt.Errorf("failed to orderRepository.Save: %w", err)
+		}
+
+		return repo.reverseOrderTransactions(ctx, order.UserID, order.ID, false)
+	})
+}
+
+// AmendOrder saves the amended order and replaces its transactions with the
+// ones booking the amended trades.
+func (repo *Repo) AmendOrder(
+	ctx context.Context,
+	order *domain.Order,
+	transactions []*domain.Transaction,
+) error {
+	return repo.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := repo.orderRepository.Save(ctx, order); err != nil {
+			return fmt.Errorf("failed to orderRepository.Save: %w", err)
+		}
+
+		if err := repo.reverseOrderTransactions(ctx, order.UserID, order.ID, true); err != nil {
+			return err
+		}
+
+		return repo.createChainTransactions(ctx, transactions)
+	})
+}
@@ -0,0 +1,17 @@
+package sqlc
+
+import (
+	"context"
+
+	esdb "github.com/samwang0723/jarvis/internal/eventsourcing/db"
+)
+
+// RelayOutbox hands the oldest outbox messages to publish, they are removed
+// once publish succeeds.
+func (repo *Repo) RelayOutbox(
+	ctx context.Context,
+	limit int,
+	publish func(ctx context.Context, messages []*esdb.OutboxMessage) error,
+) (int, error) {
+	return repo.outboxStore.Relay(ctx, limit, publish)
+}
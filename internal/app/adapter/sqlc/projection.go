@@ -0,0 +1,20 @@
+package sqlc
+
+import "context"
+
+// RunProjections feeds the recorded events to the projectors until the
+// context is cancelled.
+func (repo *Repo) RunProjections(ctx context.Context) {
+	repo.projectionRunner.Run(ctx)
+}
+
+// RebuildProjection resets the projection to replay every recorded event.
+func (repo *Repo) RebuildProjection(ctx context.Context, name string) error {
+	return repo.projectionRunner.Rebuild(ctx, name)
+}
+
+// CatchUpProjection projects the events recorded so far, returning how many
+// were projected.
+func (repo *Repo) CatchUpProjection(ctx context.Context, name string) (int, error) {
+	return repo.projectionRunner.CatchUp(ctx, name)
+}
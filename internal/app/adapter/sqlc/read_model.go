@@ -0,0 +1,313 @@
+package sqlc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	sqlcdb "github.com/samwang0723/jarvis/internal/db/main/sqlc"
+	"github.com/samwang0723/jarvis/internal/eventsourcing"
+	esdb "github.com/samwang0723/jarvis/internal/eventsourcing/db"
+	"github.com/samwang0723/jarvis/internal/helper"
+)
+
+// The read models projected from the order events, the names are the ones
+// the projection command rebuilds.
+const (
+	PositionsProjection       = "positions"
+	DailyProfitLossProjection = "daily_profit_loss"
+)
+
+// maxReplayedOrders bounds the orders a projector keeps replayed, the ones
+// dropped are replayed from their events again once projected.
+const maxReplayedOrders = 10000
+
+// WithReadModels projects the order events to the position and daily profit
+// loss read models, both the api and the projection command register them
+// through it.
+func WithReadModels() Option {
+	return func(repo *Repo) {
+		repo.projectionRunner.AddProjector(
+			PositionsProjection,
+			&positionProjector{
+				queries:  repo.primary(),
+				replayer: newOrderReplayer(repo.primaryConn.pool),
+			},
+			&domain.OrderCreated{},
+			&domain.OrderChanged{},
+			&domain.OrderClosed{},
+			&domain.OrderAmended{},
+			&domain.OrderCancelled{},
+			&domain.OrderDistributed{},
+		)
+		repo.projectionRunner.AddProjector(
+			DailyProfitLossProjection,
+			&dailyProfitLossProjector{
+				queries:  repo.primary(),
+				replayer: newOrderReplayer(repo.primaryConn.pool),
+				brokers:  repo.GetUserBrokerProfile,
+			},
+			&domain.OrderChanged{},
+			&domain.OrderAmended{},
+			&domain.OrderCancelled{},
+		)
+	}
+}
+
+// orderReplayer rebuilds an order from its events up to the one projected, a
+// projection being rebuilt sees the order as it was at the time. It keeps the
+// orders it replayed and only applies the events recorded since, a projector
+// handles the events one at a time so it is not safe for concurrent use.
+type orderReplayer struct {
+	store  *esdb.EventStore
+	orders map[uuid.UUID]*domain.Order
+}
+
+func newOrderReplayer(dbPool *pgxpool.Pool) *orderReplayer {
+	order := &domain.Order{}
+	registry := eventsourcing.NewEventRegistry()
+	for _, transition := range order.GetTransitions() {
+		registry.Register(transition.Event)
+	}
+
+	return &orderReplayer{
+		store:  esdb.NewEventStore(order.EventTable(), registry, dbPool),
+		orders: make(map[uuid.UUID]*domain.Order),
+	}
+}
+
+// replay returns the order after the event with the closing fills it booked
+// by then, an amended position keeps a single fill as in the lot ledger. The
+// events of concurrent commits may be projected out of version order, an
+// order already replayed past the event is returned as it is.
+func (or *orderReplayer) replay(ctx context.Context, event eventsourcing.Event) (*domain.Order, error) {
+	order, ok := or.orders[event.GetAggregateID()]
+	if !ok {
+		if len(or.orders) >= maxReplayedOrders {
+			or.reset()
+		}
+
+		order = &domain.Order{}
+		or.orders[event.GetAggregateID()] = order
+	}
+
+	if order.Version >= event.GetVersion() {
+		return order, nil
+	}
+
+	// the events the projector does not subscribe to are loaded from the store
+	events := []eventsourcing.Event{event}
+	if order.Version+1 < event.GetVersion() {
+		var err error
+		if events, err = or.store.Load(ctx, event.GetAggregateID(), order.Version+1); err != nil {
+			return nil, fmt.Errorf("failed to load order events: %w", err)
+		}
+	}
+
+	for _, recorded := range events {
+		if recorded.GetVersion() > event.GetVersion() {
+			break
+		}
+
+		if err := replayEvent(order, recorded); err != nil {
+			delete(or.orders, event.GetAggregateID())
+
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
+// reset drops the replayed orders, a rebuilt projection replays them again.
+func (or *orderReplayer) reset() {
+	or.orders = make(map[uuid.UUID]*domain.Order)
+}
+
+func replayEvent(order *domain.Order, event eventsourcing.Event) error {
+	fill := closingFill(order, event)
+	if err := order.Apply(event); err != nil {
+		return fmt.Errorf("failed to replay order: %w", err)
+	}
+
+	switch event.(type) {
+	case *domain.OrderChanged:
+		if fill != nil {
+			order.LotMatches = append(order.LotMatches, fill)
+		}
+	case *domain.OrderAmended, *domain.OrderCancelled:
+		order.LotMatches = nil
+		if match := amendedLotMatch(order); match != nil {
+			order.LotMatches = []*domain.LotMatch{match}
+		}
+	}
+
+	return nil
+}
+
+// closingFill returns the fill the change closes the position with. A change
+// booked before the lot ledger only carries the weighted price of the side,
+// its fill is what it added to the side at the opening price.
+func closingFill(order *domain.Order, event eventsourcing.Event) *domain.LotMatch {
+	changed, ok := event.(*domain.OrderChanged)
+	if !ok || changed.OrderType != order.ClosingSide() {
+		return nil
+	}
+
+	match := &domain.LotMatch{
+		StockID:      order.StockID,
+		OrderType:    changed.OrderType,
+		Method:       changed.LotMethod,
+		ExchangeDate: changed.ExchangeDate,
+		UserID:       order.UserID,
+		OrderID:      order.ID,
+		Quantity:     changed.FillQuantity,
+		Price:        changed.FillPrice,
+		CostPrice:    changed.CostPrice,
+	}
+	if changed.FillQuantity > 0 {
+		return match
+	}
+
+	closedPrice, closedQuantity := order.SellPrice, order.SellQuantity
+	if changed.OrderType == domain.OrderTypeBuy {
+		closedPrice, closedQuantity = order.BuyPrice, order.BuyQuantity
+	}
+
+	if changed.Quantity <= closedQuantity {
+		return nil
+	}
+
+	match.Quantity = changed.Quantity - closedQuantity
+	match.Price = (changed.TradePrice*float32(changed.Quantity) - closedPrice*float32(closedQuantity)) /
+		float32(match.Quantity)
+	match.CostPrice = order.OpeningPrice()
+
+	return match
+}
+
+// positionProjector keeps a row of every booked position with the quantities
+// and prices of both sides.
+type positionProjector struct {
+	queries  *sqlcdb.Queries
+	replayer *orderReplayer
+}
+
+func (pp *positionProjector) Handle(ctx context.Context, event eventsourcing.Event) error {
+	queries := pp.queries
+
+	if trans, ok := esdb.GetTx(ctx); ok {
+		queries = pp.queries.WithTx(trans)
+	}
+
+	order, err := pp.replayer.replay(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	// pending orders are booked as new positions once filled
+	if !order.IsPosition() {
+		return nil
+	}
+
+	if err := queries.UpsertPositionView(ctx, &sqlcdb.UpsertPositionViewParams{
+		OrderID:          order.ID,
+		UserID:           order.UserID,
+		StockID:          order.StockID,
+		Status:           order.Status,
+		BuyPrice:         helper.Float32ToDecimal(order.BuyPrice),
+		BuyQuantity:      int64(order.BuyQuantity),
+		BuyExchangeDate:  order.BuyExchangeDate,
+		SellPrice:        helper.Float32ToDecimal(order.SellPrice),
+		SellQuantity:     int64(order.SellQuantity),
+		SellExchangeDate: order.SellExchangeDate,
+		Version:          int32(order.Version),
+	}); err != nil {
+		return fmt.Errorf("queries.UpsertPositionView error: %w", err)
+	}
+
+	return nil
+}
+
+func (pp *positionProjector) Reset(ctx context.Context) error {
+	queries := pp.queries
+
+	if trans, ok := esdb.GetTx(ctx); ok {
+		queries = pp.queries.WithTx(trans)
+	}
+
+	if err := queries.DeletePositionViews(ctx); err != nil {
+		return fmt.Errorf("queries.DeletePositionViews error: %w", err)
+	}
+
+	pp.replayer.reset()
+
+	return nil
+}
+
+// dailyProfitLossProjector keeps the profit or loss each position realized on
+// an exchange date, rewritten whenever the position is closed further,
+// amended or cancelled. The fees and taxes are the ones of the broker profile
+// the user picked, as for the orders booked.
+type dailyProfitLossProjector struct {
+	queries  *sqlcdb.Queries
+	replayer *orderReplayer
+	brokers  func(ctx context.Context, userID uuid.UUID) (*domain.BrokerProfile, error)
+}
+
+func (dp *dailyProfitLossProjector) Handle(ctx context.Context, event eventsourcing.Event) error {
+	queries := dp.queries
+
+	if trans, ok := esdb.GetTx(ctx); ok {
+		queries = dp.queries.WithTx(trans)
+	}
+
+	order, err := dp.replayer.replay(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	if err := queries.DeleteOrderDailyProfitLosses(ctx, order.ID); err != nil {
+		return fmt.Errorf("queries.DeleteOrderDailyProfitLosses error: %w", err)
+	}
+
+	if order.IsCancelled() || !order.IsPosition() {
+		return nil
+	}
+
+	if order.Broker, err = dp.brokers(ctx, order.UserID); err != nil {
+		return fmt.Errorf("failed to get broker profile: %w", err)
+	}
+
+	for exchangeDate, profitLoss := range order.RealizedProfitLoss() {
+		if err := queries.CreateDailyProfitLoss(ctx, &sqlcdb.CreateDailyProfitLossParams{
+			OrderID:      order.ID,
+			ExchangeDate: exchangeDate,
+			UserID:       order.UserID,
+			StockID:      order.StockID,
+			ProfitLoss:   helper.Float32ToDecimal(profitLoss),
+		}); err != nil {
+			return fmt.Errorf("queries.CreateDailyProfitLoss error: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (dp *dailyProfitLossProjector) Reset(ctx context.Context) error {
+	queries := dp.queries
+
+	if trans, ok := esdb.GetTx(ctx); ok {
+		queries = dp.queries.WithTx(trans)
+	}
+
+	if err := queries.DeleteDailyProfitLosses(ctx); err != nil {
+		return fmt.Errorf("queries.DeleteDailyProfitLosses error: %w", err)
+	}
+
+	dp.replayer.reset()
+
+	return nil
+}
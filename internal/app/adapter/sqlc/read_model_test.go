@@ -0,0 +1,96 @@
+package sqlc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/samwang0723/jarvis/internal/app/adapter/sqlc"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/common/remotetest"
+	sqlcdb "github.com/samwang0723/jarvis/internal/db/main/sqlc"
+	esdb "github.com/samwang0723/jarvis/internal/eventsourcing/db"
+	"github.com/samwang0723/jarvis/internal/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRebuildReadModels(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	pool := remotetest.SetupPostgresClient(t, true)
+	repo := sqlc.NewSqlcRepository(pool, &logger, sqlc.WithReadModels())
+
+	// the realized profit loss is charged by the profile the user picked
+	user := &domain.User{FirstName: "read", LastName: "model", Email: "read.model@example.com", Phone: "0900000000"}
+	assert.Nil(t, repo.CreateUser(ctx, user))
+	userID := user.ID.ID
+
+	profile := domain.DefaultBrokerProfile()
+	profile.UserID = userID
+	profile.Name = "no discount"
+	profile.FeeDiscount = 1
+	assert.Nil(t, repo.CreateBrokerProfile(ctx, profile))
+	assert.Nil(t, repo.UpdateUserBrokerProfile(ctx, userID, profile.ID.ID))
+
+	// half of the first position and the whole second one are sold
+	held, err := domain.NewOrder(userID, domain.OrderTypeBuy, domain.LotTypeBoard, domain.TradeTypeCash,
+		"2330", "20240102", 100, 2000, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, held.MatchLot(domain.OrderTypeSell, "20240103", 110, 1000, 100, domain.LotMethodFIFO))
+
+	closed, err := domain.NewOrder(userID, domain.OrderTypeBuy, domain.LotTypeBoard, domain.TradeTypeCash,
+		"2603", "20240102", 50, 1000, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, closed.MatchLot(domain.OrderTypeSell, "20240103", 45, 1000, 50, domain.LotMethodFIFO))
+
+	events := esdb.NewAggregateRepository(&domain.Order{}, pool)
+	assert.Nil(t, events.Save(ctx, held))
+	assert.Nil(t, events.Save(ctx, closed))
+
+	err = repo.RebuildProjection(ctx, "unknown")
+	assert.ErrorAs(t, err, &esdb.ProjectionNotFoundError{})
+
+	// rebuilding twice projects every event once
+	for i := 0; i < 2; i++ {
+		for _, name := range []string{sqlc.PositionsProjection, sqlc.DailyProfitLossProjection} {
+			assert.Nil(t, repo.RebuildProjection(ctx, name))
+
+			projected, err := repo.CatchUpProjection(ctx, name)
+			assert.Nil(t, err)
+			assert.Equal(t, 5, projected, name)
+		}
+	}
+
+	// the read models are checked on their tables
+	queries := sqlcdb.New(pool)
+
+	positions, err := queries.ListOpenPositionViews(ctx, userID)
+	assert.Nil(t, err)
+	assert.Len(t, positions, 1)
+	assert.Equal(t, held.ID, positions[0].OrderID)
+	assert.Equal(t, int64(2000), positions[0].BuyQuantity)
+	assert.Equal(t, int64(1000), positions[0].SellQuantity)
+
+	realized, err := queries.ListDailyProfitLosses(ctx, &sqlcdb.ListDailyProfitLossesParams{
+		UserID:    userID,
+		StartDate: "20240101",
+		EndDate:   "20240131",
+	})
+	assert.Nil(t, err)
+
+	held.Broker, closed.Broker = profile, profile
+	held.LotMatches = []*domain.LotMatch{
+		{OrderType: domain.OrderTypeSell, ExchangeDate: "20240103", Quantity: 1000, Price: 110, CostPrice: 100},
+	}
+	closed.LotMatches = []*domain.LotMatch{
+		{OrderType: domain.OrderTypeSell, ExchangeDate: "20240103", Quantity: 1000, Price: 45, CostPrice: 50},
+	}
+	want := held.RealizedProfitLoss()["20240103"] + closed.RealizedProfitLoss()["20240103"]
+
+	assert.Len(t, realized, 1)
+	assert.Equal(t, "20240103", realized[0].ExchangeDate)
+	assert.InDelta(t, want, helper.DecimalToFloat32(realized[0].ProfitLoss), 0.01)
+}
@@ -0,0 +1,68 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	sqlcdb "github.com/samwang0723/jarvis/internal/db/main/sqlc"
+)
+
+func (repo *Repo) CreateScreen(ctx context.Context, obj *domain.Screen) error {
+	obj.ID.ID = uuid.Must(uuid.NewV4())
+
+	return repo.primary().CreateScreen(ctx, &sqlcdb.CreateScreenParams{
+		ID:         obj.ID.ID,
+		UserID:     obj.UserID,
+		Name:       obj.Name,
+		Expression: obj.Expression,
+	})
+}
+
+func (repo *Repo) ListScreens(ctx context.Context, userID uuid.UUID) ([]*domain.Screen, error) {
+	rows, err := repo.primary().ListScreens(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Screen, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toDomainScreen(row))
+	}
+
+	return result, nil
+}
+
+func (repo *Repo) GetScreenByID(
+	ctx context.Context,
+	userID uuid.UUID,
+	id uuid.UUID,
+) (*domain.Screen, error) {
+	row, err := repo.primary().GetScreenByID(ctx, &sqlcdb.GetScreenByIDParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainScreen(row), nil
+}
+
+func toDomainScreen(row *sqlcdb.Screen) *domain.Screen {
+	time := domain.Time{
+		CreatedAt: &row.CreatedAt,
+		UpdatedAt: &row.UpdatedAt,
+	}
+	if row.DeletedAt.Valid {
+		time.DeletedAt = &row.DeletedAt.Time
+	}
+
+	return &domain.Screen{
+		ID:         domain.ID{ID: row.ID},
+		UserID:     row.UserID,
+		Name:       row.Name,
+		Expression: row.Expression,
+		Time:       time,
+	}
+}
@@ -81,9 +81,14 @@ func (repo *Repo) ListSelectionsFromPicked(
 func (repo *Repo) ListSelections(
 	ctx context.Context,
 	date string,
-	strict bool,
+	candidates *domain.SelectionCandidates,
 ) ([]*domain.Selection, error) {
-	sel, err := repo.primary().ListSelections(ctx, date)
+	sel, err := repo.primary().ListSelections(ctx, &sqlcdb.ListSelectionsParams{
+		ExchangeDate:         date,
+		MinConcentrationDays: candidates.MinConcentrationDays,
+		MinTradeShares:       candidates.MinTradeShares,
+		MinAvgVolume:         candidates.MinAvgVolume,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +159,23 @@ func (repo *Repo) RetrieveDailyCloseHistory(
 	return domain.ConvertDailyCloseList(res), nil
 }
 
+func (repo *Repo) RetrieveDailyCloseBetween(
+	ctx context.Context,
+	stockIDs []string,
+	startDate, endDate string,
+) ([]*domain.DailyClose, error) {
+	res, err := repo.primary().
+		RetrieveDailyCloseHistoryWithDate(ctx, &sqlcdb.RetrieveDailyCloseHistoryWithDateParams{
+			StartDate: startDate,
+			EndDate:   endDate,
+			StockIds:  stockIDs,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return domain.ConvertDailyCloseList(res), nil
+}
+
 func (repo *Repo) RetrieveThreePrimaryHistory(
 	ctx context.Context,
 	stockIDs []string,
@@ -8,6 +8,8 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/eventsourcing"
 	esdb "github.com/samwang0723/jarvis/internal/eventsourcing/db"
 
 	sqlcdb "github.com/samwang0723/jarvis/internal/db/main/sqlc"
@@ -36,6 +38,8 @@ type Repo struct {
 	balanceRepository     *balanceRepository
 	orderRepository       *orderRepository
 	transactionRepository *transactionRepository
+	outboxStore           *esdb.OutboxStore
+	projectionRunner      *esdb.ProjectionRunner
 }
 
 func NewSqlcRepository(pool *pgxpool.Pool, logger *zerolog.Logger, opts ...Option) *Repo {
@@ -45,6 +49,12 @@ func NewSqlcRepository(pool *pgxpool.Pool, logger *zerolog.Logger, opts ...Optio
 		balanceRepository:     newBalanceRepository(pool),
 		orderRepository:       newOrderRepository(pool),
 		transactionRepository: newTransactionRepository(pool),
+		outboxStore:           esdb.NewOutboxStore(pool),
+		projectionRunner: esdb.NewProjectionRunner(pool, []eventsourcing.Aggregate{
+			&domain.BalanceView{},
+			&domain.Order{},
+			&domain.Transaction{},
+		}),
 	}
 
 	for _, opt := range opts {
@@ -66,6 +76,15 @@ func WithReplica(pool *pgxpool.Pool) Option {
 	}
 }
 
+// WithProjector projects the events, or every event if none are given, to a
+// read model asynchronously. The name identifies the checkpoint of the
+// projection.
+func WithProjector(name string, projector eventsourcing.Projector, events ...eventsourcing.Event) Option {
+	return func(repo *Repo) {
+		repo.projectionRunner.AddProjector(name, projector, events...)
+	}
+}
+
 func (repo *Repo) primary() *sqlcdb.Queries {
 	return repo.primaryConn.queries
 }
@@ -64,6 +64,16 @@ func (repo *Repo) GetStakeConcentrationLatestDataPoint(
 	return exchangeDate
 }
 
+func (repo *Repo) ListExchangeDates(
+	ctx context.Context,
+	startDate, endDate string,
+) ([]string, error) {
+	return repo.primary().ListExchangeDates(ctx, &sqlcdb.ListExchangeDatesParams{
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+}
+
 func toSqlcBatchUpsertStakeConcentrationParams(
 	stakeConcentrations []*domain.StakeConcentration,
 ) *sqlcdb.BatchUpsertStakeConcentrationParams {
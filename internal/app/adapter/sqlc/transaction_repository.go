@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/jackc/pgx/v5"
@@ -13,6 +14,7 @@ import (
 	"github.com/samwang0723/jarvis/internal/eventsourcing"
 	esdb "github.com/samwang0723/jarvis/internal/eventsourcing/db"
 	"github.com/samwang0723/jarvis/internal/helper"
+	"github.com/samwang0723/jarvis/internal/kafka/ikafka"
 )
 
 type transactionLoaderSaver struct {
@@ -65,6 +67,7 @@ func (ols *transactionLoaderSaver) Save(
 		OrderID:      trans.OrderID,
 		OrderType:    trans.OrderType,
 		CreditAmount: helper.Float32ToDecimal(trans.CreditAmount),
+		DebitAmount:  helper.Float32ToDecimal(trans.DebitAmount),
 		Status:       trans.Status,
 		Version:      int32(trans.Version),
 	}); err != nil {
@@ -91,6 +94,30 @@ func fromSqlcTransaction(sqlcTrans *sqlcdb.Transaction) *domain.Transaction {
 	}
 }
 
+// ListUserTransactions lists the completed transactions of the user booked
+// from start until end.
+func (repo *Repo) ListUserTransactions(
+	ctx context.Context,
+	userID uuid.UUID,
+	start, end time.Time,
+) ([]*domain.Transaction, error) {
+	rows, err := repo.primary().ListUserTransactions(ctx, &sqlcdb.ListUserTransactionsParams{
+		UserID:    userID,
+		StartTime: start,
+		EndTime:   end,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("queries.ListUserTransactions error: %w", err)
+	}
+
+	result := make([]*domain.Transaction, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, fromSqlcTransaction(row))
+	}
+
+	return result, nil
+}
+
 type transactionRepository struct {
 	repo *esdb.AggregateRepository
 }
@@ -106,6 +133,7 @@ func newTransactionRepository(dbPool *pgxpool.Pool) *transactionRepository {
 			dbPool,
 			esdb.WithAggregateLoader(loaderSaver),
 			esdb.WithAggregateSaver(loaderSaver),
+			esdb.WithOutbox(ikafka.TransactionEventsV1, &domain.TransactionCompleted{}),
 		),
 	}
 }
@@ -151,6 +179,15 @@ func (repo *Repo) createChainTransactions(
 	}
 
 	for _, transaction := range transactions {
+		// fee rebates are credited once the month is settled
+		if transaction.OrderType == domain.OrderTypeRebate {
+			if err := repo.transactionRepository.Save(ctx, transaction); err != nil {
+				return err
+			}
+
+			continue
+		}
+
 		// immediately completed the transaction as no external vendor dependency
 		if err := transaction.Complete(); err != nil {
 			return err
@@ -169,7 +206,9 @@ func (repo *Repo) createChainTransactions(
 
 func moveFund(balanceView *domain.BalanceView, transaction *domain.Transaction) error {
 	switch transaction.OrderType {
-	case domain.OrderTypeBuy, domain.OrderTypeFee, domain.OrderTypeTax, domain.OrderTypeWithdraw:
+	case domain.OrderTypeBuy, domain.OrderTypeFee, domain.OrderTypeTax, domain.OrderTypeWithdraw,
+		domain.OrderTypeMarginRepay, domain.OrderTypeMarginInterest, domain.OrderTypeShortCollateral,
+		domain.OrderTypeShortBorrowFee, domain.OrderTypeDividendPremium, domain.OrderTypeRightsSubscription:
 		if err := balanceView.MoveAvailableToPending(transaction); err != nil {
 			return err
 		}
@@ -177,7 +216,80 @@ func moveFund(balanceView *domain.BalanceView, transaction *domain.Transaction)
 		if err := balanceView.DebitPending(transaction); err != nil {
 			return err
 		}
-	case domain.OrderTypeSell, domain.OrderTypeDeposit:
+	case domain.OrderTypeSell, domain.OrderTypeDeposit, domain.OrderTypeRebate,
+		domain.OrderTypeMarginLoan, domain.OrderTypeShortRelease, domain.OrderTypeDividend,
+		domain.OrderTypeStockDividend:
+		if err := balanceView.CreditPending(transaction); err != nil {
+			return err
+		}
+
+		if err := balanceView.MovePendingToAvailable(transaction); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown order type: %s", transaction.OrderType)
+	}
+
+	return nil
+}
+
+// reverseOrderTransactions voids every completed transaction of the order and
+// moves their funds back, unsettled fee rebates are dropped. An amended order
+// keeps the corporate action distributions it received.
+func (repo *Repo) reverseOrderTransactions(
+	ctx context.Context,
+	userID, orderID uuid.UUID,
+	keepDistributions bool,
+) error {
+	if err := repo.failOrderRebates(ctx, orderID); err != nil {
+		return err
+	}
+
+	ids, err := repo.primary().ListOrderTransactions(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("failed to ListOrderTransactions: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	balanceView, err := repo.balanceRepository.Load(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to reverseOrderTransactions: %w", err)
+	}
+
+	for _, id := range ids {
+		transaction, err := repo.transactionRepository.Load(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if keepDistributions && transaction.IsDistribution() {
+			continue
+		}
+
+		if err := transaction.Reverse(); err != nil {
+			return err
+		}
+		if err := repo.transactionRepository.Save(ctx, transaction); err != nil {
+			return err
+		}
+
+		if err := refundFund(balanceView, transaction); err != nil {
+			return err
+		}
+	}
+
+	return repo.balanceRepository.Save(ctx, balanceView)
+}
+
+// refundFund undoes moveFund of a reversed transaction.
+func refundFund(balanceView *domain.BalanceView, transaction *domain.Transaction) error {
+	switch transaction.OrderType {
+	case domain.OrderTypeBuy, domain.OrderTypeFee, domain.OrderTypeTax, domain.OrderTypeWithdraw,
+		domain.OrderTypeMarginRepay, domain.OrderTypeMarginInterest, domain.OrderTypeShortCollateral,
+		domain.OrderTypeShortBorrowFee, domain.OrderTypeDividendPremium, domain.OrderTypeRightsSubscription:
 		if err := balanceView.CreditPending(transaction); err != nil {
 			return err
 		}
@@ -185,9 +297,83 @@ func moveFund(balanceView *domain.BalanceView, transaction *domain.Transaction)
 		if err := balanceView.MovePendingToAvailable(transaction); err != nil {
 			return err
 		}
+	case domain.OrderTypeSell, domain.OrderTypeDeposit, domain.OrderTypeRebate,
+		domain.OrderTypeMarginLoan, domain.OrderTypeShortRelease, domain.OrderTypeDividend,
+		domain.OrderTypeStockDividend:
+		if err := balanceView.MoveAvailableToPending(transaction); err != nil {
+			return err
+		}
+
+		if err := balanceView.DebitPending(transaction); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("unknown order type: %s", transaction.OrderType)
 	}
 
 	return nil
 }
+
+func (repo *Repo) failOrderRebates(ctx context.Context, orderID uuid.UUID) error {
+	ids, err := repo.primary().ListOrderRebates(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("failed to ListOrderRebates: %w", err)
+	}
+
+	for _, id := range ids {
+		transaction, err := repo.transactionRepository.Load(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := transaction.Fail(); err != nil {
+			return err
+		}
+		if err := repo.transactionRepository.Save(ctx, transaction); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SettleFeeRebates credits the fee rebates booked before the given time, each
+// rebate settles in its own transaction.
+func (repo *Repo) SettleFeeRebates(ctx context.Context, before time.Time) error {
+	ids, err := repo.primary().ListUnsettledRebates(ctx, before)
+	if err != nil {
+		return fmt.Errorf("failed to ListUnsettledRebates: %w", err)
+	}
+
+	for _, id := range ids {
+		err := repo.RunInTransaction(ctx, func(ctx context.Context) error {
+			transaction, err := repo.transactionRepository.Load(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			balanceView, err := repo.balanceRepository.Load(ctx, transaction.UserID)
+			if err != nil {
+				return err
+			}
+
+			if err := transaction.Complete(); err != nil {
+				return err
+			}
+			if err := repo.transactionRepository.Save(ctx, transaction); err != nil {
+				return err
+			}
+
+			if err := moveFund(balanceView, transaction); err != nil {
+				return err
+			}
+
+			return repo.balanceRepository.Save(ctx, balanceView)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to settle rebate %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
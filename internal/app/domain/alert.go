@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const (
+	// AlertPriceAbove fires once the price reaches the threshold.
+	AlertPriceAbove = "price_above"
+	// AlertPriceBelow fires once the price falls to the threshold.
+	AlertPriceBelow = "price_below"
+	// AlertChangeAbove fires once the intraday gain in percent reaches the threshold.
+	AlertChangeAbove = "change_above"
+	// AlertChangeBelow fires once the intraday loss in percent reaches the threshold.
+	AlertChangeBelow = "change_below"
+	// AlertProfitablePrice fires once an open order can be closed at the
+	// price covering its fees and taxes, the threshold is unused.
+	AlertProfitablePrice = "profitable_price"
+
+	AlertChannelWebhook = "webhook"
+	AlertChannelEmail   = "email"
+)
+
+// AlertRule is evaluated against every crawled quote of its stock and fires
+// at most once per exchange date.
+type AlertRule struct {
+	Time
+	StockID           string  `json:"stockID"`
+	Condition         string  `json:"condition"`
+	Channel           string  `json:"channel"`
+	Target            string  `json:"target"`
+	LastTriggeredDate string  `json:"lastTriggeredDate"`
+	Threshold         float32 `json:"threshold"`
+	ID
+	UserID uuid.UUID `json:"userID"`
+}
+
+func (r *AlertRule) Validate() error {
+	if r.StockID == "" {
+		return &DataValidationError{dataType: "alert stock"}
+	}
+
+	switch r.Condition {
+	case AlertPriceAbove, AlertPriceBelow, AlertChangeAbove, AlertChangeBelow:
+		if r.Threshold <= 0 {
+			return &DataValidationError{dataType: "alert threshold"}
+		}
+	case AlertProfitablePrice:
+	default:
+		return &DataValidationError{dataType: "alert condition"}
+	}
+
+	switch r.Channel {
+	case AlertChannelWebhook, AlertChannelEmail:
+	default:
+		return &DataValidationError{dataType: "alert channel"}
+	}
+
+	if r.Target == "" {
+		return &DataValidationError{dataType: "alert target"}
+	}
+
+	return nil
+}
+
+// Evaluate reports whether the quote fires the rule and describes why. Open
+// orders of the rule owner are only consulted by AlertProfitablePrice.
+func (r *AlertRule) Evaluate(quote *Realtime, openOrders []*Order) (string, bool) {
+	switch r.Condition {
+	case AlertPriceAbove:
+		if quote.Close >= r.Threshold {
+			return fmt.Sprintf("%s price %.2f reached %.2f", quote.StockID, quote.Close, r.Threshold), true
+		}
+	case AlertPriceBelow:
+		if quote.Close <= r.Threshold {
+			return fmt.Sprintf("%s price %.2f fell to %.2f", quote.StockID, quote.Close, r.Threshold), true
+		}
+	case AlertChangeAbove, AlertChangeBelow:
+		return r.evaluateChange(quote)
+	case AlertProfitablePrice:
+		return evaluateProfitablePrice(quote, openOrders)
+	}
+
+	return "", false
+}
+
+func (r *AlertRule) evaluateChange(quote *Realtime) (string, bool) {
+	if quote.PreviousClose <= 0 {
+		return "", false
+	}
+
+	change := (quote.Close - quote.PreviousClose) / quote.PreviousClose * percent
+	if r.Condition == AlertChangeAbove && change >= r.Threshold {
+		return fmt.Sprintf("%s is up %.2f%% intraday at %.2f", quote.StockID, change, quote.Close), true
+	}
+
+	if r.Condition == AlertChangeBelow && -change >= r.Threshold {
+		return fmt.Sprintf("%s is down %.2f%% intraday at %.2f", quote.StockID, -change, quote.Close), true
+	}
+
+	return "", false
+}
+
+func evaluateProfitablePrice(quote *Realtime, openOrders []*Order) (string, bool) {
+	for _, order := range openOrders {
+		if order.ProfitablePrice <= 0 {
+			continue
+		}
+
+		long := order.BuyQuantity > order.SellQuantity
+		if (long && quote.Close >= order.ProfitablePrice) ||
+			(!long && quote.Close <= order.ProfitablePrice) {
+			return fmt.Sprintf("%s price %.2f hit the profitable price %.2f of order %s",
+				quote.StockID, quote.Close, order.ProfitablePrice, order.GetAggregateID()), true
+		}
+	}
+
+	return "", false
+}
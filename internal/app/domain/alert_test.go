@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAlertRuleEvaluate(t *testing.T) {
+	t.Parallel()
+
+	quote := &Realtime{StockID: "2330", Close: 950, PreviousClose: 1000}
+	long := &Order{BuyQuantity: 1, ProfitablePrice: 945}
+	short := &Order{SellQuantity: 1, ProfitablePrice: 940}
+
+	tests := []struct {
+		name   string
+		rule   *AlertRule
+		orders []*Order
+		expect bool
+	}{
+		{
+			name:   "price above not reached",
+			rule:   &AlertRule{Condition: AlertPriceAbove, Threshold: 1000},
+			expect: false,
+		},
+		{
+			name:   "price below reached",
+			rule:   &AlertRule{Condition: AlertPriceBelow, Threshold: 950},
+			expect: true,
+		},
+		{
+			name:   "intraday drop of 5%",
+			rule:   &AlertRule{Condition: AlertChangeBelow, Threshold: 5},
+			expect: true,
+		},
+		{
+			name:   "intraday gain not reached",
+			rule:   &AlertRule{Condition: AlertChangeAbove, Threshold: 1},
+			expect: false,
+		},
+		{
+			name:   "long order above profitable price",
+			rule:   &AlertRule{Condition: AlertProfitablePrice},
+			orders: []*Order{long},
+			expect: true,
+		},
+		{
+			name:   "short order above profitable price",
+			rule:   &AlertRule{Condition: AlertProfitablePrice},
+			orders: []*Order{short},
+			expect: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			msg, fired := tt.rule.Evaluate(quote, tt.orders)
+			if fired != tt.expect {
+				t.Errorf("expect fired=%v, got %v (%s)", tt.expect, fired, msg)
+			}
+
+			if fired && msg == "" {
+				t.Error("expect a message for a fired rule")
+			}
+		})
+	}
+}
+
+func TestAlertRuleValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := AlertRule{
+		StockID:   "2330",
+		Condition: AlertPriceAbove,
+		Threshold: 1000,
+		Channel:   AlertChannelWebhook,
+		Target:    "http://localhost/hook",
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("expect valid rule, got %v", err)
+	}
+
+	invalid := []func(r *AlertRule){
+		func(r *AlertRule) { r.StockID = "" },
+		func(r *AlertRule) { r.Condition = "crosses" },
+		func(r *AlertRule) { r.Threshold = 0 },
+		func(r *AlertRule) { r.Channel = "sms" },
+		func(r *AlertRule) { r.Target = "" },
+	}
+	for idx, mutate := range invalid {
+		rule := valid
+		mutate(&rule)
+
+		var validationErr *DataValidationError
+		if err := rule.Validate(); !errors.As(err, &validationErr) {
+			t.Errorf("case %d: expect validation error, got %v", idx, err)
+		}
+	}
+}
@@ -1,15 +1,33 @@
 package domain
 
 type Analysis struct {
-	MA8       float32
-	MA21      float32
-	MA55      float32
-	LastClose float32
-	MV5       uint64
-	MV13      uint64
-	MV34      uint64
-	Foreign   int64
-	Trust     int64
-	Hedging   int64
-	Dealer    int64
+	MA8             float32
+	MA21            float32
+	MA55            float32
+	LastClose       float32
+	EMA12           float32
+	EMA26           float32
+	RSI14           float32
+	MACD            float32
+	MACDSignal      float32
+	MACDHistogram   float32
+	BollingerUpper  float32
+	BollingerMiddle float32
+	BollingerLower  float32
+	K9              float32
+	D9              float32
+	ATR14           float32
+	WeeklyMA5       float32
+	WeeklyMA10      float32
+	WeeklyMA20      float32
+	MonthlyMA3      float32
+	MonthlyMA5      float32
+	MV5             uint64
+	MV13            uint64
+	MV34            uint64
+	OBV             int64
+	Foreign         int64
+	Trust           int64
+	Hedging         int64
+	Dealer          int64
 }
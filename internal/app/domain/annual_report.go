@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const monthsOfYear = 12
+
+// ReportPeriod totals the completed transactions and the positions closed in
+// a month, or in the year for the total. The amounts are unsigned.
+type ReportPeriod struct {
+	Period             string
+	Deposit            float32
+	Withdraw           float32
+	Buy                float32
+	Sell               float32
+	Fee                float32
+	Tax                float32
+	RealizedProfitLoss float32
+	ClosedOrders       int32
+}
+
+// AnnualReport breaks the realized gains, the fees and the taxes of a year
+// down by month.
+type AnnualReport struct {
+	Total  *ReportPeriod
+	Months []*ReportPeriod
+	Year   int
+}
+
+// NewAnnualReport aggregates the completed transactions by the month they were
+// booked in and the closed positions by the month of their closing fill, the
+// profit loss of the positions has to be calculated first. Transactions and
+// positions outside of the year are skipped.
+func NewAnnualReport(year int, transactions []*Transaction, orders []*Order) (*AnnualReport, error) {
+	if year < 1 || year > time.Now().Year() {
+		return nil, &DataValidationError{dataType: "report year"}
+	}
+
+	report := &AnnualReport{
+		Year:   year,
+		Total:  &ReportPeriod{Period: fmt.Sprintf("%04d", year)},
+		Months: make([]*ReportPeriod, monthsOfYear),
+	}
+	for idx := range report.Months {
+		report.Months[idx] = &ReportPeriod{Period: fmt.Sprintf("%04d%02d", year, idx+1)}
+	}
+
+	for _, tran := range transactions {
+		createdAt := tran.CreatedAt.In(time.Local)
+		if tran.Status != string(transactionCompletedState) || createdAt.Year() != year {
+			continue
+		}
+
+		report.Months[createdAt.Month()-1].addTransaction(tran)
+		report.Total.addTransaction(tran)
+	}
+
+	for _, order := range orders {
+		if !order.IsPosition() || order.Status != string(orderClosedState) {
+			continue
+		}
+
+		closedAt, err := time.Parse(exchangeDateLayout, order.ClosingExchangeDate())
+		if err != nil || closedAt.Year() != year {
+			continue
+		}
+
+		report.Months[closedAt.Month()-1].addOrder(order)
+		report.Total.addOrder(order)
+	}
+
+	return report, nil
+}
+
+func (p *ReportPeriod) addTransaction(tran *Transaction) {
+	amount := tran.CreditAmount + tran.DebitAmount
+	switch tran.OrderType {
+	case OrderTypeDeposit:
+		p.Deposit += amount
+	case OrderTypeWithdraw:
+		p.Withdraw += amount
+	case OrderTypeBuy:
+		p.Buy += amount
+	case OrderTypeSell:
+		p.Sell += amount
+	case OrderTypeFee:
+		p.Fee += amount
+	case OrderTypeTax:
+		p.Tax += amount
+	}
+}
+
+func (p *ReportPeriod) addOrder(order *Order) {
+	p.ClosedOrders++
+	p.RealizedProfitLoss += order.ProfitLoss
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func TestNewAnnualReport(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.Must(uuid.NewV4())
+	booked := func(orderType, status string, month time.Month, credit, debit float32) *Transaction {
+		return &Transaction{
+			CreatedAt:    time.Date(2024, month, 15, 10, 0, 0, 0, time.Local),
+			OrderType:    orderType,
+			Status:       status,
+			UserID:       userID,
+			CreditAmount: credit,
+			DebitAmount:  debit,
+		}
+	}
+
+	transactions := []*Transaction{
+		booked(OrderTypeDeposit, string(transactionCompletedState), time.January, 1000000, 0),
+		booked(OrderTypeBuy, string(transactionCompletedState), time.January, 0, 100000),
+		booked(OrderTypeFee, string(transactionCompletedState), time.January, 0, 142),
+		booked(OrderTypeSell, string(transactionCompletedState), time.March, 110000, 0),
+		booked(OrderTypeFee, string(transactionCompletedState), time.March, 0, 156),
+		booked(OrderTypeTax, string(transactionCompletedState), time.March, 0, 330),
+		booked(OrderTypeWithdraw, string(transactionCompletedState), time.December, 0, 5000),
+		// reversed and unsettled transactions are left out
+		booked(OrderTypeFee, string(transactionReversedState), time.March, 0, 999),
+		booked(OrderTypeRebate, string(transactionCreatedState), time.March, 50, 0),
+	}
+	lastYear := booked(OrderTypeDeposit, string(transactionCompletedState), time.December, 500, 0)
+	lastYear.CreatedAt = lastYear.CreatedAt.AddDate(-1, 0, 0)
+	transactions = append(transactions, lastYear)
+
+	closed, err := NewOrder(userID, OrderTypeBuy, LotTypeBoard, TradeTypeCash, "2330", "20240115", 100, 1000, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = closed.MatchLot(OrderTypeSell, "20240315", 110, 1000, 100, LotMethodFIFO); err != nil {
+		t.Fatal(err)
+	}
+	closed.ProfitLoss = 9372
+
+	open, err := NewOrder(userID, OrderTypeBuy, LotTypeBoard, TradeTypeCash, "2317", "20240315", 100, 1000, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := NewAnnualReport(2024, transactions, []*Order{closed, open})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Months) != monthsOfYear {
+		t.Fatalf("expect %d months, got %d", monthsOfYear, len(report.Months))
+	}
+
+	tests := []struct {
+		got  *ReportPeriod
+		want ReportPeriod
+	}{
+		{got: report.Months[0], want: ReportPeriod{Period: "202401", Deposit: 1000000, Buy: 100000, Fee: 142}},
+		{got: report.Months[1], want: ReportPeriod{Period: "202402"}},
+		{
+			got: report.Months[2],
+			want: ReportPeriod{Period: "202403", Sell: 110000, Fee: 156, Tax: 330,
+				RealizedProfitLoss: 9372, ClosedOrders: 1},
+		},
+		{got: report.Months[11], want: ReportPeriod{Period: "202412", Withdraw: 5000}},
+		{
+			got: report.Total,
+			want: ReportPeriod{Period: "2024", Deposit: 1000000, Withdraw: 5000, Buy: 100000, Sell: 110000,
+				Fee: 298, Tax: 330, RealizedProfitLoss: 9372, ClosedOrders: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		if *tt.got != tt.want {
+			t.Errorf("expect %+v, got %+v", tt.want, *tt.got)
+		}
+	}
+
+	for _, year := range []int{0, time.Now().Year() + 1} {
+		if _, err := NewAnnualReport(year, nil, nil); err == nil {
+			t.Errorf("expect error of year %d", year)
+		}
+	}
+}
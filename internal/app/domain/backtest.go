@@ -0,0 +1,84 @@
+package domain
+
+const (
+	ExitReasonStopLoss   = "stop_loss"
+	ExitReasonTakeProfit = "take_profit"
+	ExitReasonHolding    = "holding_period"
+	ExitReasonEnd        = "end_of_range"
+)
+
+// BacktestTrade is a single simulated round trip, quantity is counted in lots.
+type BacktestTrade struct {
+	StockID    string
+	EntryDate  string
+	ExitDate   string
+	ExitReason string
+	EntryPrice float32
+	ExitPrice  float32
+	Fee        float32
+	Tax        float32
+	ProfitLoss float32
+	Return     float32
+	Quantity   uint64
+}
+
+type EquityPoint struct {
+	Date   string
+	Equity float32
+}
+
+type BacktestReport struct {
+	StartDate       string
+	EndDate         string
+	Strategy        string
+	Trades          []*BacktestTrade
+	EquityCurve     []*EquityPoint
+	InitialCapital  float32
+	FinalEquity     float32
+	TotalProfitLoss float32
+	WinRate         float32
+	AverageReturn   float32
+	MaxDrawdown     float32
+	TotalTrades     int32
+	WinningTrades   int32
+}
+
+// Summarize derives the aggregated metrics from the trades and equity curve.
+// Rates are expressed in percent.
+func (r *BacktestReport) Summarize() {
+	r.TotalTrades = int32(len(r.Trades))
+	r.WinningTrades = 0
+	r.TotalProfitLoss = 0
+	r.WinRate = 0
+	r.AverageReturn = 0
+	r.MaxDrawdown = 0
+
+	var totalReturn float32
+	for _, t := range r.Trades {
+		r.TotalProfitLoss += t.ProfitLoss
+		totalReturn += t.Return
+		if t.ProfitLoss > 0 {
+			r.WinningTrades++
+		}
+	}
+
+	if r.TotalTrades > 0 {
+		r.WinRate = float32(r.WinningTrades) / float32(r.TotalTrades) * percent
+		r.AverageReturn = totalReturn / float32(r.TotalTrades)
+	}
+
+	r.FinalEquity = r.InitialCapital + r.TotalProfitLoss
+
+	peak := r.InitialCapital
+	for _, p := range r.EquityCurve {
+		if p.Equity > peak {
+			peak = p.Equity
+		}
+
+		if peak > 0 {
+			if drawdown := (peak - p.Equity) / peak * percent; drawdown > r.MaxDrawdown {
+				r.MaxDrawdown = drawdown
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBacktestReportSummarize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		report *BacktestReport
+		expect *BacktestReport
+		name   string
+	}{
+		{
+			name:   "empty report",
+			report: &BacktestReport{InitialCapital: 1000},
+			expect: &BacktestReport{InitialCapital: 1000, FinalEquity: 1000},
+		},
+		{
+			name: "wins, losses and drawdown",
+			report: &BacktestReport{
+				InitialCapital: 1000,
+				Trades: []*BacktestTrade{
+					{ProfitLoss: 100, Return: 10},
+					{ProfitLoss: -50, Return: -5},
+					{ProfitLoss: 30, Return: 4},
+					{ProfitLoss: -10, Return: -1},
+				},
+				EquityCurve: []*EquityPoint{
+					{Date: "20240102", Equity: 1100},
+					{Date: "20240103", Equity: 990},
+					{Date: "20240104", Equity: 1050},
+					{Date: "20240105", Equity: 1070},
+				},
+			},
+			expect: &BacktestReport{
+				InitialCapital:  1000,
+				FinalEquity:     1070,
+				TotalProfitLoss: 70,
+				TotalTrades:     4,
+				WinningTrades:   2,
+				WinRate:         50,
+				AverageReturn:   2,
+				MaxDrawdown:     10,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.report.Summarize()
+
+			got, want := tt.report, tt.expect
+			if got.TotalTrades != want.TotalTrades || got.WinningTrades != want.WinningTrades {
+				t.Errorf("trades: expect %d/%d, got %d/%d",
+					want.WinningTrades, want.TotalTrades, got.WinningTrades, got.TotalTrades)
+			}
+
+			metrics := map[string][2]float32{
+				"FinalEquity":     {want.FinalEquity, got.FinalEquity},
+				"TotalProfitLoss": {want.TotalProfitLoss, got.TotalProfitLoss},
+				"WinRate":         {want.WinRate, got.WinRate},
+				"AverageReturn":   {want.AverageReturn, got.AverageReturn},
+				"MaxDrawdown":     {want.MaxDrawdown, got.MaxDrawdown},
+			}
+			for name, v := range metrics {
+				if math.Abs(float64(v[0]-v[1])) > 1e-4 {
+					t.Errorf("%s: expect %v, got %v", name, v[0], v[1])
+				}
+			}
+		})
+	}
+}
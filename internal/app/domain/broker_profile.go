@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const (
+	// RebateImmediate charges the discounted fee on every trade.
+	RebateImmediate = "immediate"
+	// RebateMonthly charges the full fee on every trade and credits the
+	// discount back once the month is over.
+	RebateMonthly = "monthly"
+
+	SecurityStock   = "stock"
+	SecurityETF     = "etf"
+	SecurityBondETF = "bond_etf"
+)
+
+// BrokerProfile is the fee schedule of a broker account. Profiles without an
+// owner are shared by every user.
+type BrokerProfile struct {
+	Time
+	Name            string  `json:"name"`
+	RebateTiming    string  `json:"rebateTiming"`
+	FeeRate         float32 `json:"feeRate"`
+	FeeDiscount     float32 `json:"feeDiscount"`
+	MinFee          float32 `json:"minFee"`
+	OddLotMinFee    float32 `json:"oddLotMinFee"`
+	TaxRate         float32 `json:"taxRate"`
+	DayTradeTaxRate float32 `json:"dayTradeTaxRate"`
+	ETFTaxRate      float32 `json:"etfTaxRate"`
+	BondETFTaxRate  float32 `json:"bondETFTaxRate"`
+	ID
+	UserID uuid.UUID `json:"userID"`
+}
+
+// DefaultBrokerProfile is the schedule of users who never picked a profile,
+// the statutory rates with a 75% fee discount.
+func DefaultBrokerProfile() *BrokerProfile {
+	return &BrokerProfile{
+		Name:            "default",
+		RebateTiming:    RebateImmediate,
+		FeeRate:         0.001425,
+		FeeDiscount:     0.25,
+		MinFee:          20,
+		OddLotMinFee:    1,
+		TaxRate:         0.003,
+		DayTradeTaxRate: 0.0015,
+		ETFTaxRate:      0.001,
+		BondETFTaxRate:  0,
+	}
+}
+
+func (p *BrokerProfile) Validate() error {
+	if p.Name == "" {
+		return &DataValidationError{dataType: "broker profile name"}
+	}
+
+	switch p.RebateTiming {
+	case RebateImmediate, RebateMonthly:
+	default:
+		return &DataValidationError{dataType: "broker rebate timing"}
+	}
+
+	if p.FeeRate <= 0 || p.FeeRate >= 1 || p.FeeDiscount <= 0 || p.FeeDiscount > 1 {
+		return &DataValidationError{dataType: "broker fee rate"}
+	}
+
+	if p.MinFee < 0 || p.OddLotMinFee < 0 {
+		return &DataValidationError{dataType: "broker minimum fee"}
+	}
+
+	for _, rate := range []float32{p.TaxRate, p.DayTradeTaxRate, p.ETFTaxRate, p.BondETFTaxRate} {
+		if rate < 0 || rate >= 1 {
+			return &DataValidationError{dataType: "broker tax rate"}
+		}
+	}
+
+	return nil
+}
+
+// Fee is the broker fee charged when the trade settles.
+func (p *BrokerProfile) Fee(amount float32, lotType string) float32 {
+	if p.RebateTiming == RebateMonthly {
+		return p.fee(amount, lotType, 1)
+	}
+
+	return p.NetFee(amount, lotType)
+}
+
+// NetFee is the broker fee of the trade once the discount is applied.
+func (p *BrokerProfile) NetFee(amount float32, lotType string) float32 {
+	return p.fee(amount, lotType, p.FeeDiscount)
+}
+
+// Rebate is the part of the fee charged on the trade which the broker credits
+// back at the end of the month.
+func (p *BrokerProfile) Rebate(amount float32, lotType string) float32 {
+	return p.Fee(amount, lotType) - p.NetFee(amount, lotType)
+}
+
+func (p *BrokerProfile) fee(amount float32, lotType string, discount float32) float32 {
+	if amount == 0 {
+		return 0
+	}
+
+	minFee := p.MinFee
+	if IsOddLot(lotType) {
+		minFee = p.OddLotMinFee
+	}
+
+	return max(amount*p.FeeRate*discount, minFee)
+}
+
+// Tax is the transaction tax charged on the amount of a closing trade. Only
+// board lots of stocks get the day trade rate, odd lots cannot be day traded.
+func (p *BrokerProfile) Tax(stockID string, amount float32, lotType string, dayTrade bool) float32 {
+	switch SecurityType(stockID) {
+	case SecurityBondETF:
+		return amount * p.BondETFTaxRate
+	case SecurityETF:
+		return amount * p.ETFTaxRate
+	}
+
+	if dayTrade && !IsOddLot(lotType) {
+		return amount * p.DayTradeTaxRate
+	}
+
+	return amount * p.TaxRate
+}
+
+// SecurityType classifies a listed security by its code, ETFs are numbered
+// from 00 and bond ETFs are suffixed with B.
+func SecurityType(stockID string) string {
+	if !strings.HasPrefix(stockID, "00") {
+		return SecurityStock
+	}
+
+	if strings.HasSuffix(stockID, "B") {
+		return SecurityBondETF
+	}
+
+	return SecurityETF
+}
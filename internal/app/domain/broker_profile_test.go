@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func TestBrokerProfileFee(t *testing.T) {
+	t.Parallel()
+
+	monthly := DefaultBrokerProfile()
+	monthly.RebateTiming = RebateMonthly
+
+	tests := []struct {
+		name       string
+		profile    *BrokerProfile
+		lotType    string
+		amount     float32
+		wantFee    float32
+		wantRebate float32
+	}{
+		{name: "board lot", profile: DefaultBrokerProfile(), lotType: LotTypeBoard, amount: 600000, wantFee: 213.75},
+		{name: "board lot minimum fee", profile: DefaultBrokerProfile(), lotType: LotTypeBoard, amount: 10000, wantFee: 20},
+		{name: "odd lot minimum fee", profile: DefaultBrokerProfile(), lotType: LotTypeIntradayOddLot, amount: 600, wantFee: 1},
+		{name: "no trade", profile: DefaultBrokerProfile(), lotType: LotTypeOddLot},
+		{
+			name:       "monthly rebate",
+			profile:    monthly,
+			lotType:    LotTypeBoard,
+			amount:     600000,
+			wantFee:    855,
+			wantRebate: 641.25,
+		},
+		{name: "monthly rebate below minimum fee", profile: monthly, lotType: LotTypeBoard, amount: 10000, wantFee: 20},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.profile.Fee(tt.amount, tt.lotType); got != tt.wantFee {
+				t.Errorf("expect fee %v, got %v", tt.wantFee, got)
+			}
+
+			if got := tt.profile.Rebate(tt.amount, tt.lotType); got != tt.wantRebate {
+				t.Errorf("expect rebate %v, got %v", tt.wantRebate, got)
+			}
+		})
+	}
+}
+
+func TestBrokerProfileTax(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		stockID  string
+		lotType  string
+		dayTrade bool
+		want     float32
+	}{
+		{name: "stock", stockID: "2330", lotType: LotTypeBoard, want: 300},
+		{name: "stock day trade", stockID: "2330", lotType: LotTypeBoard, dayTrade: true, want: 150},
+		{name: "odd lot day trade", stockID: "2330", lotType: LotTypeOddLot, dayTrade: true, want: 300},
+		{name: "etf", stockID: "0050", lotType: LotTypeBoard, dayTrade: true, want: 100},
+		{name: "bond etf", stockID: "00679B", lotType: LotTypeBoard},
+	}
+
+	profile := DefaultBrokerProfile()
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := profile.Tax(tt.stockID, 100000, tt.lotType, tt.dayTrade); math.Abs(float64(got-tt.want)) > 0.01 {
+				t.Errorf("expect tax %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBrokerProfileValidate(t *testing.T) {
+	t.Parallel()
+
+	profile := DefaultBrokerProfile()
+	if err := profile.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	profile.RebateTiming = "yearly"
+
+	var validationErr *DataValidationError
+	if err := profile.Validate(); !errors.As(err, &validationErr) {
+		t.Errorf("expect validation error, got %v", err)
+	}
+}
+
+func TestOrderProfitLossWithBroker(t *testing.T) {
+	t.Parallel()
+
+	discounted := DefaultBrokerProfile()
+	discounted.FeeDiscount = 0.1
+
+	profitLoss := make([]float32, 0, 2)
+	for _, broker := range []*BrokerProfile{nil, discounted} {
+		order, err := NewOrder(uuid.Must(uuid.NewV4()), OrderTypeBuy, LotTypeBoard, TradeTypeCash, "2330", "20240102", 600, 1000, broker)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = order.Change(OrderTypeSell, "2330", "20240103", 620, 1000); err != nil {
+			t.Fatal(err)
+		}
+
+		order.CalculateProfitLoss()
+		profitLoss = append(profitLoss, order.ProfitLoss)
+	}
+
+	if profitLoss[1] <= profitLoss[0] {
+		t.Errorf("expect a cheaper broker to earn more, got %v", profitLoss)
+	}
+}
@@ -0,0 +1,414 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/traditionalchinese"
+)
+
+// Import status of a trade confirmation.
+const (
+	ImportStatusNew       = "new"
+	ImportStatusDuplicate = "duplicate"
+	ImportStatusInvalid   = "invalid"
+	ImportStatusCreated   = "created"
+	ImportStatusFailed    = "failed"
+)
+
+const (
+	// rocYearOffset converts the Minguo years of the statements, 113 is 2024.
+	rocYearOffset = 1911
+	priceCents    = 100
+)
+
+var (
+	errStatementHeader = errors.New("missing statement column")
+	errStatementSide   = errors.New("unknown trade side")
+	errStatementDate   = errors.New("invalid trade date")
+	errStatementValue  = errors.New("invalid trade value")
+)
+
+// TradeConfirmation is a fill exported by the broker, the quantity is counted
+// in shares. Line is the line of the statement it was read from.
+type TradeConfirmation struct {
+	Err          error
+	StockID      string
+	OrderType    string
+	TradeType    string
+	ExchangeDate string
+	Status       string
+	Line         int
+	Price        float32
+	Quantity     uint64
+	// Booked is the part of the quantity an earlier import booked before it
+	// failed, only the rest is left to book.
+	Booked uint64
+}
+
+// StatementParser reads the trade confirmations of a broker statement. Rows
+// which cannot be read are returned as invalid, the error is kept for the
+// statement the parser does not recognize.
+type StatementParser interface {
+	Parse(r io.Reader) ([]*TradeConfirmation, error)
+}
+
+var (
+	statementParsersMu sync.RWMutex
+	statementParsers   = map[string]StatementParser{
+		"generic": &csvStatementParser{columns: statementColumns{
+			date:     []string{"date", "exchange_date", "trade_date"},
+			stockID:  []string{"stock_id", "symbol", "code"},
+			side:     []string{"side", "order_type", "action"},
+			price:    []string{"price", "trade_price"},
+			quantity: []string{"quantity", "shares"},
+		}},
+		// 元大證券
+		"yuanta": &csvStatementParser{columns: statementColumns{
+			date:     []string{"成交日期"},
+			stockID:  []string{"股票代號", "商品代號"},
+			side:     []string{"買賣別"},
+			price:    []string{"成交單價", "成交價"},
+			quantity: []string{"成交股數"},
+		}},
+		// 富邦證券
+		"fubon": &csvStatementParser{columns: statementColumns{
+			date:     []string{"交易日期", "成交日期"},
+			stockID:  []string{"證券代號", "股票代號"},
+			side:     []string{"交易類別", "買賣別"},
+			price:    []string{"成交價", "成交價格"},
+			quantity: []string{"成交數量", "成交股數"},
+		}},
+		// 永豐金證券
+		"sinopac": &csvStatementParser{columns: statementColumns{
+			date:     []string{"日期", "成交日期"},
+			stockID:  []string{"代號", "股票代號"},
+			side:     []string{"買賣", "買賣別"},
+			price:    []string{"價格", "成交價"},
+			quantity: []string{"股數", "成交股數"},
+		}},
+	}
+)
+
+// RegisterStatementParser plugs the parser of a broker format in.
+func RegisterStatementParser(broker string, parser StatementParser) {
+	statementParsersMu.Lock()
+	defer statementParsersMu.Unlock()
+
+	statementParsers[strings.ToLower(broker)] = parser
+}
+
+// NewStatementParser returns the parser of the broker format.
+func NewStatementParser(broker string) (StatementParser, error) {
+	statementParsersMu.RLock()
+	defer statementParsersMu.RUnlock()
+
+	parser, ok := statementParsers[strings.ToLower(broker)]
+	if !ok {
+		return nil, &DataValidationError{dataType: "statement format"}
+	}
+
+	return parser, nil
+}
+
+// statementColumns lists the header names each field is exported under.
+type statementColumns struct {
+	date     []string
+	stockID  []string
+	side     []string
+	price    []string
+	quantity []string
+}
+
+// csvStatementParser reads the trade confirmation CSV exports, the columns
+// are found by their header so the extra columns of a broker are ignored.
+// Exports in Big5 are decoded.
+type csvStatementParser struct {
+	columns statementColumns
+}
+
+func (p *csvStatementParser) Parse(r io.Reader) ([]*TradeConfirmation, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(data) {
+		if data, err = traditionalchinese.Big5.NewDecoder().Bytes(data); err != nil {
+			return nil, err
+		}
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := p.columnIndex(header)
+	if err != nil {
+		return nil, err
+	}
+
+	trades := []*TradeConfirmation{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		if trade := parseTradeConfirmation(record, index, line); trade != nil {
+			trades = append(trades, trade)
+		}
+	}
+
+	return trades, nil
+}
+
+func (p *csvStatementParser) columnIndex(header []string) ([]int, error) {
+	fields := [][]string{p.columns.date, p.columns.stockID, p.columns.side, p.columns.price, p.columns.quantity}
+	index := make([]int, len(fields))
+	for idx, names := range fields {
+		index[idx] = -1
+		for col, name := range header {
+			if containsFold(names, strings.TrimSpace(name)) {
+				index[idx] = col
+
+				break
+			}
+		}
+
+		if index[idx] < 0 {
+			return nil, fmt.Errorf("%w: %s", errStatementHeader, names[0])
+		}
+	}
+
+	return index, nil
+}
+
+// parseTradeConfirmation reads the record, nil for the blank and total rows.
+func parseTradeConfirmation(record []string, index []int, line int) *TradeConfirmation {
+	cell := func(field int) string {
+		if index[field] >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[index[field]])
+	}
+
+	date, stockID, side, price, quantity := cell(0), cell(1), cell(2), cell(3), cell(4)
+	if stockID == "" || strings.Contains(date, "計") || strings.Contains(stockID, "計") {
+		return nil
+	}
+
+	// the code may be followed by the name of the stock
+	if fields := strings.Fields(stockID); len(fields) > 0 {
+		stockID = fields[0]
+	}
+
+	trade := &TradeConfirmation{Line: line, StockID: stockID, Status: ImportStatusNew}
+	var err error
+	if trade.ExchangeDate, err = parseStatementDate(date); err != nil {
+		return trade.invalid(err)
+	}
+
+	if trade.OrderType, trade.TradeType, err = parseStatementSide(side); err != nil {
+		return trade.invalid(err)
+	}
+
+	parsedPrice, err := strconv.ParseFloat(strings.ReplaceAll(price, ",", ""), 32)
+	if err != nil || parsedPrice <= 0 {
+		return trade.invalid(fmt.Errorf("%w: price %q", errStatementValue, price))
+	}
+	trade.Price = float32(parsedPrice)
+
+	trade.Quantity, err = strconv.ParseUint(strings.ReplaceAll(quantity, ",", ""), 10, 64)
+	if err != nil || trade.Quantity == 0 {
+		return trade.invalid(fmt.Errorf("%w: quantity %q", errStatementValue, quantity))
+	}
+
+	return trade
+}
+
+func (t *TradeConfirmation) invalid(err error) *TradeConfirmation {
+	t.Err = err
+	t.Status = ImportStatusInvalid
+
+	return t
+}
+
+// parseStatementSide reads the side of the fill, 資 marks a margin trade and
+// 券 a short sale, day trades (沖) settle in cash.
+func parseStatementSide(side string) (orderType, tradeType string, err error) {
+	lower := strings.ToLower(side)
+	switch {
+	case strings.Contains(side, "買") || strings.Contains(lower, "buy"):
+		orderType = OrderTypeBuy
+	case strings.Contains(side, "賣") || strings.Contains(lower, "sell"):
+		orderType = OrderTypeSell
+	default:
+		return "", "", fmt.Errorf("%w: %q", errStatementSide, side)
+	}
+
+	switch {
+	case strings.Contains(side, "資") || strings.Contains(lower, "margin"):
+		tradeType = TradeTypeMargin
+	case strings.Contains(side, "券") || strings.Contains(lower, "short"):
+		tradeType = TradeTypeShort
+	default:
+		tradeType = TradeTypeCash
+	}
+
+	return orderType, tradeType, nil
+}
+
+// parseStatementDate reads the dates in the western or the Minguo calendar
+// into the exchange date layout.
+func parseStatementDate(date string) (string, error) {
+	parts := strings.FieldsFunc(date, func(r rune) bool {
+		return r == '/' || r == '-' || r == '.'
+	})
+	if len(parts) == 1 {
+		if _, err := time.Parse(exchangeDateLayout, date); err != nil {
+			return "", fmt.Errorf("%w: %q", errStatementDate, date)
+		}
+
+		return date, nil
+	}
+
+	if len(parts) != 3 {
+		return "", fmt.Errorf("%w: %q", errStatementDate, date)
+	}
+
+	year, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", errStatementDate, date)
+	}
+	if year < rocYearOffset {
+		year += rocYearOffset
+	}
+
+	parsed, err := time.Parse("2006-1-2", fmt.Sprintf("%d-%s-%s", year, parts[1], parts[2]))
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", errStatementDate, date)
+	}
+
+	return parsed.Format(exchangeDateLayout), nil
+}
+
+func containsFold(names []string, name string) bool {
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type fillKey struct {
+	stockID   string
+	orderType string
+	date      string
+	cents     int64
+}
+
+func newFillKey(stockID, orderType, date string, price float32) fillKey {
+	return fillKey{
+		stockID:   stockID,
+		orderType: orderType,
+		date:      date,
+		cents:     int64(math.Round(float64(price) * priceCents)),
+	}
+}
+
+// DedupeTradeConfirmations marks the trades already booked by the orders as
+// duplicates. A broker fill may be booked across positions, so the booked
+// quantity of the same stock, side, date and price is consumed in statement
+// order. A trade only booked in part keeps the booked quantity.
+func DedupeTradeConfirmations(trades []*TradeConfirmation, orders []*Order) {
+	booked := make(map[fillKey]uint64)
+	for _, order := range orders {
+		if !order.IsPosition() || order.IsCancelled() {
+			continue
+		}
+
+		opening := order.OpeningSide()
+		openQuantity, closeQuantity := order.BuyQuantity, order.SellQuantity
+		if opening == OrderTypeSell {
+			openQuantity, closeQuantity = closeQuantity, openQuantity
+		}
+		booked[newFillKey(order.StockID, opening, order.OpeningExchangeDate(), order.OpeningPrice())] += openQuantity
+
+		if len(order.LotMatches) > 0 {
+			for _, match := range order.LotMatches {
+				booked[newFillKey(order.StockID, match.OrderType, match.ExchangeDate, match.Price)] += match.Quantity
+			}
+		} else if closeQuantity > 0 {
+			date, price := order.SellExchangeDate, order.SellPrice
+			if opening == OrderTypeSell {
+				date, price = order.BuyExchangeDate, order.BuyPrice
+			}
+			booked[newFillKey(order.StockID, order.ClosingSide(), date, price)] += closeQuantity
+		}
+	}
+
+	for _, trade := range trades {
+		if trade.Status != ImportStatusNew {
+			continue
+		}
+
+		key := newFillKey(trade.StockID, trade.OrderType, trade.ExchangeDate, trade.Price)
+		quantity := min(booked[key], trade.Quantity)
+		booked[key] -= quantity
+		if quantity == trade.Quantity {
+			trade.Status = ImportStatusDuplicate
+		} else {
+			trade.Booked = quantity
+		}
+	}
+}
+
+// SortTradeConfirmations orders the trades by exchange date so positions are
+// opened before they are closed. Statements listing the latest first are
+// reversed so the fills of a day keep their order.
+func SortTradeConfirmations(trades []*TradeConfirmation) {
+	var first, last string
+	for _, trade := range trades {
+		if trade.ExchangeDate == "" {
+			continue
+		}
+
+		if first == "" {
+			first = trade.ExchangeDate
+		}
+		last = trade.ExchangeDate
+	}
+
+	if first > last {
+		slices.Reverse(trades)
+	}
+
+	sort.SliceStable(trades, func(i, j int) bool {
+		return trades[i].ExchangeDate < trades[j].ExchangeDate
+	})
+}
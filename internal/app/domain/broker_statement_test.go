@@ -0,0 +1,246 @@
+package domain
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"golang.org/x/text/encoding/traditionalchinese"
+)
+
+const yuantaStatement = `成交日期,股票代號,股票名稱,買賣別,成交股數,成交單價,手續費
+113/01/03,2330 台積電,,現賣,"1,000",600,855
+113/01/02,2330,台積電,現買,"2,000",590.5,1683
+113/01/02,2317,鴻海,資買,500,105,20
+113/01/02,2454,聯發科,轉帳,1000,900,0
+,合計,,,,,2558
+`
+
+func TestStatementParserParse(t *testing.T) {
+	t.Parallel()
+
+	big5, err := traditionalchinese.Big5.NewEncoder().String(yuantaStatement)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		broker  string
+		content string
+	}{
+		{name: "utf-8", broker: "yuanta", content: yuantaStatement},
+		{name: "utf-8 with bom", broker: "Yuanta", content: "\xef\xbb\xbf" + yuantaStatement},
+		{name: "big5", broker: "yuanta", content: big5},
+	}
+
+	want := []*TradeConfirmation{
+		{Line: 2, StockID: "2330", OrderType: OrderTypeSell, TradeType: TradeTypeCash, ExchangeDate: "20240103",
+			Price: 600, Quantity: 1000, Status: ImportStatusNew},
+		{Line: 3, StockID: "2330", OrderType: OrderTypeBuy, TradeType: TradeTypeCash, ExchangeDate: "20240102",
+			Price: 590.5, Quantity: 2000, Status: ImportStatusNew},
+		{Line: 4, StockID: "2317", OrderType: OrderTypeBuy, TradeType: TradeTypeMargin, ExchangeDate: "20240102",
+			Price: 105, Quantity: 500, Status: ImportStatusNew},
+		{Line: 5, StockID: "2454", ExchangeDate: "20240102", Status: ImportStatusInvalid},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			parser, err := NewStatementParser(tt.broker)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			trades, err := parser.Parse(strings.NewReader(tt.content))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(trades) != len(want) {
+				t.Fatalf("expect %d trades, got %d", len(want), len(trades))
+			}
+
+			for idx, trade := range trades {
+				w := want[idx]
+				if trade.Line != w.Line || trade.StockID != w.StockID || trade.OrderType != w.OrderType ||
+					trade.TradeType != w.TradeType || trade.ExchangeDate != w.ExchangeDate ||
+					trade.Price != w.Price || trade.Quantity != w.Quantity || trade.Status != w.Status {
+					t.Errorf("expect %+v, got %+v", w, trade)
+				}
+			}
+
+			if !errors.Is(trades[3].Err, errStatementSide) {
+				t.Errorf("expect unknown side error, got %v", trades[3].Err)
+			}
+		})
+	}
+}
+
+func TestStatementParserUnrecognized(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewStatementParser("unknown"); err == nil {
+		t.Error("expect error of unknown format")
+	}
+
+	parser, err := NewStatementParser("generic")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = parser.Parse(bytes.NewBufferString("date,symbol,side,price\n20240102,2330,buy,600\n"))
+	if !errors.Is(err, errStatementHeader) {
+		t.Errorf("expect missing column error, got %v", err)
+	}
+}
+
+func TestParseStatementDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		date    string
+		want    string
+		wantErr bool
+	}{
+		{date: "20240102", want: "20240102"},
+		{date: "2024/01/02", want: "20240102"},
+		{date: "2024-1-2", want: "20240102"},
+		{date: "113/01/02", want: "20240102"},
+		{date: "113.1.2", want: "20240102"},
+		{date: "2024/13/02", wantErr: true},
+		{date: "0102", wantErr: true},
+		{date: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.date, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseStatementDate(tt.date)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expect error %v, got %v", tt.wantErr, err)
+			}
+
+			if got != tt.want {
+				t.Errorf("expect %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDedupeTradeConfirmations(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.Must(uuid.NewV4())
+	first, err := NewOrder(userID, OrderTypeBuy, LotTypeBoard, TradeTypeCash, "2330", "20240102", 590.5, 1000, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := NewOrder(userID, OrderTypeBuy, LotTypeBoard, TradeTypeCash, "2330", "20240102", 590.5, 1000, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a sale closing both positions is booked as two lot matches
+	first.LotMatches = []*LotMatch{{OrderType: OrderTypeSell, ExchangeDate: "20240103", Price: 600, Quantity: 1000}}
+	second.LotMatches = []*LotMatch{{OrderType: OrderTypeSell, ExchangeDate: "20240103", Price: 600, Quantity: 500}}
+
+	cancelled, err := NewOrder(userID, OrderTypeBuy, LotTypeBoard, TradeTypeCash, "2317", "20240102", 105, 1000, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancelled.Status = string(orderCancelledState)
+
+	trades := []*TradeConfirmation{
+		{StockID: "2330", OrderType: OrderTypeBuy, ExchangeDate: "20240102", Price: 590.5, Quantity: 2000},
+		{StockID: "2330", OrderType: OrderTypeSell, ExchangeDate: "20240103", Price: 600, Quantity: 1500},
+		{StockID: "2330", OrderType: OrderTypeSell, ExchangeDate: "20240103", Price: 600, Quantity: 500},
+		{StockID: "2317", OrderType: OrderTypeBuy, ExchangeDate: "20240102", Price: 105, Quantity: 1000},
+		{StockID: "2330", OrderType: OrderTypeBuy, ExchangeDate: "20240104", Price: 610, Quantity: 1000},
+	}
+	for _, trade := range trades {
+		trade.Status = ImportStatusNew
+	}
+	invalid := &TradeConfirmation{StockID: "2330", Status: ImportStatusInvalid}
+	trades = append(trades, invalid)
+
+	DedupeTradeConfirmations(trades, []*Order{first, second, cancelled})
+
+	want := []string{
+		ImportStatusDuplicate,
+		ImportStatusDuplicate,
+		ImportStatusNew,
+		ImportStatusNew,
+		ImportStatusNew,
+		ImportStatusInvalid,
+	}
+	for idx, trade := range trades {
+		if trade.Status != want[idx] {
+			t.Errorf("trade %d: expect %s, got %s", idx, want[idx], trade.Status)
+		}
+	}
+}
+
+func TestDedupeTradeConfirmations_PartiallyBooked(t *testing.T) {
+	t.Parallel()
+
+	// an earlier import booked the board lot of the fill and failed on its odd lot
+	board, err := NewOrder(uuid.Must(uuid.NewV4()), OrderTypeBuy, LotTypeBoard, TradeTypeCash,
+		"2330", "20240102", 590.5, 1000, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	trades := []*TradeConfirmation{
+		{StockID: "2330", OrderType: OrderTypeBuy, ExchangeDate: "20240102", Price: 590.5, Quantity: 1500},
+		{StockID: "2330", OrderType: OrderTypeBuy, ExchangeDate: "20240102", Price: 590.5, Quantity: 300},
+	}
+	for _, trade := range trades {
+		trade.Status = ImportStatusNew
+	}
+
+	DedupeTradeConfirmations(trades, []*Order{board})
+
+	want := []struct {
+		status string
+		booked uint64
+	}{
+		{status: ImportStatusNew, booked: 1000},
+		{status: ImportStatusNew, booked: 0},
+	}
+	for idx, trade := range trades {
+		if trade.Status != want[idx].status || trade.Booked != want[idx].booked {
+			t.Errorf("trade %d: expect %s with %d booked, got %s with %d booked",
+				idx, want[idx].status, want[idx].booked, trade.Status, trade.Booked)
+		}
+	}
+}
+
+func TestSortTradeConfirmations(t *testing.T) {
+	t.Parallel()
+
+	sell := &TradeConfirmation{ExchangeDate: "20240103"}
+	dayTradeSell := &TradeConfirmation{ExchangeDate: "20240102"}
+	buy := &TradeConfirmation{ExchangeDate: "20240102"}
+	invalid := &TradeConfirmation{}
+
+	// latest first, the day trade bought before it sold
+	trades := []*TradeConfirmation{sell, dayTradeSell, buy, invalid}
+	SortTradeConfirmations(trades)
+
+	want := []*TradeConfirmation{invalid, buy, dayTradeSell, sell}
+	for idx, trade := range trades {
+		if trade != want[idx] {
+			t.Errorf("position %d: expect %+v, got %+v", idx, want[idx], trade)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package domain
+
+import "sort"
+
+// CorporateAction describes the distributions taking effect on an ex-date and
+// paid to the holders on the payment date.
+// Stock dividend, rights and split ratios are counted in shares per held share,
+// e.g. a NT$1 stock dividend on NT$10 par is 0.1 and a 1-to-4 split is 4.
+type CorporateAction struct {
+	Time
+	StockID       string  `json:"stockId"`
+	ExDate        string  `json:"exDate"`
+	RecordDate    string  `json:"recordDate"`
+	PaymentDate   string  `json:"paymentDate"`
+	CashDividend  float32 `json:"cashDividend"`
+	StockDividend float32 `json:"stockDividend"`
+	RightsRatio   float32 `json:"rightsRatio"`
+	RightsPrice   float32 `json:"rightsPrice"`
+	SplitRatio    float32 `json:"splitRatio"`
+	ID
+}
+
+// ReferencePrice follows the TWSE ex-rights/ex-dividend reference price
+// formula: (close - cash + rights price * rights ratio) /
+// (1 + stock dividend + rights ratio), then divided by the split ratio.
+func (c *CorporateAction) ReferencePrice(previousClose float32) float32 {
+	price := (previousClose - c.CashDividend + c.RightsPrice*c.RightsRatio) /
+		(1 + c.StockDividend + c.RightsRatio)
+	if c.SplitRatio > 0 {
+		price /= c.SplitRatio
+	}
+
+	return price
+}
+
+// AdjustmentFactor is the ratio applied to prices before the ex-date so they
+// line up with the prices after it.
+func (c *CorporateAction) AdjustmentFactor(previousClose float32) float32 {
+	if previousClose <= 0 {
+		return 1
+	}
+
+	factor := c.ReferencePrice(previousClose) / previousClose
+	if factor <= 0 {
+		return 1
+	}
+
+	return factor
+}
+
+// BackAdjust returns copies of the bars with prices before every ex-date
+// multiplied by the cumulative adjustment factor, so the latest bars keep
+// their raw prices. Every action adjusts the bars before its ex-date, callers
+// restrict the actions to the date the series should be anchored on. The
+// output keeps the input order, volumes are untouched.
+func BackAdjust(bars []*DailyClose, actions []*CorporateAction) []*DailyClose {
+	output := make([]*DailyClose, len(bars))
+	for i, bar := range bars {
+		adjusted := *bar
+		output[i] = &adjusted
+	}
+
+	if len(actions) == 0 {
+		return output
+	}
+
+	series := make(map[string][]*DailyClose)
+	for _, bar := range output {
+		series[bar.StockID] = append(series[bar.StockID], bar)
+	}
+
+	actionMap := make(map[string][]*CorporateAction)
+	for _, action := range actions {
+		actionMap[action.StockID] = append(actionMap[action.StockID], action)
+	}
+
+	for stockID, stockBars := range series {
+		backAdjustSeries(stockBars, actionMap[stockID])
+	}
+
+	return output
+}
+
+func backAdjustSeries(bars []*DailyClose, actions []*CorporateAction) {
+	if len(actions) == 0 {
+		return
+	}
+
+	sort.Slice(bars, func(i, j int) bool {
+		return bars[i].ExchangeDate < bars[j].ExchangeDate
+	})
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].ExDate < actions[j].ExDate
+	})
+
+	// factors[i] applies to every bar before actions[i].ExDate
+	factors := make([]float32, len(actions))
+	for i, action := range actions {
+		factors[i] = 1
+		idx := sort.Search(len(bars), func(n int) bool {
+			return bars[n].ExchangeDate >= action.ExDate
+		})
+		if idx > 0 {
+			factors[i] = action.AdjustmentFactor(bars[idx-1].Close)
+		}
+	}
+
+	cumulative := float32(1)
+	next := len(actions) - 1
+	for i := len(bars) - 1; i >= 0; i-- {
+		for next >= 0 && bars[i].ExchangeDate < actions[next].ExDate {
+			cumulative *= factors[next]
+			next--
+		}
+
+		if cumulative == 1 {
+			continue
+		}
+
+		bar := bars[i]
+		previousClose := bar.Close - bar.PriceDiff
+		bar.Open *= cumulative
+		bar.High *= cumulative
+		bar.Low *= cumulative
+		bar.Close *= cumulative
+		bar.PriceDiff = bar.Close - previousClose*cumulative
+	}
+
+	// the first bar after an ex-date is quoted against the reference price,
+	// restate its diff against the adjusted previous close
+	for i := 1; i < len(bars); i++ {
+		restateDiff(bars[i-1], bars[i], actions)
+	}
+}
+
+func restateDiff(previous, current *DailyClose, actions []*CorporateAction) {
+	for _, action := range actions {
+		if previous.ExchangeDate < action.ExDate && current.ExchangeDate >= action.ExDate {
+			current.PriceDiff = current.Close - previous.Close
+			return
+		}
+	}
+}
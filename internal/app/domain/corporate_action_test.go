@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCorporateActionReferencePrice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		action *CorporateAction
+		name   string
+		close  float32
+		expect float32
+	}{
+		{
+			name:   "cash dividend",
+			action: &CorporateAction{CashDividend: 3},
+			close:  600,
+			expect: 597,
+		},
+		{
+			name:   "cash and stock dividend",
+			action: &CorporateAction{CashDividend: 1, StockDividend: 0.1},
+			close:  56,
+			expect: 50,
+		},
+		{
+			name:   "rights offering",
+			action: &CorporateAction{RightsRatio: 0.1, RightsPrice: 30},
+			close:  63,
+			expect: 60,
+		},
+		{
+			name:   "split",
+			action: &CorporateAction{SplitRatio: 4},
+			close:  400,
+			expect: 100,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.action.ReferencePrice(tt.close); math.Abs(float64(got-tt.expect)) > 1e-4 {
+				t.Errorf("expect %v, got %v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestBackAdjust(t *testing.T) {
+	t.Parallel()
+
+	// descending like RetrieveDailyCloseHistory, ex-dividend on 20240613
+	bars := []*DailyClose{
+		{StockID: "2330", ExchangeDate: "20240614", Open: 98, High: 99, Low: 97, Close: 99, PriceDiff: 1},
+		{StockID: "2330", ExchangeDate: "20240613", Open: 97, High: 98, Low: 96, Close: 98, PriceDiff: 3},
+		{StockID: "2330", ExchangeDate: "20240612", Open: 99, High: 101, Low: 99, Close: 100, PriceDiff: 1},
+		{StockID: "2330", ExchangeDate: "20240611", Open: 98, High: 100, Low: 97, Close: 99, PriceDiff: 1},
+		{StockID: "2317", ExchangeDate: "20240612", Open: 10, High: 10, Low: 10, Close: 10},
+	}
+	actions := []*CorporateAction{
+		{StockID: "2330", ExDate: "20240613", CashDividend: 5},
+	}
+
+	got := BackAdjust(bars, actions)
+
+	expect := []struct {
+		date      string
+		close     float32
+		high      float32
+		priceDiff float32
+	}{
+		{date: "20240614", close: 99, high: 99, priceDiff: 1},
+		{date: "20240613", close: 98, high: 98, priceDiff: 3},
+		{date: "20240612", close: 95, high: 95.95, priceDiff: 0.95},
+		{date: "20240611", close: 94.05, high: 95, priceDiff: 0.95},
+		{date: "20240612", close: 10, high: 10, priceDiff: 0},
+	}
+
+	for i, e := range expect {
+		bar := got[i]
+		if bar.ExchangeDate != e.date ||
+			math.Abs(float64(bar.Close-e.close)) > 1e-3 ||
+			math.Abs(float64(bar.High-e.high)) > 1e-3 ||
+			math.Abs(float64(bar.PriceDiff-e.priceDiff)) > 1e-3 {
+			t.Errorf("bar %d: expect %+v, got %+v", i, e, bar)
+		}
+	}
+
+	if bars[2].Close != 100 {
+		t.Errorf("expect input bars untouched, got %v", bars[2].Close)
+	}
+}
@@ -61,6 +61,9 @@ func mapToDailyClose(s any) *DailyClose {
 	val := reflect.ValueOf(s).Elem()
 	obj.StockID = val.FieldByName("StockID").String()
 	obj.ExchangeDate = val.FieldByName("ExchangeDate").String()
+	obj.Open = helper.DecimalToFloat32(val.FieldByName("Open").Interface().(decimal.Big))
+	obj.High = helper.DecimalToFloat32(val.FieldByName("High").Interface().(decimal.Big))
+	obj.Low = helper.DecimalToFloat32(val.FieldByName("Low").Interface().(decimal.Big))
 	obj.Close = helper.DecimalToFloat32(val.FieldByName("Close").Interface().(decimal.Big))
 	obj.TradedShares = val.FieldByName("TradeShares").Interface().(sql.NullInt64).Int64
 
@@ -0,0 +1,222 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const (
+	// ParValue of a share, fractional shares of a stock dividend are paid in
+	// cash at par.
+	ParValue = 10
+	// DividendPremiumRate is the supplementary health insurance premium
+	// withheld from a dividend payment reaching DividendPremiumThreshold.
+	DividendPremiumRate      = 0.0211
+	DividendPremiumThreshold = 20000
+
+	// BookClosureDays the register of a listed company stays closed for up to
+	// the record date, SettlementDays the business days a trade takes to
+	// reach the register.
+	BookClosureDays = 5
+	SettlementDays  = 2
+
+	// shares per held share are rounded before flooring so float32 ratios
+	// like 0.7 do not lose a share
+	shareRatioPrecision = 1e4
+)
+
+// Entitlement is what a position receives from a corporate action on its
+// payment date. The rights are only offered, the shares and their cost are
+// booked once the holder subscribes.
+type Entitlement struct {
+	PaymentDate       string
+	StockID           string
+	ID                uuid.UUID
+	CorporateActionID uuid.UUID
+	OrderID           uuid.UUID
+	UserID            uuid.UUID
+	HeldQuantity      uint64
+	StockShares       uint64
+	RightsShares      uint64
+	CashDividend      float32
+	CashInLieu        float32
+	Premium           float32
+	RightsCost        float32
+	RightsSubscribed  bool
+}
+
+// CashAmount is the net cash the position receives.
+func (e *Entitlement) CashAmount() float32 {
+	return e.CashDividend + e.CashInLieu - e.Premium
+}
+
+// CanSubscribeRights reports whether rights are offered and not subscribed yet.
+func (e *Entitlement) CanSubscribeRights() bool {
+	return e.RightsShares > 0 && !e.RightsSubscribed
+}
+
+// CutoffDate is the first exchange date whose trades miss the register of the
+// record date, the trades before it settle by the day the book closes. Only
+// weekends are skipped as the exchange holidays are not known here. The
+// actions imported without a record date fall back to the ex-date.
+func (c *CorporateAction) CutoffDate() string {
+	recordDate, err := time.Parse(exchangeDateLayout, c.RecordDate)
+	if err != nil {
+		return c.ExDate
+	}
+
+	// the register takes the transfers settled before the book closes
+	lastTrade := recordDate.AddDate(0, 0, -BookClosureDays)
+	for !isBusinessDay(lastTrade) {
+		lastTrade = lastTrade.AddDate(0, 0, -1)
+	}
+
+	for settled := 0; settled < SettlementDays; {
+		lastTrade = lastTrade.AddDate(0, 0, -1)
+		if isBusinessDay(lastTrade) {
+			settled++
+		}
+	}
+
+	cutoff := lastTrade.AddDate(0, 0, 1)
+	for !isBusinessDay(cutoff) {
+		cutoff = cutoff.AddDate(0, 0, 1)
+	}
+
+	return cutoff.Format(exchangeDateLayout)
+}
+
+func isBusinessDay(date time.Time) bool {
+	return date.Weekday() != time.Saturday && date.Weekday() != time.Sunday
+}
+
+// Entitle returns the entitlement of the position, nil when it did not hold
+// shares before the cutoff date of the record date. Short positions are left
+// out as the exchange has short sales covered before the book closes.
+func (c *CorporateAction) Entitle(order *Order) *Entitlement {
+	held := order.HeldQuantity(c.CutoffDate())
+	if held == 0 {
+		return nil
+	}
+
+	entitlement := &Entitlement{
+		PaymentDate:       c.PaymentDate,
+		StockID:           c.StockID,
+		CorporateActionID: c.ID.ID,
+		OrderID:           order.ID,
+		UserID:            order.UserID,
+		HeldQuantity:      held,
+		CashDividend:      float32(held) * c.CashDividend,
+	}
+
+	if entitlement.CashDividend >= DividendPremiumThreshold {
+		entitlement.Premium = entitlement.CashDividend * DividendPremiumRate
+	}
+
+	shares, fraction := distributedShares(held, c.StockDividend)
+	entitlement.StockShares = shares
+	entitlement.CashInLieu = float32(fraction * ParValue)
+
+	entitlement.RightsShares, _ = distributedShares(held, c.RightsRatio)
+	entitlement.RightsCost = float32(entitlement.RightsShares) * c.RightsPrice
+
+	if entitlement.CashAmount() <= 0 && entitlement.StockShares == 0 && entitlement.RightsShares == 0 {
+		return nil
+	}
+
+	return entitlement
+}
+
+// distributedShares splits the shares distributed for the held quantity into
+// whole shares and the fractional share left.
+func distributedShares(held uint64, ratio float32) (uint64, float64) {
+	if ratio <= 0 {
+		return 0, 0
+	}
+
+	shares := math.Round(float64(held)*float64(ratio)*shareRatioPrecision) / shareRatioPrecision
+	whole := math.Floor(shares)
+
+	return uint64(whole), shares - whole
+}
+
+// HeldQuantity is the quantity of the long position held before the date,
+// positions booked before the lot ledger count their closing trade as a
+// whole.
+func (order *Order) HeldQuantity(date string) uint64 {
+	if !order.IsPosition() || order.IsCancelled() || order.OpeningSide() != OrderTypeBuy ||
+		order.BuyExchangeDate == "" || order.BuyExchangeDate >= date {
+		return 0
+	}
+
+	var sold uint64
+	if len(order.LotMatches) > 0 {
+		for _, match := range order.LotMatches {
+			if match.ExchangeDate < date {
+				sold += match.Quantity
+			}
+		}
+	} else if order.SellQuantity > 0 && order.SellExchangeDate < date {
+		sold = order.SellQuantity
+	}
+
+	return order.BuyQuantity - min(sold, order.BuyQuantity)
+}
+
+// TotalReturn is the profit loss of the position along with the cash it
+// received from corporate actions.
+func (order *Order) TotalReturn() float32 {
+	return order.ProfitLoss + order.Dividends
+}
+
+// Distribute adds the stock dividend of the entitlement to the position and
+// records the cash received, the rights are left to SubscribeRights.
+func (order *Order) Distribute(entitlement *Entitlement) error {
+	if entitlement.OrderID != order.ID {
+		return &DataValidationError{dataType: "entitlement order"}
+	}
+
+	// an entitlement offering rights only has nothing to book yet
+	if entitlement.StockShares == 0 && entitlement.CashAmount() == 0 {
+		return nil
+	}
+
+	event := &OrderDistributed{
+		ExchangeDate: entitlement.PaymentDate,
+		Quantity:     entitlement.StockShares,
+		CashAmount:   entitlement.CashAmount(),
+	}
+
+	if err := order.applyChange(event); err != nil {
+		return err
+	}
+
+	// a position sold after the ex-date stays closed on a cash distribution
+	if order.QuantityMatched() {
+		return order.Close()
+	}
+
+	return nil
+}
+
+// SubscribeRights adds the rights shares the holder subscribed to the
+// position, their cost raises the cost basis.
+func (order *Order) SubscribeRights(entitlement *Entitlement) error {
+	if entitlement.OrderID != order.ID {
+		return &DataValidationError{dataType: "entitlement order"}
+	}
+
+	if !entitlement.CanSubscribeRights() {
+		return &DataValidationError{dataType: "entitlement rights"}
+	}
+
+	event := &OrderDistributed{
+		ExchangeDate: entitlement.PaymentDate,
+		Quantity:     entitlement.RightsShares,
+		Cost:         entitlement.RightsCost,
+	}
+
+	return order.applyChange(event)
+}
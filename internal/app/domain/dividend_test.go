@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func TestCorporateActionEntitle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		action    *CorporateAction
+		buyDate   string
+		sellDate  string
+		sold      uint64
+		want      *Entitlement
+		wantEmpty bool
+	}{
+		{
+			name:    "cash dividend",
+			action:  &CorporateAction{ExDate: "20240615", CashDividend: 3.5},
+			buyDate: "20240102",
+			want:    &Entitlement{HeldQuantity: 2000, CashDividend: 7000},
+		},
+		{
+			name:    "premium withheld from a large dividend",
+			action:  &CorporateAction{ExDate: "20240615", CashDividend: 10},
+			buyDate: "20240102",
+			want:    &Entitlement{HeldQuantity: 2000, CashDividend: 20000, Premium: 422},
+		},
+		{
+			name:    "stock dividend pays the fractional share at par",
+			action:  &CorporateAction{ExDate: "20240615", StockDividend: 0.0755},
+			buyDate: "20240102",
+			sold:    500,
+			want:    &Entitlement{HeldQuantity: 1500, StockShares: 113, CashInLieu: 2.5},
+		},
+		{
+			name:    "rights issue",
+			action:  &CorporateAction{ExDate: "20240615", RightsRatio: 0.1, RightsPrice: 50},
+			buyDate: "20240102",
+			want:    &Entitlement{HeldQuantity: 2000, RightsShares: 200, RightsCost: 10000},
+		},
+		{
+			name:     "sold after the ex-date",
+			action:   &CorporateAction{ExDate: "20240615", CashDividend: 1},
+			buyDate:  "20240102",
+			sellDate: "20240620",
+			sold:     2000,
+			want:     &Entitlement{HeldQuantity: 2000, CashDividend: 2000},
+		},
+		{
+			name:      "sold before the ex-date",
+			action:    &CorporateAction{ExDate: "20240615", CashDividend: 1},
+			buyDate:   "20240102",
+			sellDate:  "20240614",
+			sold:      2000,
+			wantEmpty: true,
+		},
+		{
+			name:      "bought on the ex-date",
+			action:    &CorporateAction{ExDate: "20240615", CashDividend: 1},
+			buyDate:   "20240615",
+			wantEmpty: true,
+		},
+		{
+			name:    "bought before the cutoff of the record date",
+			action:  &CorporateAction{ExDate: "20240611", RecordDate: "20240619", CashDividend: 1},
+			buyDate: "20240612",
+			want:    &Entitlement{HeldQuantity: 2000, CashDividend: 2000},
+		},
+		{
+			name:      "bought too late to settle before the book closes",
+			action:    &CorporateAction{ExDate: "20240611", RecordDate: "20240619", CashDividend: 1},
+			buyDate:   "20240613",
+			wantEmpty: true,
+		},
+		{
+			name:     "sold settling after the book closed",
+			action:   &CorporateAction{ExDate: "20240615", RecordDate: "20240619", CashDividend: 1},
+			buyDate:  "20240102",
+			sellDate: "20240613",
+			sold:     2000,
+			want:     &Entitlement{HeldQuantity: 2000, CashDividend: 2000},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			order, err := NewOrder(uuid.Must(uuid.NewV4()), OrderTypeBuy, LotTypeBoard, TradeTypeCash,
+				"2330", tt.buyDate, 100, 2000, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.sold > 0 {
+				sellDate := tt.sellDate
+				if sellDate == "" {
+					sellDate = "20240301"
+				}
+
+				if err = order.MatchLot(OrderTypeSell, sellDate, 110, tt.sold, 100, LotMethodFIFO); err != nil {
+					t.Fatal(err)
+				}
+				order.LotMatches = []*LotMatch{{ExchangeDate: sellDate, Quantity: tt.sold}}
+			}
+
+			got := tt.action.Entitle(order)
+			if tt.wantEmpty {
+				if got != nil {
+					t.Errorf("expect no entitlement, got %+v", got)
+				}
+
+				return
+			}
+
+			if got == nil {
+				t.Fatal("expect an entitlement")
+			}
+
+			if got.HeldQuantity != tt.want.HeldQuantity || got.StockShares != tt.want.StockShares ||
+				got.RightsShares != tt.want.RightsShares || !almostEqual(got.CashDividend, tt.want.CashDividend) ||
+				!almostEqual(got.CashInLieu, tt.want.CashInLieu) || !almostEqual(got.Premium, tt.want.Premium) ||
+				!almostEqual(got.RightsCost, tt.want.RightsCost) {
+				t.Errorf("expect entitlement %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCorporateActionCutoffDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		action *CorporateAction
+		want   string
+	}{
+		{
+			name:   "record date after a weekend",
+			action: &CorporateAction{ExDate: "20240613", RecordDate: "20240619"},
+			want:   "20240613",
+		},
+		{
+			name:   "book closing over a weekend",
+			action: &CorporateAction{ExDate: "20240614", RecordDate: "20240622"},
+			want:   "20240614",
+		},
+		{
+			name:   "settlement over a weekend",
+			action: &CorporateAction{ExDate: "20240617", RecordDate: "20240623"},
+			want:   "20240617",
+		},
+		{
+			name:   "no record date",
+			action: &CorporateAction{ExDate: "20240613"},
+			want:   "20240613",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.action.CutoffDate(); got != tt.want {
+				t.Errorf("expect cutoff date %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOrderDistribute(t *testing.T) {
+	t.Parallel()
+
+	order, err := NewOrder(uuid.Must(uuid.NewV4()), OrderTypeBuy, LotTypeBoard, TradeTypeCash,
+		"2330", "20240102", 100, 2000, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	action := &CorporateAction{ExDate: "20240615", CashDividend: 2, RightsRatio: 0.1, RightsPrice: 45}
+	entitlement := action.Entitle(order)
+	if err = order.Distribute(entitlement); err != nil {
+		t.Fatal(err)
+	}
+
+	// the rights are offered, nothing is booked before the holder subscribes
+	if order.BuyQuantity != 2000 || !almostEqual(order.BuyPrice, 100) || !almostEqual(order.Dividends, 4000) {
+		t.Errorf("unexpected distributed order %+v", order)
+	}
+
+	if err = order.SubscribeRights(entitlement); err != nil {
+		t.Fatal(err)
+	}
+
+	entitlement.RightsSubscribed = true
+	if err = order.SubscribeRights(entitlement); err == nil {
+		t.Error("expect the rights not to be subscribed twice")
+	}
+
+	if order.BuyQuantity != 2200 || !almostEqual(order.BuyPrice, 95) || !almostEqual(order.Dividends, 4000) {
+		t.Errorf("unexpected distributed order %+v", order)
+	}
+
+	if err = order.MatchLot(OrderTypeSell, "20240701", 100, 2200, 95, LotMethodFIFO); err != nil {
+		t.Fatal(err)
+	}
+
+	order.CalculateProfitLoss()
+	if order.Status != "closed" || order.TotalReturn() != order.ProfitLoss+4000 {
+		t.Errorf("unexpected total return %v of order %+v", order.TotalReturn(), order)
+	}
+
+	// a closed position receiving cash only stays closed
+	cash := &CorporateAction{ExDate: "20240620", CashDividend: 1}
+	if err = order.Distribute(cash.Entitle(order)); err != nil {
+		t.Fatal(err)
+	}
+
+	if order.Status != "closed" || !almostEqual(order.Dividends, 6200) {
+		t.Errorf("unexpected order %+v after cash distribution", order)
+	}
+}
@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	tickTimeLayout     = "15:04:05"
+	barTimeLayout      = "15:04"
+	minutesPerHour     = 60
+	defaultBarInterval = 1
+)
+
+// IntradayTick is one crawled snapshot of the session. Open, High and Low are
+// the session values so far and Volume is the cumulative traded lots.
+type IntradayTick struct {
+	Time
+	StockID      string  `json:"stockID"`
+	ExchangeDate string  `json:"exchangeDate"`
+	TickTime     string  `json:"tickTime"`
+	Open         float32 `json:"open"`
+	High         float32 `json:"high"`
+	Low          float32 `json:"low"`
+	Close        float32 `json:"close"`
+	Volume       uint64  `json:"volume"`
+	ID
+}
+
+func NewIntradayTick(r *Realtime) *IntradayTick {
+	return &IntradayTick{
+		StockID:      r.StockID,
+		ExchangeDate: r.Date,
+		TickTime:     r.ParseTime,
+		Open:         r.Open,
+		High:         r.High,
+		Low:          r.Low,
+		Close:        r.Close,
+		Volume:       r.Volume,
+	}
+}
+
+// IntradayBar is an OHLCV bar starting at StartTime (HH:MM) and lasting
+// Interval minutes.
+type IntradayBar struct {
+	StockID      string  `json:"stockID"`
+	ExchangeDate string  `json:"exchangeDate"`
+	StartTime    string  `json:"startTime"`
+	Open         float32 `json:"open"`
+	High         float32 `json:"high"`
+	Low          float32 `json:"low"`
+	Close        float32 `json:"close"`
+	Volume       uint64  `json:"volume"`
+	Interval     int32   `json:"interval"`
+}
+
+// ParseBarInterval accepts 1, 5 and 15 minutes, zero defaults to 1 minute.
+//
+//nolint:nolintlint, gomnd
+func ParseBarInterval(minutes int32) (int32, error) {
+	switch minutes {
+	case 0:
+		return defaultBarInterval, nil
+	case 1, 5, 15:
+		return minutes, nil
+	default:
+		return 0, &DataValidationError{dataType: "interval"}
+	}
+}
+
+// BuildMinuteBars rolls the ticks of one stock and session into bars of the
+// given minutes. The bar prices come from the traded prices of the ticks,
+// widened by any new session high or low first seen in the bar, and the bar
+// volume is the growth of the cumulative volume. Ticks are sparse, so a bar
+// only exists for intervals containing at least one tick.
+func BuildMinuteBars(ticks []*IntradayTick, interval int32) ([]*IntradayBar, error) {
+	if interval <= 0 {
+		return nil, &DataValidationError{dataType: "interval"}
+	}
+
+	sorted := make([]*IntradayTick, len(ticks))
+	copy(sorted, ticks)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].TickTime < sorted[j].TickTime
+	})
+
+	bars := []*IntradayBar{}
+	var bar *IntradayBar
+	var previous *IntradayTick
+
+	for _, tick := range sorted {
+		start, err := barStartTime(tick.TickTime, interval)
+		if err != nil {
+			return nil, err
+		}
+
+		if bar == nil || bar.StartTime != start {
+			bar = &IntradayBar{
+				StockID:      tick.StockID,
+				ExchangeDate: tick.ExchangeDate,
+				StartTime:    start,
+				Open:         tick.Close,
+				High:         tick.Close,
+				Low:          tick.Close,
+				Interval:     interval,
+			}
+			bars = append(bars, bar)
+		}
+
+		bar.Close = tick.Close
+		bar.High = max(bar.High, tick.Close)
+		bar.Low = min(bar.Low, tick.Close)
+
+		if previous == nil {
+			// everything before the first tick happened in its bar
+			bar.Open = tick.Open
+			bar.High = max(bar.High, tick.High)
+			bar.Low = min(bar.Low, tick.Low)
+			bar.Volume = tick.Volume
+		} else {
+			if tick.High > previous.High {
+				bar.High = max(bar.High, tick.High)
+			}
+
+			if tick.Low < previous.Low {
+				bar.Low = min(bar.Low, tick.Low)
+			}
+
+			if tick.Volume > previous.Volume {
+				bar.Volume += tick.Volume - previous.Volume
+			}
+		}
+
+		previous = tick
+	}
+
+	return bars, nil
+}
+
+func barStartTime(tickTime string, interval int32) (string, error) {
+	t, err := time.Parse(tickTimeLayout, tickTime)
+	if err != nil {
+		return "", fmt.Errorf("parse tick time %q: %w", tickTime, err)
+	}
+
+	minutes := int32(t.Hour()*minutesPerHour + t.Minute())
+	minutes -= minutes % interval
+
+	return time.Date(0, 1, 1, 0, int(minutes), 0, 0, time.UTC).Format(barTimeLayout), nil
+}
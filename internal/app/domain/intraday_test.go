@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func intradayTick(at string, open, high, low, closePrice float32, volume uint64) *IntradayTick {
+	return &IntradayTick{
+		StockID:      "2330",
+		ExchangeDate: "20240216",
+		TickTime:     at,
+		Open:         open,
+		High:         high,
+		Low:          low,
+		Close:        closePrice,
+		Volume:       volume,
+	}
+}
+
+func intradayBar(start string, open, high, low, closePrice float32, volume uint64, interval int32) *IntradayBar {
+	return &IntradayBar{
+		StockID:      "2330",
+		ExchangeDate: "20240216",
+		StartTime:    start,
+		Open:         open,
+		High:         high,
+		Low:          low,
+		Close:        closePrice,
+		Volume:       volume,
+		Interval:     interval,
+	}
+}
+
+func TestBuildMinuteBars(t *testing.T) {
+	t.Parallel()
+
+	ticks := []*IntradayTick{
+		intradayTick("09:06:05", 600, 606, 598, 604, 1800),
+		intradayTick("09:00:05", 600, 601, 599, 600, 1000),
+		intradayTick("09:03:05", 600, 603, 598, 602, 1500),
+		intradayTick("09:04:59", 600, 603, 598, 599, 1600),
+	}
+
+	tests := []struct {
+		name     string
+		interval int32
+		expect   []*IntradayBar
+	}{
+		{
+			name:     "1 minute",
+			interval: 1,
+			expect: []*IntradayBar{
+				intradayBar("09:00", 600, 601, 599, 600, 1000, 1),
+				intradayBar("09:03", 602, 603, 598, 602, 500, 1),
+				intradayBar("09:04", 599, 599, 599, 599, 100, 1),
+				intradayBar("09:06", 604, 606, 604, 604, 200, 1),
+			},
+		},
+		{
+			name:     "5 minutes",
+			interval: 5,
+			expect: []*IntradayBar{
+				intradayBar("09:00", 600, 603, 598, 599, 1600, 5),
+				intradayBar("09:05", 604, 606, 604, 604, 200, 5),
+			},
+		},
+		{
+			name:     "15 minutes",
+			interval: 15,
+			expect: []*IntradayBar{
+				intradayBar("09:00", 600, 606, 598, 604, 1800, 15),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := BuildMinuteBars(ticks, tt.interval)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, tt.expect) {
+				for i := range got {
+					t.Logf("bar %d: %+v", i, got[i])
+				}
+				t.Errorf("unexpected bars for %s", tt.name)
+			}
+		})
+	}
+}
+
+func TestParseBarInterval(t *testing.T) {
+	t.Parallel()
+
+	for in, want := range map[int32]int32{0: 1, 1: 1, 5: 5, 15: 15} {
+		got, err := ParseBarInterval(in)
+		if err != nil || got != want {
+			t.Errorf("%d: expect %d, got %d (%v)", in, want, got, err)
+		}
+	}
+
+	var validationErr *DataValidationError
+	if _, err := ParseBarInterval(30); !errors.As(err, &validationErr) {
+		t.Errorf("expect validation error, got %v", err)
+	}
+}
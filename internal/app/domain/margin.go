@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"time"
+)
+
+// Trade types of a position. Cash trades settle with the funds of the user,
+// margin buys (融資) are partly financed by the broker and short sales (融券)
+// sell shares borrowed from the broker.
+const (
+	TradeTypeCash   = "cash"
+	TradeTypeMargin = "margin"
+	TradeTypeShort  = "short"
+)
+
+const (
+	// MarginLoanRatio is the part of a margin buy financed by the broker.
+	MarginLoanRatio = 0.6
+	// ShortDepositRatio is the margin deposited for a short sale, the broker
+	// keeps the proceeds of the sale as collateral on top of it.
+	ShortDepositRatio = 0.9
+	// MarginInterestRate is the annual interest rate of the margin loan.
+	MarginInterestRate = 0.0645
+	// ShortBorrowFeeRate is charged on the amount of a short sale.
+	ShortBorrowFeeRate = 0.0008
+	// MaintenanceCallRatio is the maintenance ratio the broker calls for more
+	// margin under.
+	MaintenanceCallRatio = 1.3
+	// MaintenanceWarningRatio warns ahead of the margin call.
+	MaintenanceWarningRatio = 1.4
+
+	daysPerYear = 365
+	hoursPerDay = 24
+)
+
+func validTradeType(tradeType string) bool {
+	switch tradeType {
+	case TradeTypeCash, TradeTypeMargin, TradeTypeShort:
+		return true
+	}
+
+	return false
+}
+
+// tradeTypeOrCash defaults the positions booked before trade types to cash.
+func tradeTypeOrCash(tradeType string) string {
+	if tradeType == "" {
+		return TradeTypeCash
+	}
+
+	return tradeType
+}
+
+// validOpeningTrade reports whether the trade type can open a position on the
+// side, margin positions open with a buy and short positions with a sell.
+func validOpeningTrade(tradeType, orderType string) bool {
+	switch tradeType {
+	case TradeTypeMargin:
+		return orderType == OrderTypeBuy
+	case TradeTypeShort:
+		return orderType == OrderTypeSell
+	}
+
+	return true
+}
+
+// MarginLoan is the amount the broker finances for a margin buy.
+func MarginLoan(price float32, quantity uint64) float32 {
+	return price * float32(quantity) * MarginLoanRatio
+}
+
+// ShortCollateral is the amount the broker holds for a short sale, the
+// proceeds along with the margin deposit.
+func ShortCollateral(price float32, quantity uint64) float32 {
+	return price * float32(quantity) * (1 + ShortDepositRatio)
+}
+
+// ShortBorrowFee is the fee of borrowing the shares of a short sale.
+func ShortBorrowFee(price float32, quantity uint64) float32 {
+	return price * float32(quantity) * ShortBorrowFeeRate
+}
+
+// IsMargined reports whether the position is financed by the broker.
+func (order *Order) IsMargined() bool {
+	return order.TradeType == TradeTypeMargin || order.TradeType == TradeTypeShort
+}
+
+// MarginInterest is the interest accrued on the loan financing the quantity
+// from the opening date until the exchange date.
+func (order *Order) MarginInterest(quantity uint64, exchangeDate string) float32 {
+	if order.TradeType != TradeTypeMargin {
+		return 0
+	}
+
+	days := exchangeDays(order.BuyExchangeDate, exchangeDate)
+
+	return MarginLoan(order.BuyPrice, quantity) * MarginInterestRate * float32(days) / daysPerYear
+}
+
+// CalculateMargin fills the financed amount, the collateral, the interest
+// accrued until the exchange date and the maintenance ratio of an open
+// margined position at the current price.
+func (order *Order) CalculateMargin(currentPrice float32, exchangeDate string) {
+	if !order.IsMargined() || !order.IsOpen() || currentPrice <= 0 {
+		return
+	}
+
+	quantity := order.OpenQuantity()
+	marketValue := currentPrice * float32(quantity)
+	if order.TradeType == TradeTypeMargin {
+		order.FinancedAmount = MarginLoan(order.BuyPrice, quantity)
+		order.Collateral = marketValue
+		order.AccruedInterest = order.MarginInterest(quantity, exchangeDate)
+		order.MaintenanceRatio = marketValue / order.FinancedAmount
+	} else {
+		order.FinancedAmount = order.SellPrice * float32(quantity)
+		order.Collateral = ShortCollateral(order.SellPrice, quantity)
+		order.MaintenanceRatio = order.Collateral / marketValue
+	}
+}
+
+// MarginWarning reports whether the maintenance ratio is close to a margin
+// call, CalculateMargin has to run first.
+func (order *Order) MarginWarning() bool {
+	return order.MaintenanceRatio > 0 && order.MaintenanceRatio < MaintenanceWarningRatio
+}
+
+// MarginCalled reports whether the maintenance ratio dropped under the margin
+// call level.
+func (order *Order) MarginCalled() bool {
+	return order.MaintenanceRatio > 0 && order.MaintenanceRatio < MaintenanceCallRatio
+}
+
+// exchangeDays counts the calendar days between the exchange dates, zero when
+// either is not a valid date.
+func exchangeDays(from, to string) int {
+	start, err := time.Parse(exchangeDateLayout, from)
+	if err != nil {
+		return 0
+	}
+
+	end, err := time.Parse(exchangeDateLayout, to)
+	if err != nil || end.Before(start) {
+		return 0
+	}
+
+	return int(end.Sub(start).Hours() / hoursPerDay)
+}
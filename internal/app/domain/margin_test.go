@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func TestNewOrderTradeType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		orderType string
+		tradeType string
+		wantErr   bool
+	}{
+		{name: "cash buy", orderType: OrderTypeBuy, tradeType: TradeTypeCash},
+		{name: "cash sell", orderType: OrderTypeSell, tradeType: TradeTypeCash},
+		{name: "margin buy", orderType: OrderTypeBuy, tradeType: TradeTypeMargin},
+		{name: "short sale", orderType: OrderTypeSell, tradeType: TradeTypeShort},
+		{name: "margin sell without position", orderType: OrderTypeSell, tradeType: TradeTypeMargin, wantErr: true},
+		{name: "short cover without position", orderType: OrderTypeBuy, tradeType: TradeTypeShort, wantErr: true},
+		{name: "unknown trade type", orderType: OrderTypeBuy, tradeType: "loan", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			order, err := NewOrder(uuid.Must(uuid.NewV4()), tt.orderType, LotTypeBoard, tt.tradeType,
+				"2330", "20240102", 100, 1000, nil)
+			if tt.wantErr {
+				var validationErr *DataValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("expect validation error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if order.TradeType != tt.tradeType {
+				t.Errorf("expect trade type %s, got %s", tt.tradeType, order.TradeType)
+			}
+		})
+	}
+}
+
+func TestCalculateMargin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		orderType    string
+		tradeType    string
+		currentPrice float32
+		exchangeDate string
+		financed     float32
+		collateral   float32
+		interest     float32
+		ratio        float32
+		warning      bool
+		called       bool
+	}{
+		{
+			name:         "margin buy above warning",
+			orderType:    OrderTypeBuy,
+			tradeType:    TradeTypeMargin,
+			currentPrice: 100,
+			exchangeDate: "20240102",
+			financed:     60000,
+			collateral:   100000,
+			ratio:        1.6667,
+		},
+		{
+			name:         "margin buy accrues interest",
+			orderType:    OrderTypeBuy,
+			tradeType:    TradeTypeMargin,
+			currentPrice: 82,
+			exchangeDate: "20240201",
+			financed:     60000,
+			collateral:   82000,
+			interest:     318.08,
+			ratio:        1.3667,
+			warning:      true,
+		},
+		{
+			name:         "margin buy called",
+			orderType:    OrderTypeBuy,
+			tradeType:    TradeTypeMargin,
+			currentPrice: 75,
+			exchangeDate: "20240102",
+			financed:     60000,
+			collateral:   75000,
+			ratio:        1.25,
+			warning:      true,
+			called:       true,
+		},
+		{
+			name:         "short sale above warning",
+			orderType:    OrderTypeSell,
+			tradeType:    TradeTypeShort,
+			currentPrice: 100,
+			exchangeDate: "20240102",
+			financed:     100000,
+			collateral:   190000,
+			ratio:        1.9,
+		},
+		{
+			name:         "short sale called",
+			orderType:    OrderTypeSell,
+			tradeType:    TradeTypeShort,
+			currentPrice: 150,
+			exchangeDate: "20240102",
+			financed:     100000,
+			collateral:   190000,
+			ratio:        1.2667,
+			warning:      true,
+			called:       true,
+		},
+		{
+			name:         "cash position",
+			orderType:    OrderTypeBuy,
+			tradeType:    TradeTypeCash,
+			currentPrice: 50,
+			exchangeDate: "20240102",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			order, err := NewOrder(uuid.Must(uuid.NewV4()), tt.orderType, LotTypeBoard, tt.tradeType,
+				"2330", "20240102", 100, 1000, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			order.CalculateMargin(tt.currentPrice, tt.exchangeDate)
+			if !almostEqual(order.FinancedAmount, tt.financed) || !almostEqual(order.Collateral, tt.collateral) ||
+				!almostEqual(order.AccruedInterest, tt.interest) {
+				t.Errorf("expect financed %v collateral %v interest %v, got %v %v %v", tt.financed, tt.collateral,
+					tt.interest, order.FinancedAmount, order.Collateral, order.AccruedInterest)
+			}
+
+			if math.Abs(float64(order.MaintenanceRatio-tt.ratio)) > 0.001 {
+				t.Errorf("expect maintenance ratio %v, got %v", tt.ratio, order.MaintenanceRatio)
+			}
+
+			if order.MarginWarning() != tt.warning || order.MarginCalled() != tt.called {
+				t.Errorf("expect warning %v called %v, got %v %v", tt.warning, tt.called,
+					order.MarginWarning(), order.MarginCalled())
+			}
+		})
+	}
+}
+
+func TestMarginInterestOfClosedQuantity(t *testing.T) {
+	t.Parallel()
+
+	order, err := NewOrder(uuid.Must(uuid.NewV4()), OrderTypeBuy, LotTypeBoard, TradeTypeMargin,
+		"2330", "20240102", 100, 2000, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := order.MarginInterest(1000, "20240102"); got != 0 {
+		t.Errorf("expect no interest on the opening date, got %v", got)
+	}
+
+	// a year of interest on the loan of 60 per share
+	if got := order.MarginInterest(1000, "20250101"); !almostEqual(got, 3870) {
+		t.Errorf("expect interest 3870, got %v", got)
+	}
+
+	if got := order.MarginInterest(1000, "20231231"); got != 0 {
+		t.Errorf("expect no interest before the opening date, got %v", got)
+	}
+}
+
+func almostEqual(got, want float32) bool {
+	return math.Abs(float64(got-want)) < 0.01
+}
@@ -0,0 +1,40 @@
+package domain
+
+const (
+	// LotTypeBoard trades board lots in the regular session, quantities are
+	// requested in lots.
+	LotTypeBoard = "board"
+	// LotTypeOddLot trades odd lots in the after-hours session, which matches
+	// once after the close.
+	LotTypeOddLot = "odd"
+	// LotTypeIntradayOddLot trades odd lots during the regular session.
+	LotTypeIntradayOddLot = "intraday_odd"
+
+	// BoardLotShares is the number of shares in a board lot.
+	BoardLotShares = 1000
+)
+
+func IsOddLot(lotType string) bool {
+	return lotType == LotTypeOddLot || lotType == LotTypeIntradayOddLot
+}
+
+func validLotType(lotType string) bool {
+	return lotType == "" || lotType == LotTypeBoard || IsOddLot(lotType)
+}
+
+// ShareQuantity converts a requested quantity into shares, board lots are
+// requested in lots and odd lots in shares.
+func ShareQuantity(lotType string, quantity uint64) (uint64, error) {
+	switch lotType {
+	case "", LotTypeBoard:
+		return quantity * BoardLotShares, nil
+	case LotTypeOddLot, LotTypeIntradayOddLot:
+		if quantity >= BoardLotShares {
+			return 0, &DataValidationError{dataType: "odd lot quantity"}
+		}
+
+		return quantity, nil
+	default:
+		return 0, &DataValidationError{dataType: "lot type"}
+	}
+}
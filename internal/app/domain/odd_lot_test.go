@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func TestShareQuantity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		lotType  string
+		quantity uint64
+		want     uint64
+		wantErr  bool
+	}{
+		{name: "legacy board lot", quantity: 2, want: 2000},
+		{name: "board lot", lotType: LotTypeBoard, quantity: 3, want: 3000},
+		{name: "odd lot", lotType: LotTypeOddLot, quantity: 250, want: 250},
+		{name: "intraday odd lot", lotType: LotTypeIntradayOddLot, quantity: 999, want: 999},
+		{name: "odd lot of a board lot", lotType: LotTypeOddLot, quantity: 1000, wantErr: true},
+		{name: "unknown lot type", lotType: "fractional", quantity: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ShareQuantity(tt.lotType, tt.quantity)
+			if tt.wantErr {
+				var validationErr *DataValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("expect validation error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tt.want {
+				t.Errorf("expect %d shares, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOddLotProfitLoss(t *testing.T) {
+	t.Parallel()
+
+	order, err := NewOrder(uuid.Must(uuid.NewV4()), OrderTypeBuy, LotTypeIntradayOddLot, TradeTypeCash, "2330", "20240102", 600, 10, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = order.Change(OrderTypeSell, "2330", "20240103", 620, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	// 6200 received less 1.xx fee and 18.6 tax against 6000 spent and 2.xx fee
+	order.CalculateProfitLoss()
+	if order.ProfitLoss < 170 || order.ProfitLoss > 180 {
+		t.Errorf("unexpected odd lot profit loss %v", order.ProfitLoss)
+	}
+}
+
+func TestAfterHoursOddLotMatch(t *testing.T) {
+	t.Parallel()
+
+	order, err := NewPendingOrder(uuid.Must(uuid.NewV4()), OrderKindLimit, OrderTypeBuy, LotTypeOddLot, TradeTypeCash,
+		"2330", "20240102", 100, 600, 0, uuid.Nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	quote := &Realtime{StockID: "2330", Date: "20240102", Close: 590}
+	if _, _, filled := order.Match(NewPriceBarFromRealtime(quote)); filled {
+		t.Error("expect an after-hours odd lot not to fill during the session")
+	}
+
+	dailyClose := &DailyClose{StockID: "2330", ExchangeDate: "20240102", Open: 605, High: 610, Low: 590, Close: 595}
+	if price, _, filled := order.Match(NewPriceBarFromDailyClose(dailyClose)); !filled || price != 595 {
+		t.Errorf("expect the odd lot to fill at the close of 595 after the session, got %v %v", price, filled)
+	}
+
+	// the low of the session predates the order, only the close is matched
+	dailyClose.Close = 602
+	if _, _, filled := order.Match(NewPriceBarFromDailyClose(dailyClose)); filled {
+		t.Error("expect the odd lot not to fill on the low of the placement date")
+	}
+}
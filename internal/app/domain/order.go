@@ -16,16 +16,35 @@ const (
 	orderChangedState eventsourcing.State = "changed"
 	orderClosedState  eventsourcing.State = "closed"
 
-	taiwanStockQuantity = 1000
-	dayTradeTaxRate     = 0.5
-	taxRate             = 0.003
-	feeRate             = 0.001425
-	brokerFeeDiscount   = 0.25
-	buySellTime         = 2
-	percent             = 100
+	// pending orders wait in the book until the simulator fills them
+	orderPendingState   eventsourcing.State = "pending"
+	orderTriggeredState eventsourcing.State = "triggered"
+	orderFilledState    eventsourcing.State = "filled"
+	orderCancelledState eventsourcing.State = "cancelled"
+
+	buySellTime = 2
+	percent     = 100
+)
+
+const (
+	// OrderKindMarket is booked at once as a position.
+	OrderKindMarket = "market"
+	// OrderKindLimit fills at the limit price or better.
+	OrderKindLimit = "limit"
+	// OrderKindStop fills at the market once the price crosses the stop price.
+	OrderKindStop = "stop"
+	// OrderKindStopLimit turns into a limit order once the price crosses the
+	// stop price.
+	OrderKindStopLimit = "stop_limit"
+	// OrderKindTakeProfit closes an open position at the limit price or better,
+	// it is cancelled once the position is closed otherwise.
+	OrderKindTakeProfit = "take_profit"
 )
 
 type stockState struct {
+	broker        *BrokerProfile
+	stockID       string
+	lotType       string
 	totalSpent    float32
 	totalReceived float32
 	totalFees     float32
@@ -33,23 +52,41 @@ type stockState struct {
 }
 
 type Order struct {
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
-	StockName        string
-	StockID          string
-	BuyExchangeDate  string
-	Status           string
-	SellExchangeDate string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	StockName          string
+	StockID            string
+	BuyExchangeDate    string
+	Status             string
+	SellExchangeDate   string
+	Kind               string
+	Side               string
+	LotType            string
+	TradeType          string
+	PlacedExchangeDate string
+	FilledExchangeDate string
 	eventsourcing.BaseAggregate
 	SellQuantity      uint64
 	BuyQuantity       uint64
+	Quantity          uint64
 	UserID            uuid.UUID
+	PositionID        uuid.UUID
+	LimitPrice        float32
+	StopPrice         float32
+	FilledPrice       float32
+	LotMatches        []*LotMatch
+	Broker            *BrokerProfile
 	ProfitablePrice   float32
 	SellPrice         float32
 	ProfitLoss        float32
 	ProfitLossPercent float32
 	CurrentPrice      float32
 	BuyPrice          float32
+	FinancedAmount    float32
+	Collateral        float32
+	AccruedInterest   float32
+	MaintenanceRatio  float32
+	Dividends         float32
 }
 
 type ListOrdersParams struct {
@@ -76,21 +113,116 @@ func (order *Order) QuantityMatched() bool {
 	return order.BuyQuantity == order.SellQuantity
 }
 
+// IsPosition reports whether the order holds a booked position rather than
+// waiting in the book as a pending order.
+func (order *Order) IsPosition() bool {
+	return order.Kind == "" || order.Kind == OrderKindMarket
+}
+
+// OpeningPrice is the cost basis of the lot, the price of the opening fill.
+func (order *Order) OpeningPrice() float32 {
+	if order.OpeningSide() == OrderTypeSell {
+		return order.SellPrice
+	}
+
+	return order.BuyPrice
+}
+
+// ClosingSide returns the order type which closes the position.
+func (order *Order) ClosingSide() string {
+	if order.OpeningSide() == OrderTypeSell {
+		return OrderTypeBuy
+	}
+
+	return OrderTypeSell
+}
+
+func (order *Order) OpeningExchangeDate() string {
+	if order.OpeningSide() == OrderTypeSell {
+		return order.SellExchangeDate
+	}
+
+	return order.BuyExchangeDate
+}
+
+// ClosingExchangeDate is the date of the latest closing fill.
+func (order *Order) ClosingExchangeDate() string {
+	if order.OpeningSide() == OrderTypeSell {
+		return order.BuyExchangeDate
+	}
+
+	return order.SellExchangeDate
+}
+
+// OpenQuantity is the quantity of the lot left to close.
+func (order *Order) OpenQuantity() uint64 {
+	if order.BuyQuantity > order.SellQuantity {
+		return order.BuyQuantity - order.SellQuantity
+	}
+
+	return order.SellQuantity - order.BuyQuantity
+}
+
+// IsOpen reports whether the position still has quantity left to close.
+func (order *Order) IsOpen() bool {
+	return order.IsPosition() &&
+		(order.Status == string(orderCreatedState) || order.Status == string(orderChangedState)) &&
+		!order.QuantityMatched()
+}
+
+func (order *Order) IsCancelled() bool {
+	return order.Status == string(orderCancelledState)
+}
+
+// OpeningSide returns the order type which opened the position. A position
+// never closes more than it opened, a matched one opened on the earlier date.
+func (order *Order) OpeningSide() string {
+	switch {
+	case order.BuyQuantity > order.SellQuantity:
+		return OrderTypeBuy
+	case order.SellQuantity > order.BuyQuantity:
+		return OrderTypeSell
+	case order.SellExchangeDate < order.BuyExchangeDate:
+		return OrderTypeSell
+	default:
+		return OrderTypeBuy
+	}
+}
+
+func (order *Order) IsPending() bool {
+	return order.Status == string(orderPendingState) || order.Status == string(orderTriggeredState)
+}
+
+func (order *Order) newStockState() *stockState {
+	return &stockState{
+		broker:  order.broker(),
+		stockID: order.StockID,
+		lotType: order.LotType,
+	}
+}
+
+// broker returns the fee schedule the position is priced with, the default
+// one unless the caller attached the profile of the owner.
+func (order *Order) broker() *BrokerProfile {
+	if order.Broker == nil {
+		return DefaultBrokerProfile()
+	}
+
+	return order.Broker
+}
+
 func (s *stockState) Buy(price float32, quantity uint64) {
-	totalCost := price * float32(quantity) * taiwanStockQuantity
-	fee := totalCost * feeRate * brokerFeeDiscount
+	totalCost := price * float32(quantity)
+	fee := s.broker.NetFee(totalCost, s.lotType)
 
 	s.totalSpent += totalCost + fee
 	s.totalFees += fee
 }
 
 func (s *stockState) Sell(price float32, quantity uint64, dayTrade bool) {
-	totalRevenue := price * float32(quantity) * taiwanStockQuantity
-	fee := totalRevenue * feeRate * brokerFeeDiscount
-	tax := totalRevenue * taxRate
-	if dayTrade {
-		tax *= dayTradeTaxRate
-	}
+	totalRevenue := price * float32(quantity)
+	fee := s.broker.NetFee(totalRevenue, s.lotType)
+	tax := s.broker.Tax(s.stockID, totalRevenue, s.lotType, dayTrade)
 
 	s.totalReceived += totalRevenue - fee - tax
 	s.totalFees += fee
@@ -106,11 +238,17 @@ func (s *stockState) ProfitLossPercent() float32 {
 }
 
 func (order *Order) CalculateProfitLoss() {
-	if !order.QuantityMatched() {
+	if !order.IsPosition() || order.IsCancelled() || !order.QuantityMatched() {
+		return
+	}
+
+	if order.lotsMatched() {
+		order.calculateLotProfitLoss()
+
 		return
 	}
 
-	stock := &stockState{}
+	stock := order.newStockState()
 	stock.Buy(order.BuyPrice, order.BuyQuantity)
 	dayTrade := false
 	if order.BuyExchangeDate == order.SellExchangeDate {
@@ -122,13 +260,76 @@ func (order *Order) CalculateProfitLoss() {
 	order.ProfitLossPercent = stock.ProfitLossPercent()
 }
 
+// lotsMatched reports whether the closing fills of the position were all
+// recorded in the lot ledger, positions booked before it lack them.
+func (order *Order) lotsMatched() bool {
+	if len(order.LotMatches) == 0 {
+		return false
+	}
+
+	var quantity uint64
+	for _, match := range order.LotMatches {
+		quantity += match.Quantity
+	}
+
+	if order.OpeningSide() == OrderTypeSell {
+		return quantity == order.BuyQuantity
+	}
+
+	return quantity == order.SellQuantity
+}
+
+// calculateLotProfitLoss realizes every closing fill against the cost basis
+// its lot method assigned.
+func (order *Order) calculateLotProfitLoss() {
+	stock := order.realize(order.LotMatches)
+
+	order.ProfitLoss = stock.ProfitLoss()
+	order.ProfitLossPercent = stock.ProfitLossPercent()
+}
+
+// RealizedProfitLoss returns the profit or loss the closing fills of the lot
+// ledger realized, by the exchange date of the fills.
+func (order *Order) RealizedProfitLoss() map[string]float32 {
+	fills := make(map[string][]*LotMatch)
+	for _, match := range order.LotMatches {
+		fills[match.ExchangeDate] = append(fills[match.ExchangeDate], match)
+	}
+
+	realized := make(map[string]float32, len(fills))
+	for exchangeDate, matches := range fills {
+		realized[exchangeDate] = order.realize(matches).ProfitLoss()
+	}
+
+	return realized
+}
+
+// realize trades the closing fills against their cost basis.
+func (order *Order) realize(matches []*LotMatch) *stockState {
+	stock := order.newStockState()
+	long := order.OpeningSide() == OrderTypeBuy
+	openingDate := order.OpeningExchangeDate()
+	for _, match := range matches {
+		dayTrade := match.ExchangeDate == openingDate
+		if long {
+			stock.Buy(match.CostPrice, match.Quantity)
+			stock.Sell(match.Price, match.Quantity, dayTrade)
+		} else {
+			stock.Sell(match.CostPrice, match.Quantity, dayTrade)
+			stock.Buy(match.Price, match.Quantity)
+		}
+	}
+
+	return stock
+}
+
 //nolint:nestif // ignore nested if
 func (order *Order) CalculateUnrealizedProfitLoss(currentPrice float32) {
-	if order.QuantityMatched() {
+	if order.IsCancelled() || order.QuantityMatched() {
 		return
 	}
 
-	stock := &stockState{}
+	stock := order.newStockState()
 	if order.BuyQuantity > order.SellQuantity {
 		remainingQuantity := order.BuyQuantity - order.SellQuantity
 		stock.Buy(order.BuyPrice, order.BuyQuantity)
@@ -169,25 +370,48 @@ func (order *Order) Apply(event eventsourcing.Event) error {
 	case *OrderCreated:
 		order.UserID = event.GetParentID()
 		order.StockID = event.StockID
-		feeAmount := event.TradePrice * float32(event.Quantity) * taiwanStockQuantity * feeRate * brokerFeeDiscount * buySellTime
-		taxAmount := event.TradePrice * float32(event.Quantity) * taiwanStockQuantity * taxRate
-		originalAmount := event.TradePrice * float32(event.Quantity) * taiwanStockQuantity
+		order.LotType = event.LotType
+		order.TradeType = tradeTypeOrCash(event.TradeType)
 
 		if event.OrderType == OrderTypeBuy {
 			order.BuyPrice = event.TradePrice
 			order.BuyQuantity = event.Quantity
 			order.BuyExchangeDate = event.ExchangeDate
-			order.ProfitablePrice = ((originalAmount + feeAmount + taxAmount) / float32(event.Quantity)) / taiwanStockQuantity
 		} else {
 			order.SellPrice = event.TradePrice
 			order.SellQuantity = event.Quantity
 			order.SellExchangeDate = event.ExchangeDate
-			order.ProfitablePrice = ((originalAmount - feeAmount - taxAmount) / float32(event.Quantity)) / taiwanStockQuantity
 		}
+		order.ProfitablePrice = order.profitablePrice(event.OrderType, event.TradePrice, event.Quantity)
 
+		order.Kind = OrderKindMarket
 		order.CreatedAt = event.CreatedAt
 		order.UpdatedAt = event.CreatedAt
 		order.SetAggregateID(event.AggregateID)
+	case *OrderPlaced:
+		order.UserID = event.GetParentID()
+		order.StockID = event.StockID
+		order.Kind = event.Kind
+		order.Side = event.OrderType
+		order.LotType = event.LotType
+		order.TradeType = tradeTypeOrCash(event.TradeType)
+		order.PlacedExchangeDate = event.ExchangeDate
+		order.Quantity = event.Quantity
+		order.LimitPrice = event.LimitPrice
+		order.StopPrice = event.StopPrice
+		order.PositionID = event.PositionID
+		order.CreatedAt = event.CreatedAt
+		order.UpdatedAt = event.CreatedAt
+		order.SetAggregateID(event.AggregateID)
+	case *OrderTriggered:
+		order.UpdatedAt = event.CreatedAt
+	case *OrderFilled:
+		order.FilledPrice = event.TradePrice
+		order.FilledExchangeDate = event.ExchangeDate
+		order.Quantity = event.Quantity
+		order.UpdatedAt = event.CreatedAt
+	case *OrderCancelled:
+		order.UpdatedAt = event.CreatedAt
 	case *OrderChanged:
 		if event.OrderType == OrderTypeBuy {
 			order.BuyPrice = event.TradePrice
@@ -201,6 +425,36 @@ func (order *Order) Apply(event eventsourcing.Event) error {
 		order.UpdatedAt = event.CreatedAt
 	case *OrderClosed:
 		order.UpdatedAt = event.CreatedAt
+	case *OrderAmended:
+		opening := order.OpeningSide()
+		price, exchangeDate := event.TradePrice, event.ExchangeDate
+		if event.Quantity == 0 {
+			price, exchangeDate = 0, ""
+		}
+
+		if event.OrderType == OrderTypeBuy {
+			order.BuyPrice = price
+			order.BuyQuantity = event.Quantity
+			order.BuyExchangeDate = exchangeDate
+		} else {
+			order.SellPrice = price
+			order.SellQuantity = event.Quantity
+			order.SellExchangeDate = exchangeDate
+		}
+
+		if event.OrderType == opening {
+			order.ProfitablePrice = order.profitablePrice(opening, event.TradePrice, event.Quantity)
+		}
+		order.UpdatedAt = event.CreatedAt
+	case *OrderDistributed:
+		if event.Quantity > 0 {
+			total := order.BuyQuantity + event.Quantity
+			order.BuyPrice = (order.BuyPrice*float32(order.BuyQuantity) + event.Cost) / float32(total)
+			order.BuyQuantity = total
+			order.ProfitablePrice = order.profitablePrice(OrderTypeBuy, order.BuyPrice, order.BuyQuantity)
+		}
+		order.Dividends += event.CashAmount
+		order.UpdatedAt = event.CreatedAt
 	default:
 		return &UnsupportedEventError{event: event}
 	}
@@ -210,6 +464,21 @@ func (order *Order) Apply(event eventsourcing.Event) error {
 	return nil
 }
 
+// profitablePrice is the price the position opened by the trade breaks even
+// at, after the fees of both trades and the tax.
+func (order *Order) profitablePrice(orderType string, tradePrice float32, quantity uint64) float32 {
+	broker := order.broker()
+	originalAmount := tradePrice * float32(quantity)
+	feeAmount := broker.NetFee(originalAmount, order.LotType) * buySellTime
+	taxAmount := broker.Tax(order.StockID, originalAmount, order.LotType, false)
+
+	if orderType == OrderTypeBuy {
+		return (originalAmount + feeAmount + taxAmount) / float32(quantity)
+	}
+
+	return (originalAmount - feeAmount - taxAmount) / float32(quantity)
+}
+
 // GetStates returns all possible state transitions
 func (order *Order) GetTransitions() []eventsourcing.Transition {
 	return []eventsourcing.Transition{
@@ -228,31 +497,115 @@ func (order *Order) GetTransitions() []eventsourcing.Transition {
 			Event:     &OrderChanged{},
 			ToState:   orderChangedState,
 		},
-
 		{
 			FromState: orderChangedState,
 			Event:     &OrderClosed{},
 			ToState:   orderClosedState,
 		},
+		{
+			FromState: orderCreatedState,
+			Event:     &OrderAmended{},
+			ToState:   orderChangedState,
+		},
+		{
+			FromState: orderChangedState,
+			Event:     &OrderAmended{},
+			ToState:   orderChangedState,
+		},
+		{
+			FromState: orderClosedState,
+			Event:     &OrderAmended{},
+			ToState:   orderChangedState,
+		},
+		{
+			FromState: orderCreatedState,
+			Event:     &OrderCancelled{},
+			ToState:   orderCancelledState,
+		},
+		{
+			FromState: orderChangedState,
+			Event:     &OrderCancelled{},
+			ToState:   orderCancelledState,
+		},
+		{
+			FromState: orderClosedState,
+			Event:     &OrderCancelled{},
+			ToState:   orderCancelledState,
+		},
+		{
+			FromState: orderCreatedState,
+			Event:     &OrderDistributed{},
+			ToState:   orderChangedState,
+		},
+		{
+			FromState: orderChangedState,
+			Event:     &OrderDistributed{},
+			ToState:   orderChangedState,
+		},
+		{
+			FromState: orderClosedState,
+			Event:     &OrderDistributed{},
+			ToState:   orderChangedState,
+		},
+		{
+			FromState: orderInitState,
+			Event:     &OrderPlaced{},
+			ToState:   orderPendingState,
+		},
+		{
+			FromState: orderPendingState,
+			Event:     &OrderTriggered{},
+			ToState:   orderTriggeredState,
+		},
+		{
+			FromState: orderPendingState,
+			Event:     &OrderFilled{},
+			ToState:   orderFilledState,
+		},
+		{
+			FromState: orderTriggeredState,
+			Event:     &OrderFilled{},
+			ToState:   orderFilledState,
+		},
+		{
+			FromState: orderPendingState,
+			Event:     &OrderCancelled{},
+			ToState:   orderCancelledState,
+		},
+		{
+			FromState: orderTriggeredState,
+			Event:     &OrderCancelled{},
+			ToState:   orderCancelledState,
+		},
 	}
 }
 
 func NewOrder(
 	userID uuid.UUID,
 	orderType string,
+	lotType string,
+	tradeType string,
 	stockID string,
 	exchangeDate string,
 	tradePrice float32,
 	quantity uint64,
+	broker *BrokerProfile,
 ) (*Order, error) {
+	if !validTradeType(tradeType) || !validOpeningTrade(tradeType, orderType) {
+		return nil, &DataValidationError{dataType: "trade type"}
+	}
+
 	id := uuid.Must(uuid.NewV4())
 	order := &Order{
 		BaseAggregate: eventsourcing.BaseAggregate{
 			ID: id,
 		},
+		Broker: broker,
 	}
 	event := &OrderCreated{
 		OrderType:    orderType,
+		LotType:      lotType,
+		TradeType:    tradeType,
 		StockID:      stockID,
 		ExchangeDate: exchangeDate,
 		TradePrice:   tradePrice,
@@ -311,6 +664,50 @@ func (order *Order) Change(
 	return nil
 }
 
+// MatchLot closes part of the position with a fill, costPrice is the basis
+// the lot method assigned to the closed quantity.
+func (order *Order) MatchLot(
+	orderType string,
+	exchangeDate string,
+	price float32,
+	quantity uint64,
+	costPrice float32,
+	method string,
+) error {
+	if quantity == 0 || quantity > order.OpenQuantity() || orderType != order.ClosingSide() {
+		return &DataValidationError{dataType: "lot match quantity"}
+	}
+
+	closedPrice, closedQuantity := order.SellPrice, order.SellQuantity
+	if orderType == OrderTypeBuy {
+		closedPrice, closedQuantity = order.BuyPrice, order.BuyQuantity
+	}
+
+	total := closedQuantity + quantity
+	event := &OrderChanged{
+		OrderType:    orderType,
+		StockID:      order.StockID,
+		ExchangeDate: exchangeDate,
+		LotMethod:    method,
+		Quantity:     total,
+		FillQuantity: quantity,
+		TradePrice:   (closedPrice*float32(closedQuantity) + price*float32(quantity)) / float32(total),
+		FillPrice:    price,
+		CostPrice:    costPrice,
+	}
+
+	if err := order.applyChange(event); err != nil {
+		return err
+	}
+
+	// close order if all open positions are closed
+	if order.QuantityMatched() {
+		return order.Close()
+	}
+
+	return nil
+}
+
 func (order *Order) Close() error {
 	event := &OrderClosed{}
 
@@ -329,3 +726,74 @@ func (order *Order) Close() error {
 
 	return nil
 }
+
+// Amend corrects the price, quantity and date of one side of a position, a
+// zero quantity removes the closing trade. The position is reopened or closed
+// according to the amended quantities.
+func (order *Order) Amend(
+	orderType string,
+	exchangeDate string,
+	tradePrice float32,
+	quantity uint64,
+) error {
+	if !order.IsPosition() {
+		return &DataValidationError{dataType: "order kind"}
+	}
+
+	if orderType != OrderTypeBuy && orderType != OrderTypeSell {
+		return &DataValidationError{dataType: "order type"}
+	}
+
+	opening := order.OpeningSide()
+	if quantity > 0 && (tradePrice <= 0 || exchangeDate == "") {
+		return &DataValidationError{dataType: "order trade"}
+	}
+
+	// a position never closes more than it opened
+	buyQuantity, sellQuantity := order.BuyQuantity, order.SellQuantity
+	if orderType == OrderTypeBuy {
+		buyQuantity = quantity
+	} else {
+		sellQuantity = quantity
+	}
+
+	if (opening == OrderTypeBuy && (buyQuantity == 0 || sellQuantity > buyQuantity)) ||
+		(opening == OrderTypeSell && (sellQuantity == 0 || buyQuantity > sellQuantity)) {
+		return &DataValidationError{dataType: "order quantity"}
+	}
+
+	event := &OrderAmended{
+		OrderType:    orderType,
+		ExchangeDate: exchangeDate,
+		TradePrice:   tradePrice,
+		Quantity:     quantity,
+	}
+
+	if err := order.applyChange(event); err != nil {
+		return err
+	}
+
+	// close order if all open positions are closed
+	if order.QuantityMatched() {
+		return order.Close()
+	}
+
+	return nil
+}
+
+func (order *Order) applyChange(event eventsourcing.Event) error {
+	// fill base event data
+	event.SetAggregateID(order.ID)
+	event.SetParentID(order.UserID)
+	event.SetVersion(order.Version + 1)
+	event.SetCreatedAt(time.Now())
+
+	// apply the event
+	if err := order.Apply(event); err != nil {
+		return err
+	}
+	// record uncommitted events
+	order.AppendChanges(event)
+
+	return nil
+}
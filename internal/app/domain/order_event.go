@@ -1,9 +1,14 @@
 package domain
 
-import "github.com/samwang0723/jarvis/internal/eventsourcing"
+import (
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/eventsourcing"
+)
 
 type OrderCreated struct {
 	OrderType    string
+	LotType      string
+	TradeType    string
 	StockID      string
 	ExchangeDate string
 	Description  string
@@ -19,15 +24,21 @@ func (*OrderCreated) EventType() eventsourcing.EventType {
 	return "order.created"
 }
 
+// OrderChanged carries the weighted price and total quantity of a side, the
+// Fill fields record the closing fill matched against the lot.
 type OrderChanged struct {
 	OrderType    string
 	StockID      string
 	ExchangeDate string
 	Description  string
+	LotMethod    string
 	eventsourcing.BaseEvent
-	Quantity   uint64
-	TradePrice float32
-	ProfitLoss float32
+	Quantity     uint64
+	FillQuantity uint64
+	TradePrice   float32
+	ProfitLoss   float32
+	FillPrice    float32
+	CostPrice    float32
 }
 
 // EventType returns the name of event
@@ -43,3 +54,83 @@ type OrderClosed struct {
 func (*OrderClosed) EventType() eventsourcing.EventType {
 	return "order.closed"
 }
+
+type OrderPlaced struct {
+	Kind         string
+	OrderType    string
+	LotType      string
+	TradeType    string
+	StockID      string
+	ExchangeDate string
+	eventsourcing.BaseEvent
+	Quantity   uint64
+	PositionID uuid.UUID
+	LimitPrice float32
+	StopPrice  float32
+}
+
+// EventType returns the name of event
+func (*OrderPlaced) EventType() eventsourcing.EventType {
+	return "order.placed"
+}
+
+type OrderTriggered struct {
+	ExchangeDate string
+	eventsourcing.BaseEvent
+	TradePrice float32
+}
+
+// EventType returns the name of event
+func (*OrderTriggered) EventType() eventsourcing.EventType {
+	return "order.triggered"
+}
+
+type OrderFilled struct {
+	ExchangeDate string
+	eventsourcing.BaseEvent
+	Quantity   uint64
+	TradePrice float32
+}
+
+// EventType returns the name of event
+func (*OrderFilled) EventType() eventsourcing.EventType {
+	return "order.filled"
+}
+
+type OrderCancelled struct {
+	Reason string
+	eventsourcing.BaseEvent
+}
+
+// EventType returns the name of event
+func (*OrderCancelled) EventType() eventsourcing.EventType {
+	return "order.cancelled"
+}
+
+type OrderAmended struct {
+	OrderType    string
+	ExchangeDate string
+	eventsourcing.BaseEvent
+	Quantity   uint64
+	TradePrice float32
+}
+
+// EventType returns the name of event
+func (*OrderAmended) EventType() eventsourcing.EventType {
+	return "order.amended"
+}
+
+// OrderDistributed books the corporate action entitlement of a long position,
+// the shares are added at the subscription cost.
+type OrderDistributed struct {
+	ExchangeDate string
+	eventsourcing.BaseEvent
+	Quantity   uint64
+	Cost       float32
+	CashAmount float32
+}
+
+// EventType returns the name of event
+func (*OrderDistributed) EventType() eventsourcing.EventType {
+	return "order.distributed"
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func TestOrderAmend(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		orderType  string
+		price      float32
+		quantity   uint64
+		wantStatus string
+		wantErr    bool
+	}{
+		{name: "correct opening price", orderType: OrderTypeBuy, price: 95, quantity: 2000, wantStatus: "changed"},
+		{name: "close the position", orderType: OrderTypeSell, price: 110, quantity: 2000, wantStatus: "closed"},
+		{name: "close more than opened", orderType: OrderTypeSell, price: 110, quantity: 3000, wantErr: true},
+		{name: "remove the opening trade", orderType: OrderTypeBuy, quantity: 0, wantErr: true},
+		{name: "missing price", orderType: OrderTypeBuy, quantity: 2000, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			order, err := NewOrder(uuid.Must(uuid.NewV4()), OrderTypeBuy, LotTypeBoard, TradeTypeCash, "2330", "20240102", 100, 2000, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = order.Amend(tt.orderType, "20240103", tt.price, tt.quantity)
+			if tt.wantErr {
+				var validationErr *DataValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("expect validation error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if order.Status != tt.wantStatus {
+				t.Errorf("expect status %s, got %s", tt.wantStatus, order.Status)
+			}
+		})
+	}
+}
+
+func TestOrderAmendReopensClosedPosition(t *testing.T) {
+	t.Parallel()
+
+	order, err := NewOrder(uuid.Must(uuid.NewV4()), OrderTypeBuy, LotTypeBoard, TradeTypeCash, "2330", "20240102", 100, 2000, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = order.Change(OrderTypeSell, "2330", "20240103", 110, 2000); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = order.Amend(OrderTypeSell, "20240103", 110, 1000); err != nil {
+		t.Fatal(err)
+	}
+
+	if order.Status != "changed" || order.SellQuantity != 1000 || order.OpeningSide() != OrderTypeBuy {
+		t.Errorf("unexpected reopened order %+v", order)
+	}
+
+	if err = order.Amend(OrderTypeBuy, "20240102", 90, 1000); err != nil {
+		t.Fatal(err)
+	}
+
+	if order.Status != "closed" || order.ProfitablePrice >= 100 {
+		t.Errorf("unexpected amended order %+v", order)
+	}
+}
+
+func TestOrderCancel(t *testing.T) {
+	t.Parallel()
+
+	order, err := NewOrder(uuid.Must(uuid.NewV4()), OrderTypeSell, LotTypeBoard, TradeTypeCash, "2330", "20240102", 100, 2000, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = order.Cancel("mistyped"); err != nil {
+		t.Fatal(err)
+	}
+
+	order.CalculateUnrealizedProfitLoss(90)
+	if !order.IsCancelled() || order.IsOpen() || order.ProfitLoss != 0 {
+		t.Errorf("unexpected cancelled order %+v", order)
+	}
+
+	if err = order.Amend(OrderTypeSell, "20240102", 100, 1000); err == nil {
+		t.Error("expect a cancelled order not to be amended")
+	}
+}
+
+func TestTransactionReverse(t *testing.T) {
+	t.Parallel()
+
+	tran, err := NewTransaction(uuid.Must(uuid.NewV4()), OrderTypeBuy, 0, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = tran.Reverse(); err == nil {
+		t.Error("expect an incomplete transaction not to be reversed")
+	}
+
+	if err = tran.Complete(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = tran.Reverse(); err != nil {
+		t.Fatal(err)
+	}
+
+	if tran.Status != "reversed" || tran.Version != 3 {
+		t.Errorf("unexpected reversed transaction %+v", tran)
+	}
+}
@@ -0,0 +1,249 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/eventsourcing"
+)
+
+// PriceBar is the price range a pending order is matched against. A realtime
+// quote only contributes its last trade since its session high and low may
+// predate the order.
+type PriceBar struct {
+	StockID      string
+	ExchangeDate string
+	Open         float32
+	High         float32
+	Low          float32
+	Close        float32
+	Intraday     bool
+}
+
+func NewPriceBarFromRealtime(quote *Realtime) *PriceBar {
+	return &PriceBar{
+		StockID:      quote.StockID,
+		ExchangeDate: quote.Date,
+		Open:         quote.Close,
+		High:         quote.Close,
+		Low:          quote.Close,
+		Close:        quote.Close,
+		Intraday:     true,
+	}
+}
+
+func NewPriceBarFromDailyClose(dailyClose *DailyClose) *PriceBar {
+	return &PriceBar{
+		StockID:      dailyClose.StockID,
+		ExchangeDate: dailyClose.ExchangeDate,
+		Open:         dailyClose.Open,
+		High:         dailyClose.High,
+		Low:          dailyClose.Low,
+		Close:        dailyClose.Close,
+	}
+}
+
+// NewPendingOrder places an order waiting for the simulator, orderType is the
+// side the order trades once filled.
+//
+//nolint:nolintlint, cyclop
+func NewPendingOrder(
+	userID uuid.UUID,
+	kind string,
+	orderType string,
+	lotType string,
+	tradeType string,
+	stockID string,
+	exchangeDate string,
+	quantity uint64,
+	limitPrice float32,
+	stopPrice float32,
+	positionID uuid.UUID,
+) (*Order, error) {
+	if orderType != OrderTypeBuy && orderType != OrderTypeSell {
+		return nil, &DataValidationError{dataType: "order type"}
+	}
+
+	if stockID == "" || quantity == 0 {
+		return nil, &DataValidationError{dataType: "order quantity"}
+	}
+
+	if !validLotType(lotType) {
+		return nil, &DataValidationError{dataType: "lot type"}
+	}
+
+	if !validTradeType(tradeType) {
+		return nil, &DataValidationError{dataType: "trade type"}
+	}
+
+	switch kind {
+	case OrderKindLimit:
+		if limitPrice <= 0 {
+			return nil, &DataValidationError{dataType: "order limit price"}
+		}
+	case OrderKindStop:
+		if stopPrice <= 0 {
+			return nil, &DataValidationError{dataType: "order stop price"}
+		}
+	case OrderKindStopLimit:
+		if limitPrice <= 0 || stopPrice <= 0 {
+			return nil, &DataValidationError{dataType: "order stop limit price"}
+		}
+	case OrderKindTakeProfit:
+		if limitPrice <= 0 {
+			return nil, &DataValidationError{dataType: "order limit price"}
+		}
+
+		if positionID == uuid.Nil {
+			return nil, &DataValidationError{dataType: "order position"}
+		}
+	default:
+		return nil, &DataValidationError{dataType: "order kind"}
+	}
+
+	id := uuid.Must(uuid.NewV4())
+	order := &Order{
+		BaseAggregate: eventsourcing.BaseAggregate{
+			ID: id,
+		},
+	}
+	event := &OrderPlaced{
+		Kind:         kind,
+		OrderType:    orderType,
+		LotType:      lotType,
+		TradeType:    tradeType,
+		StockID:      stockID,
+		ExchangeDate: exchangeDate,
+		Quantity:     quantity,
+		PositionID:   positionID,
+		LimitPrice:   limitPrice,
+		StopPrice:    stopPrice,
+	}
+
+	// fill base event data
+	event.SetAggregateID(id)
+	event.SetParentID(userID)
+	event.SetVersion(1)
+	event.SetCreatedAt(time.Now())
+
+	// apply the event
+	if err := order.Apply(event); err != nil {
+		return nil, err
+	}
+	// record uncommitted events
+	order.AppendChanges(event)
+
+	return order, nil
+}
+
+// Match reports whether the bar triggers the stop of a stop-limit order and
+// whether it fills the order, along with the price of either. Gaps through
+// the order price execute at the open.
+func (order *Order) Match(bar *PriceBar) (price float32, triggered, filled bool) {
+	if !order.IsPending() || bar.StockID != order.StockID || bar.ExchangeDate < order.PlacedExchangeDate {
+		return 0, false, false
+	}
+
+	// after-hours odd lots only match once the session closed
+	if order.LotType == LotTypeOddLot && bar.Intraday {
+		return 0, false, false
+	}
+
+	// the daily bar of the placement date spans the moves before the order, it
+	// is only matched against the quotes received after it. The after-hours
+	// session of the odd lots trades after the bar, at its close.
+	if !bar.Intraday && bar.ExchangeDate == order.PlacedExchangeDate {
+		if order.LotType != LotTypeOddLot {
+			return 0, false, false
+		}
+
+		bar = &PriceBar{
+			StockID:      bar.StockID,
+			ExchangeDate: bar.ExchangeDate,
+			Open:         bar.Close,
+			High:         bar.Close,
+			Low:          bar.Close,
+			Close:        bar.Close,
+		}
+	}
+
+	switch order.Kind {
+	case OrderKindLimit, OrderKindTakeProfit:
+		price, filled = matchLimit(order.Side, order.LimitPrice, bar)
+	case OrderKindStop:
+		price, filled = matchStop(order.Side, order.StopPrice, bar)
+	case OrderKindStopLimit:
+		if order.Status == string(orderTriggeredState) {
+			price, filled = matchLimit(order.Side, order.LimitPrice, bar)
+
+			return price, false, filled
+		}
+
+		price, triggered = matchStop(order.Side, order.StopPrice, bar)
+		if !triggered {
+			return 0, false, false
+		}
+
+		// the resulting limit order fills at once if the trigger price honours it
+		filled = (order.Side == OrderTypeBuy && price <= order.LimitPrice) ||
+			(order.Side == OrderTypeSell && price >= order.LimitPrice)
+	}
+
+	return price, triggered, filled
+}
+
+func matchLimit(side string, limit float32, bar *PriceBar) (float32, bool) {
+	if side == OrderTypeBuy && bar.Low <= limit {
+		return min(bar.Open, limit), true
+	}
+
+	if side == OrderTypeSell && bar.High >= limit {
+		return max(bar.Open, limit), true
+	}
+
+	return 0, false
+}
+
+func matchStop(side string, stop float32, bar *PriceBar) (float32, bool) {
+	if side == OrderTypeBuy && bar.High >= stop {
+		return max(bar.Open, stop), true
+	}
+
+	if side == OrderTypeSell && bar.Low <= stop {
+		return min(bar.Open, stop), true
+	}
+
+	return 0, false
+}
+
+// Trigger turns a stop-limit order into a limit order.
+func (order *Order) Trigger(exchangeDate string, price float32) error {
+	event := &OrderTriggered{
+		ExchangeDate: exchangeDate,
+		TradePrice:   price,
+	}
+
+	return order.applyChange(event)
+}
+
+// Fill closes a pending order, the filled quantity is booked as a position by
+// the caller.
+func (order *Order) Fill(exchangeDate string, price float32, quantity uint64) error {
+	event := &OrderFilled{
+		ExchangeDate: exchangeDate,
+		Quantity:     quantity,
+		TradePrice:   price,
+	}
+
+	return order.applyChange(event)
+}
+
+// Cancel withdraws a pending order or voids a booked position, the caller
+// reverses the transactions of the latter.
+func (order *Order) Cancel(reason string) error {
+	event := &OrderCancelled{
+		Reason: reason,
+	}
+
+	return order.applyChange(event)
+}
@@ -0,0 +1,230 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func TestNewPendingOrder(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.Must(uuid.NewV4())
+	positionID := uuid.Must(uuid.NewV4())
+
+	tests := []struct {
+		name       string
+		kind       string
+		orderType  string
+		limitPrice float32
+		stopPrice  float32
+		positionID uuid.UUID
+		wantErr    bool
+	}{
+		{name: "limit", kind: OrderKindLimit, orderType: OrderTypeBuy, limitPrice: 100},
+		{name: "limit without price", kind: OrderKindLimit, orderType: OrderTypeBuy, wantErr: true},
+		{name: "stop", kind: OrderKindStop, orderType: OrderTypeSell, stopPrice: 90},
+		{name: "stop limit without limit", kind: OrderKindStopLimit, orderType: OrderTypeSell, stopPrice: 90, wantErr: true},
+		{
+			name:       "take profit",
+			kind:       OrderKindTakeProfit,
+			orderType:  OrderTypeSell,
+			limitPrice: 120,
+			positionID: positionID,
+		},
+		{name: "take profit without position", kind: OrderKindTakeProfit, orderType: OrderTypeSell, limitPrice: 120, wantErr: true},
+		{name: "market", kind: OrderKindMarket, orderType: OrderTypeBuy, limitPrice: 100, wantErr: true},
+		{name: "unknown side", kind: OrderKindLimit, orderType: "Hold", limitPrice: 100, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			order, err := NewPendingOrder(userID, tt.kind, tt.orderType, LotTypeBoard, TradeTypeCash, "2330", "20240102", 1,
+				tt.limitPrice, tt.stopPrice, tt.positionID)
+			if tt.wantErr {
+				var validationErr *DataValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("expect validation error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if order.Status != string(orderPendingState) || order.UserID != userID || order.IsPosition() {
+				t.Errorf("unexpected pending order %+v", order)
+			}
+		})
+	}
+}
+
+func TestOrderMatch(t *testing.T) {
+	t.Parallel()
+
+	bar := &PriceBar{StockID: "2330", ExchangeDate: "20240102", Open: 100, High: 105, Low: 95, Close: 102}
+
+	tests := []struct {
+		name          string
+		order         *Order
+		bar           *PriceBar
+		wantPrice     float32
+		wantTriggered bool
+		wantFilled    bool
+	}{
+		{
+			name:       "limit buy within range",
+			order:      &Order{Kind: OrderKindLimit, Side: OrderTypeBuy, LimitPrice: 96},
+			bar:        bar,
+			wantPrice:  96,
+			wantFilled: true,
+		},
+		{
+			name:       "limit buy below gap open",
+			order:      &Order{Kind: OrderKindLimit, Side: OrderTypeBuy, LimitPrice: 101},
+			bar:        bar,
+			wantPrice:  100,
+			wantFilled: true,
+		},
+		{
+			name:  "limit sell out of range",
+			order: &Order{Kind: OrderKindLimit, Side: OrderTypeSell, LimitPrice: 106},
+			bar:   bar,
+		},
+		{
+			name:       "stop loss sell",
+			order:      &Order{Kind: OrderKindStop, Side: OrderTypeSell, StopPrice: 97},
+			bar:        bar,
+			wantPrice:  97,
+			wantFilled: true,
+		},
+		{
+			name:       "stop buy above gap open",
+			order:      &Order{Kind: OrderKindStop, Side: OrderTypeBuy, StopPrice: 99},
+			bar:        bar,
+			wantPrice:  100,
+			wantFilled: true,
+		},
+		{
+			name:          "stop limit triggered and filled",
+			order:         &Order{Kind: OrderKindStopLimit, Side: OrderTypeBuy, StopPrice: 104, LimitPrice: 104.5},
+			bar:           bar,
+			wantPrice:     104,
+			wantTriggered: true,
+			wantFilled:    true,
+		},
+		{
+			name:          "stop limit triggered only",
+			order:         &Order{Kind: OrderKindStopLimit, Side: OrderTypeSell, StopPrice: 96, LimitPrice: 98},
+			bar:           bar,
+			wantPrice:     96,
+			wantTriggered: true,
+		},
+		{
+			name: "triggered stop limit fills as limit",
+			order: &Order{
+				Kind:       OrderKindStopLimit,
+				Side:       OrderTypeSell,
+				Status:     string(orderTriggeredState),
+				StopPrice:  96,
+				LimitPrice: 98,
+			},
+			bar:        bar,
+			wantPrice:  100,
+			wantFilled: true,
+		},
+		{
+			name:       "take profit on realtime quote",
+			order:      &Order{Kind: OrderKindTakeProfit, Side: OrderTypeSell, LimitPrice: 101},
+			bar:        NewPriceBarFromRealtime(&Realtime{StockID: "2330", Date: "20240102", High: 110, Close: 101.5}),
+			wantPrice:  101.5,
+			wantFilled: true,
+		},
+		{
+			name:  "bar before placement",
+			order: &Order{Kind: OrderKindLimit, Side: OrderTypeBuy, LimitPrice: 96, PlacedExchangeDate: "20240103"},
+			bar:   bar,
+		},
+		{
+			name:  "daily bar of the placement date",
+			order: &Order{Kind: OrderKindLimit, Side: OrderTypeBuy, LimitPrice: 96, PlacedExchangeDate: "20240102"},
+			bar:   bar,
+		},
+		{
+			name:  "stop on the daily bar of the placement date",
+			order: &Order{Kind: OrderKindStop, Side: OrderTypeSell, StopPrice: 97, PlacedExchangeDate: "20240102"},
+			bar:   bar,
+		},
+		{
+			name:       "realtime quote of the placement date",
+			order:      &Order{Kind: OrderKindLimit, Side: OrderTypeBuy, LimitPrice: 96, PlacedExchangeDate: "20240102"},
+			bar:        NewPriceBarFromRealtime(&Realtime{StockID: "2330", Date: "20240102", Low: 90, Close: 95.5}),
+			wantPrice:  95.5,
+			wantFilled: true,
+		},
+		{
+			name:       "daily bar after the placement date",
+			order:      &Order{Kind: OrderKindLimit, Side: OrderTypeBuy, LimitPrice: 96, PlacedExchangeDate: "20240101"},
+			bar:        bar,
+			wantPrice:  96,
+			wantFilled: true,
+		},
+		{
+			name:  "cancelled order",
+			order: &Order{Kind: OrderKindLimit, Side: OrderTypeBuy, LimitPrice: 96, Status: string(orderCancelledState)},
+			bar:   bar,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.order.StockID = "2330"
+			if tt.order.Status == "" {
+				tt.order.Status = string(orderPendingState)
+			}
+
+			price, triggered, filled := tt.order.Match(tt.bar)
+			if price != tt.wantPrice || triggered != tt.wantTriggered || filled != tt.wantFilled {
+				t.Errorf("expect (%v, %v, %v), got (%v, %v, %v)",
+					tt.wantPrice, tt.wantTriggered, tt.wantFilled, price, triggered, filled)
+			}
+		})
+	}
+}
+
+func TestPendingOrderLifecycle(t *testing.T) {
+	t.Parallel()
+
+	order, err := NewPendingOrder(uuid.Must(uuid.NewV4()), OrderKindStopLimit, OrderTypeBuy, LotTypeBoard, TradeTypeCash,
+		"2330", "20240102", 2, 105, 104, uuid.Nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = order.Trigger("20240102", 104); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = order.Fill("20240103", 104.5, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	if order.Status != string(orderFilledState) || order.FilledPrice != 104.5 || order.Version != 3 {
+		t.Errorf("unexpected filled order %+v", order)
+	}
+
+	if err = order.Cancel("too late"); err == nil {
+		t.Error("expect a filled order not to be cancelled")
+	}
+}
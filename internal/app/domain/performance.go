@@ -0,0 +1,217 @@
+package domain
+
+import (
+	"math"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const (
+	// BenchmarkQuoteKey is the MIS channel of the TAIEX, the benchmark the
+	// account performance is compared against.
+	BenchmarkQuoteKey = "tse_t00.tw"
+	// RiskFreeRate is the annual rate the Sharpe ratio measures the excess
+	// return over, close to the one year time deposit rate.
+	RiskFreeRate = 0.016
+
+	tradingDaysPerYear = 252
+	exchangeMonthLen   = 6
+)
+
+// EquitySnapshot records the account at the close of an exchange date.
+// NetDeposits is the cumulative cash deposited less withdrawn, the difference
+// between two snapshots is the external flow of the day.
+type EquitySnapshot struct {
+	ExchangeDate   string
+	UserID         uuid.UUID
+	Cash           float32
+	MarketValue    float32
+	Equity         float32
+	NetDeposits    float32
+	BenchmarkClose float32
+}
+
+// NewEquitySnapshot takes the snapshot of the portfolio on the exchange date.
+func NewEquitySnapshot(
+	exchangeDate string,
+	portfolio *Portfolio,
+	netDeposits, benchmarkClose float32,
+) *EquitySnapshot {
+	return &EquitySnapshot{
+		ExchangeDate:   exchangeDate,
+		UserID:         portfolio.UserID,
+		Cash:           portfolio.Cash + portfolio.Pending,
+		MarketValue:    portfolio.MarketValue,
+		Equity:         portfolio.Equity,
+		NetDeposits:    netDeposits,
+		BenchmarkClose: benchmarkClose,
+	}
+}
+
+type MonthlyReturn struct {
+	Month  string
+	Return float32
+}
+
+// Performance of the account over its snapshots, returns and the drawdown
+// are expressed in percent.
+type Performance struct {
+	StartDate          string
+	EndDate            string
+	MonthlyReturns     []*MonthlyReturn
+	Snapshots          []*EquitySnapshot
+	TimeWeightedReturn float32
+	BenchmarkReturn    float32
+	ExcessReturn       float32
+	SharpeRatio        float32
+	MaxDrawdown        float32
+}
+
+type dailyReturn struct {
+	date  string
+	value float64
+}
+
+// NewPerformance derives the performance from the snapshots ordered by date.
+// Daily returns leave out the deposits and withdrawals of the day so the time
+// weighted return only measures the investment decisions.
+func NewPerformance(snapshots []*EquitySnapshot) *Performance {
+	performance := &Performance{
+		Snapshots:      snapshots,
+		MonthlyReturns: []*MonthlyReturn{},
+	}
+	if len(snapshots) == 0 {
+		return performance
+	}
+
+	performance.StartDate = snapshots[0].ExchangeDate
+	performance.EndDate = snapshots[len(snapshots)-1].ExchangeDate
+
+	returns := dailyReturns(snapshots)
+	performance.TimeWeightedReturn = float32(compound(returns) * percent)
+	performance.BenchmarkReturn = benchmarkReturn(snapshots)
+	performance.ExcessReturn = performance.TimeWeightedReturn - performance.BenchmarkReturn
+	performance.SharpeRatio = sharpeRatio(returns)
+	performance.MaxDrawdown = maxDrawdown(returns)
+	performance.MonthlyReturns = monthlyReturns(returns)
+
+	return performance
+}
+
+func dailyReturns(snapshots []*EquitySnapshot) []*dailyReturn {
+	returns := make([]*dailyReturn, 0, len(snapshots))
+	for idx := 1; idx < len(snapshots); idx++ {
+		prev, curr := snapshots[idx-1], snapshots[idx]
+		if prev.Equity <= 0 {
+			continue
+		}
+
+		flow := float64(curr.NetDeposits - prev.NetDeposits)
+		returns = append(returns, &dailyReturn{
+			date:  curr.ExchangeDate,
+			value: (float64(curr.Equity)-flow)/float64(prev.Equity) - 1,
+		})
+	}
+
+	return returns
+}
+
+func compound(returns []*dailyReturn) float64 {
+	growth := 1.0
+	for _, r := range returns {
+		growth *= 1 + r.value
+	}
+
+	return growth - 1
+}
+
+// benchmarkReturn compares the first and the last benchmark close recorded,
+// snapshots taken without the index quote are skipped.
+func benchmarkReturn(snapshots []*EquitySnapshot) float32 {
+	var first, last float32
+	for _, snapshot := range snapshots {
+		if snapshot.BenchmarkClose <= 0 {
+			continue
+		}
+
+		if first == 0 {
+			first = snapshot.BenchmarkClose
+		}
+		last = snapshot.BenchmarkClose
+	}
+
+	if first == 0 {
+		return 0
+	}
+
+	return (last/first - 1) * percent
+}
+
+// sharpeRatio annualizes the mean excess daily return over its standard
+// deviation.
+func sharpeRatio(returns []*dailyReturn) float32 {
+	if len(returns) < 2 {
+		return 0
+	}
+
+	dailyRiskFree := RiskFreeRate / tradingDaysPerYear
+	var sum float64
+	for _, r := range returns {
+		sum += r.value - dailyRiskFree
+	}
+	mean := sum / float64(len(returns))
+
+	var variance float64
+	for _, r := range returns {
+		diff := r.value - dailyRiskFree - mean
+		variance += diff * diff
+	}
+	stddev := math.Sqrt(variance / float64(len(returns)-1))
+	if stddev == 0 {
+		return 0
+	}
+
+	return float32(mean / stddev * math.Sqrt(tradingDaysPerYear))
+}
+
+// maxDrawdown follows the growth of the returns rather than the equity so
+// withdrawals are not taken for losses.
+func maxDrawdown(returns []*dailyReturn) float32 {
+	growth, peak, drawdown := 1.0, 1.0, 0.0
+	for _, r := range returns {
+		growth *= 1 + r.value
+		peak = math.Max(peak, growth)
+		drawdown = math.Max(drawdown, (peak-growth)/peak)
+	}
+
+	return float32(drawdown * percent)
+}
+
+func monthlyReturns(returns []*dailyReturn) []*MonthlyReturn {
+	months := []*MonthlyReturn{}
+	growth := 1.0
+	for idx, r := range returns {
+		growth *= 1 + r.value
+
+		month := exchangeMonth(r.date)
+		if next := idx + 1; next < len(returns) && exchangeMonth(returns[next].date) == month {
+			continue
+		}
+
+		months = append(months, &MonthlyReturn{
+			Month:  month,
+			Return: float32((growth - 1) * percent),
+		})
+		growth = 1.0
+	}
+
+	return months
+}
+
+func exchangeMonth(date string) string {
+	if len(date) < exchangeMonthLen {
+		return date
+	}
+
+	return date[:exchangeMonthLen]
+}
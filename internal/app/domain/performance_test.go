@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestNewPerformance(t *testing.T) {
+	t.Parallel()
+
+	snapshots := []*EquitySnapshot{
+		{ExchangeDate: "20240130", Equity: 100000, NetDeposits: 100000, BenchmarkClose: 18000},
+		{ExchangeDate: "20240131", Equity: 110000, NetDeposits: 100000, BenchmarkClose: 18900},
+		// a deposit of 50000 is not a return
+		{ExchangeDate: "20240201", Equity: 160000, NetDeposits: 150000},
+		{ExchangeDate: "20240202", Equity: 144000, NetDeposits: 150000},
+		{ExchangeDate: "20240205", Equity: 151200, NetDeposits: 150000, BenchmarkClose: 19800},
+	}
+
+	performance := NewPerformance(snapshots)
+	if performance.StartDate != "20240130" || performance.EndDate != "20240205" {
+		t.Errorf("expect range 20240130-20240205, got %s-%s", performance.StartDate, performance.EndDate)
+	}
+
+	tests := []struct {
+		name string
+		got  float32
+		want float32
+	}{
+		{name: "time weighted return", got: performance.TimeWeightedReturn, want: 3.95},
+		{name: "benchmark return", got: performance.BenchmarkReturn, want: 10},
+		{name: "excess return", got: performance.ExcessReturn, want: -6.05},
+		{name: "sharpe ratio", got: performance.SharpeRatio, want: 2.31},
+		{name: "max drawdown", got: performance.MaxDrawdown, want: 10},
+	}
+
+	for _, tt := range tests {
+		if !almostEqual(tt.got, tt.want) {
+			t.Errorf("%s: expect %v, got %v", tt.name, tt.want, tt.got)
+		}
+	}
+
+	want := []*MonthlyReturn{{Month: "202401", Return: 10}, {Month: "202402", Return: -5.5}}
+	if len(performance.MonthlyReturns) != len(want) {
+		t.Fatalf("expect %d monthly returns, got %d", len(want), len(performance.MonthlyReturns))
+	}
+
+	for idx, month := range performance.MonthlyReturns {
+		if month.Month != want[idx].Month || !almostEqual(month.Return, want[idx].Return) {
+			t.Errorf("expect %s %v, got %s %v", want[idx].Month, want[idx].Return, month.Month, month.Return)
+		}
+	}
+}
+
+func TestNewPerformanceWithoutReturns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		snapshots []*EquitySnapshot
+	}{
+		{name: "no snapshot"},
+		{name: "single snapshot", snapshots: []*EquitySnapshot{{ExchangeDate: "20240102", Equity: 100000}}},
+		{
+			name: "empty account",
+			snapshots: []*EquitySnapshot{
+				{ExchangeDate: "20240102"},
+				{ExchangeDate: "20240103"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			performance := NewPerformance(tt.snapshots)
+			if performance.TimeWeightedReturn != 0 || performance.SharpeRatio != 0 ||
+				performance.MaxDrawdown != 0 || len(performance.MonthlyReturns) != 0 {
+				t.Errorf("expect no returns, got %+v", performance)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/helper"
+)
+
+// Position consolidates the open positions of a stock on one side, long
+// positions open with a buy and short positions with a sell.
+type Position struct {
+	StockID                     string
+	StockName                   string
+	Side                        string
+	Quantity                    uint64
+	AverageCost                 float32
+	CurrentPrice                float32
+	CostBasis                   float32
+	MarketValue                 float32
+	FinancedAmount              float32
+	Collateral                  float32
+	UnrealizedProfitLoss        float32
+	UnrealizedProfitLossPercent float32
+	Weight                      float32
+}
+
+// Portfolio is what the user holds, the positions along with the cash of the
+// balance. Equity is the cash plus the net value of every position.
+type Portfolio struct {
+	Positions            []*Position
+	UserID               uuid.UUID
+	Cash                 float32
+	Pending              float32
+	MarketValue          float32
+	UnrealizedProfitLoss float32
+	Equity               float32
+}
+
+// NewPortfolio aggregates the open positions per stock and side, weighted in
+// percent of the market value. The current price of the orders has to be
+// filled first, the opening price is used for the stocks without one.
+func NewPortfolio(userID uuid.UUID, orders []*Order, balance *BalanceView) *Portfolio {
+	portfolio := &Portfolio{
+		UserID:    userID,
+		Positions: []*Position{},
+	}
+	if balance != nil {
+		portfolio.Cash = balance.Available
+		portfolio.Pending = balance.Pending
+	}
+
+	positions := make(map[string]*Position)
+	for _, order := range orders {
+		if !order.IsOpen() {
+			continue
+		}
+
+		side := order.OpeningSide()
+		key := order.StockID + ":" + side
+		position, ok := positions[key]
+		if !ok {
+			position = &Position{
+				StockID:   order.StockID,
+				StockName: order.StockName,
+				Side:      side,
+			}
+			positions[key] = position
+			portfolio.Positions = append(portfolio.Positions, position)
+		}
+
+		position.add(order)
+	}
+
+	equity := portfolio.Cash + portfolio.Pending
+	for _, position := range portfolio.Positions {
+		position.value()
+		portfolio.MarketValue += position.MarketValue
+		portfolio.UnrealizedProfitLoss += position.UnrealizedProfitLoss
+		equity += position.NetValue()
+	}
+	portfolio.Equity = equity
+
+	if portfolio.MarketValue > 0 {
+		for _, position := range portfolio.Positions {
+			position.Weight = position.MarketValue / portfolio.MarketValue * percent
+		}
+	}
+
+	return portfolio
+}
+
+func (p *Position) add(order *Order) {
+	quantity := order.OpenQuantity()
+	price := order.CurrentPrice
+	if price <= 0 {
+		price = order.OpeningPrice()
+	}
+
+	p.Quantity += quantity
+	p.CurrentPrice = price
+	p.CostBasis += order.OpeningPrice() * float32(quantity)
+	p.MarketValue += price * float32(quantity)
+
+	switch order.TradeType {
+	case TradeTypeMargin:
+		p.FinancedAmount += MarginLoan(order.BuyPrice, quantity)
+	case TradeTypeShort:
+		p.FinancedAmount += order.SellPrice * float32(quantity)
+		p.Collateral += ShortCollateral(order.SellPrice, quantity)
+	}
+}
+
+// value fills the average cost and the unrealized profit loss before fees and
+// taxes, a short position gains when the price falls.
+func (p *Position) value() {
+	if p.Quantity == 0 {
+		return
+	}
+
+	p.AverageCost = p.CostBasis / float32(p.Quantity)
+	p.UnrealizedProfitLoss = p.MarketValue - p.CostBasis
+	if p.Side == OrderTypeSell {
+		p.UnrealizedProfitLoss = -p.UnrealizedProfitLoss
+	}
+
+	if p.CostBasis > 0 {
+		p.UnrealizedProfitLossPercent = helper.RoundDecimalTwo(p.UnrealizedProfitLoss / p.CostBasis * percent)
+	}
+}
+
+// NetValue is what the position adds to the equity. The margin loan is owed
+// to the broker, a short position gets back its collateral once the borrowed
+// shares are bought back at the market value, cash shorts owe the shares
+// only.
+func (p *Position) NetValue() float32 {
+	if p.Side == OrderTypeSell {
+		return p.Collateral - p.MarketValue
+	}
+
+	return p.MarketValue - p.FinancedAmount
+}
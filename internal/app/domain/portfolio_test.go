@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func TestNewPortfolio(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.Must(uuid.NewV4())
+	newPosition := func(orderType, tradeType, stockID string, price, currentPrice float32) *Order {
+		order, err := NewOrder(userID, orderType, LotTypeBoard, tradeType, stockID, "20240102", price, 1000, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		order.CurrentPrice = currentPrice
+
+		return order
+	}
+
+	orders := []*Order{
+		newPosition(OrderTypeBuy, TradeTypeCash, "2330", 100, 130),
+		newPosition(OrderTypeBuy, TradeTypeCash, "2330", 120, 130),
+		newPosition(OrderTypeBuy, TradeTypeMargin, "2317", 50, 40),
+		newPosition(OrderTypeSell, TradeTypeShort, "2454", 200, 180),
+		// without a current price the position is valued at cost
+		newPosition(OrderTypeBuy, TradeTypeCash, "0050", 150, 0),
+	}
+
+	portfolio := NewPortfolio(userID, orders, &BalanceView{Available: 100000, Pending: -20000})
+	if len(portfolio.Positions) != 4 {
+		t.Fatalf("expect 4 positions, got %d", len(portfolio.Positions))
+	}
+
+	tests := []struct {
+		stockID     string
+		side        string
+		quantity    uint64
+		averageCost float32
+		marketValue float32
+		profitLoss  float32
+		percent     float32
+		netValue    float32
+	}{
+		{"2330", OrderTypeBuy, 2000, 110, 260000, 40000, 18.18, 260000},
+		{"2317", OrderTypeBuy, 1000, 50, 40000, -10000, -20, 10000},
+		{"2454", OrderTypeSell, 1000, 200, 180000, 20000, 10, 200000},
+		{"0050", OrderTypeBuy, 1000, 150, 150000, 0, 0, 150000},
+	}
+
+	for idx, tt := range tests {
+		position := portfolio.Positions[idx]
+		if position.StockID != tt.stockID || position.Side != tt.side || position.Quantity != tt.quantity {
+			t.Errorf("expect %s %s %d, got %s %s %d", tt.stockID, tt.side, tt.quantity,
+				position.StockID, position.Side, position.Quantity)
+		}
+
+		if !almostEqual(position.AverageCost, tt.averageCost) || !almostEqual(position.MarketValue, tt.marketValue) ||
+			!almostEqual(position.UnrealizedProfitLoss, tt.profitLoss) ||
+			!almostEqual(position.UnrealizedProfitLossPercent, tt.percent) {
+			t.Errorf("%s: expect cost %v value %v profit loss %v (%v%%), got %v %v %v (%v%%)", tt.stockID,
+				tt.averageCost, tt.marketValue, tt.profitLoss, tt.percent, position.AverageCost,
+				position.MarketValue, position.UnrealizedProfitLoss, position.UnrealizedProfitLossPercent)
+		}
+
+		if !almostEqual(position.NetValue(), tt.netValue) {
+			t.Errorf("%s: expect net value %v, got %v", tt.stockID, tt.netValue, position.NetValue())
+		}
+
+		if !almostEqual(position.Weight, tt.marketValue/6300) {
+			t.Errorf("%s: expect weight %v, got %v", tt.stockID, tt.marketValue/6300, position.Weight)
+		}
+	}
+
+	if !almostEqual(portfolio.MarketValue, 630000) || !almostEqual(portfolio.UnrealizedProfitLoss, 50000) {
+		t.Errorf("expect market value 630000 profit loss 50000, got %v %v",
+			portfolio.MarketValue, portfolio.UnrealizedProfitLoss)
+	}
+
+	// cash 80000 along with the net value of the positions
+	if !almostEqual(portfolio.Equity, 700000) {
+		t.Errorf("expect equity 700000, got %v", portfolio.Equity)
+	}
+}
+
+func TestNewPortfolioSkipsClosedPositions(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.Must(uuid.NewV4())
+	order, err := NewOrder(userID, OrderTypeBuy, LotTypeBoard, TradeTypeCash, "2330", "20240102", 100, 1000, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = order.MatchLot(OrderTypeSell, "20240103", 110, 1000, 100, LotMethodFIFO); err != nil {
+		t.Fatal(err)
+	}
+
+	portfolio := NewPortfolio(userID, []*Order{order}, nil)
+	if len(portfolio.Positions) != 0 || portfolio.Equity != 0 {
+		t.Errorf("expect an empty portfolio, got %d positions equity %v", len(portfolio.Positions), portfolio.Equity)
+	}
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"bytes"
 	"errors"
 
 	jsoniter "github.com/json-iterator/go"
@@ -10,17 +11,19 @@ import (
 //nolint:nolintlint,gochecknoglobals
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
 
-// https://mis.twse.com.tw/stock/api/getStockInfo.jsp?ex_ch=tse_2330.tw
+// Realtime is the latest quote of a stock in the session, Volume is the
+// cumulative traded lots.
 type Realtime struct {
-	StockID   string  `json:"stockID"`
-	Name      string  `json:"name"`
-	Date      string  `json:"date"`
-	ParseTime string  `json:"parseTime"`
-	Open      float32 `json:"open"`
-	Close     float32 `json:"close"`
-	High      float32 `json:"high"`
-	Low       float32 `json:"low"`
-	Volume    uint64  `json:"volume"`
+	StockID       string  `json:"stockID"`
+	Name          string  `json:"name"`
+	Date          string  `json:"date"`
+	ParseTime     string  `json:"parseTime"`
+	Open          float32 `json:"open"`
+	Close         float32 `json:"close"`
+	High          float32 `json:"high"`
+	Low           float32 `json:"low"`
+	PreviousClose float32 `json:"previousClose"`
+	Volume        uint64  `json:"volume"`
 }
 
 type RealtimeList struct {
@@ -41,6 +44,7 @@ type rawBody struct {
 	Low     string `json:"l"`
 	Open    string `json:"o"`
 	Close   string `json:"z"`
+	Prev    string `json:"y"`
 	Volume  string `json:"v"`
 	Time    string `json:"t"`
 	Name    string `json:"n"`
@@ -48,16 +52,22 @@ type rawBody struct {
 
 var errEmptyData = errors.New("cannot unmarshal empty data")
 
-func (r *Realtime) UnmarshalJSON(data []byte) error {
+// ParseMisRealtime parses the msgArray payload of the exchange MIS endpoint
+// shared by TWSE and TPEx, e.g.
+// https://mis.twse.com.tw/stock/api/getStockInfo.jsp?ex_ch=tse_2330.tw
+// Prices are zero when the stock has not traded yet ("-").
+func ParseMisRealtime(data []byte) (*Realtime, error) {
 	var raw rawData
 	if err := json.Unmarshal(data, &raw); err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(raw.MessageAry) == 0 {
-		return errEmptyData
+		return nil, errEmptyData
 	}
 
+	r := &Realtime{}
+
 	r.StockID = raw.MessageAry[0].StockID
 	r.Date = raw.MessageAry[0].Date
 	//nolint:nolintlint,errcheck
@@ -70,9 +80,32 @@ func (r *Realtime) UnmarshalJSON(data []byte) error {
 	r.Low, _ = helper.StringToFloat32(raw.MessageAry[0].Low)
 	//nolint:nolintlint,errcheck
 	r.Volume, _ = helper.StringToUint64(raw.MessageAry[0].Volume)
+	//nolint:nolintlint,errcheck
+	r.PreviousClose, _ = helper.StringToFloat32(raw.MessageAry[0].Prev)
 	r.ParseTime = raw.MessageAry[0].Time
+	r.Name = raw.MessageAry[0].Name
+
+	return r, nil
+}
+
+// UnmarshalJSON reads a marshalled Realtime, or the MIS payload the quotes
+// were cached and published as before.
+func (r *Realtime) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`{"msgArray"`)) {
+		quote, err := ParseMisRealtime(data)
+		if err != nil {
+			return err
+		}
+
+		*r = *quote
+
+		return nil
+	}
+
+	// the alias drops the method, unmarshalling the fields as they are
+	type realtime Realtime
 
-	return nil
+	return json.Unmarshal(data, (*realtime)(r))
 }
 
 func Merge(objs, picked []*RealtimeList) []*RealtimeList {
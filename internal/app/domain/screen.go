@@ -0,0 +1,11 @@
+package domain
+
+import "github.com/gofrs/uuid/v5"
+
+type Screen struct {
+	Time
+	Name       string
+	Expression string
+	ID
+	UserID uuid.UUID
+}
@@ -8,6 +8,20 @@ import (
 	"github.com/samwang0723/jarvis/internal/helper"
 )
 
+// SelectionCandidates narrows the stocks of an exchange date down to the
+// candidates a screening strategy is evaluated on, zero disables a threshold.
+type SelectionCandidates struct {
+	// MinConcentrationDays is the number of concentration periods, out of
+	// 1, 5, 10, 20 and 60 days, with net buying.
+	MinConcentrationDays int32
+	MinTradeShares       int64
+	// MinAvgVolume is the average traded shares of the 5 days before.
+	MinAvgVolume int64
+	// MinCloseToHigh is the ratio of the live quote to the high of the
+	// session a realtime candidate has to trade above.
+	MinCloseToHigh float32
+}
+
 type Selection struct {
 	StockID         string
 	Name            string
@@ -31,6 +45,7 @@ type Selection struct {
 	Trust10         int
 	Foreign10       int
 	QuoteChange     float32
+	Analysis        *Analysis
 }
 
 func ConvertSelectionList(sel any) []*Selection {
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const (
+	// LotMethodFIFO closes the lots opened first.
+	LotMethodFIFO = "fifo"
+	// LotMethodLIFO closes the lots opened last.
+	LotMethodLIFO = "lifo"
+	// LotMethodAverage closes the lots opened first at the average cost of
+	// every open lot.
+	LotMethodAverage = "average"
+	// LotMethodSpecific closes the chosen lots in the given order.
+	LotMethodSpecific = "specific"
+	// LotMethodAmended marks the match rebuilt from an amended position.
+	LotMethodAmended = "amended"
+)
+
+// LotMatch records a closing fill against a tax lot, the opening fill of a
+// position. OrderType is the side of the closing fill.
+type LotMatch struct {
+	CreatedAt    time.Time
+	StockID      string
+	OrderType    string
+	Method       string
+	ExchangeDate string
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	OrderID      uuid.UUID
+	Quantity     uint64
+	Price        float32
+	CostPrice    float32
+}
+
+// ArrangeLots returns the open positions in the order the method closes them,
+// specific only keeps the chosen lots.
+func ArrangeLots(method string, lots []*Order, lotIDs []uuid.UUID) ([]*Order, error) {
+	arranged := slices.Clone(lots)
+	slices.SortStableFunc(arranged, func(a, b *Order) int {
+		if c := strings.Compare(a.OpeningExchangeDate(), b.OpeningExchangeDate()); c != 0 {
+			return c
+		}
+
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	switch method {
+	case "", LotMethodFIFO, LotMethodAverage:
+	case LotMethodLIFO:
+		slices.Reverse(arranged)
+	case LotMethodSpecific:
+		if len(lotIDs) == 0 {
+			return nil, &DataValidationError{dataType: "lot selection"}
+		}
+
+		chosen := make([]*Order, 0, len(lotIDs))
+		for _, id := range lotIDs {
+			idx := slices.IndexFunc(arranged, func(lot *Order) bool {
+				return lot.ID == id
+			})
+			if idx < 0 {
+				return nil, &DataValidationError{dataType: "lot selection"}
+			}
+
+			chosen = append(chosen, arranged[idx])
+		}
+
+		return chosen, nil
+	default:
+		return nil, &DataValidationError{dataType: "lot method"}
+	}
+
+	return arranged, nil
+}
+
+// AverageCost is the opening price of the open lots weighted by the quantity
+// they have left.
+func AverageCost(lots []*Order) float32 {
+	var cost float32
+	var quantity uint64
+	for _, lot := range lots {
+		cost += lot.OpeningPrice() * float32(lot.OpenQuantity())
+		quantity += lot.OpenQuantity()
+	}
+
+	if quantity == 0 {
+		return 0
+	}
+
+	return cost / float32(quantity)
+}
@@ -0,0 +1,187 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func newTestLot(t *testing.T, exchangeDate string, price float32, quantity uint64) *Order {
+	t.Helper()
+
+	lot, err := NewOrder(uuid.Must(uuid.NewV4()), OrderTypeBuy, LotTypeBoard, TradeTypeCash, "2330", exchangeDate, price, quantity, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return lot
+}
+
+func TestArrangeLots(t *testing.T) {
+	t.Parallel()
+
+	first := newTestLot(t, "20240102", 100, 1)
+	second := newTestLot(t, "20240103", 110, 2)
+	third := newTestLot(t, "20240104", 120, 3)
+	lots := []*Order{second, third, first}
+
+	tests := []struct {
+		name    string
+		method  string
+		lotIDs  []uuid.UUID
+		want    []*Order
+		wantErr bool
+	}{
+		{name: "default", want: []*Order{first, second, third}},
+		{name: "fifo", method: LotMethodFIFO, want: []*Order{first, second, third}},
+		{name: "lifo", method: LotMethodLIFO, want: []*Order{third, second, first}},
+		{name: "average", method: LotMethodAverage, want: []*Order{first, second, third}},
+		{
+			name:   "specific",
+			method: LotMethodSpecific,
+			lotIDs: []uuid.UUID{third.ID, first.ID},
+			want:   []*Order{third, first},
+		},
+		{name: "specific without lots", method: LotMethodSpecific, wantErr: true},
+		{
+			name:    "specific unknown lot",
+			method:  LotMethodSpecific,
+			lotIDs:  []uuid.UUID{uuid.Must(uuid.NewV4())},
+			wantErr: true,
+		},
+		{name: "unknown method", method: "hifo", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ArrangeLots(tt.method, lots, tt.lotIDs)
+			if tt.wantErr {
+				var validationErr *DataValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("expect validation error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("expect %d lots, got %d", len(tt.want), len(got))
+			}
+
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("expect lot %d to open on %s, got %s",
+						i, tt.want[i].OpeningExchangeDate(), got[i].OpeningExchangeDate())
+				}
+			}
+		})
+	}
+}
+
+func TestAverageCost(t *testing.T) {
+	t.Parallel()
+
+	lots := []*Order{
+		newTestLot(t, "20240102", 100, 1),
+		newTestLot(t, "20240103", 130, 2),
+	}
+
+	if cost := AverageCost(lots); cost != 120 {
+		t.Errorf("expect average cost 120, got %v", cost)
+	}
+
+	if cost := AverageCost(nil); cost != 0 {
+		t.Errorf("expect no average cost, got %v", cost)
+	}
+}
+
+func TestOrderMatchLot(t *testing.T) {
+	t.Parallel()
+
+	lot := newTestLot(t, "20240102", 100, 3)
+
+	if err := lot.MatchLot(OrderTypeBuy, "20240103", 110, 1, 100, LotMethodFIFO); err == nil {
+		t.Error("expect the opening side not to match the lot")
+	}
+
+	if err := lot.MatchLot(OrderTypeSell, "20240103", 110, 4, 100, LotMethodFIFO); err == nil {
+		t.Error("expect the match not to exceed the open quantity")
+	}
+
+	if err := lot.MatchLot(OrderTypeSell, "20240103", 110, 1, 100, LotMethodFIFO); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := lot.MatchLot(OrderTypeSell, "20240104", 140, 2, 100, LotMethodFIFO); err != nil {
+		t.Fatal(err)
+	}
+
+	if lot.Status != "closed" || lot.SellQuantity != 3 || lot.SellPrice != 130 {
+		t.Errorf("unexpected matched lot %+v", lot)
+	}
+}
+
+func TestOrderCalculateLotProfitLoss(t *testing.T) {
+	t.Parallel()
+
+	lot := newTestLot(t, "20240102", 100, 2)
+	if err := lot.MatchLot(OrderTypeSell, "20240103", 110, 2, 100, LotMethodFIFO); err != nil {
+		t.Fatal(err)
+	}
+
+	lot.CalculateProfitLoss()
+	want := lot.ProfitLoss
+
+	// an average cost basis realizes the gain against the cost of every lot
+	lot.LotMatches = []*LotMatch{
+		{OrderType: OrderTypeSell, ExchangeDate: "20240103", Quantity: 2, Price: 110, CostPrice: 105},
+	}
+	lot.CalculateProfitLoss()
+
+	if lot.ProfitLoss >= want {
+		t.Errorf("expect lot profit loss below %v, got %v", want, lot.ProfitLoss)
+	}
+
+	// an incomplete ledger falls back to the position prices
+	lot.LotMatches[0].Quantity = 1
+	lot.CalculateProfitLoss()
+
+	if lot.ProfitLoss != want {
+		t.Errorf("expect profit loss %v, got %v", want, lot.ProfitLoss)
+	}
+}
+
+func TestOrderRealizedProfitLoss(t *testing.T) {
+	t.Parallel()
+
+	lot := newTestLot(t, "20240102", 100, 3000)
+	lot.LotMatches = []*LotMatch{
+		{OrderType: OrderTypeSell, ExchangeDate: "20240103", Quantity: 1000, Price: 110, CostPrice: 100},
+		{OrderType: OrderTypeSell, ExchangeDate: "20240104", Quantity: 1000, Price: 90, CostPrice: 100},
+		{OrderType: OrderTypeSell, ExchangeDate: "20240104", Quantity: 1000, Price: 120, CostPrice: 100},
+	}
+
+	realized := lot.RealizedProfitLoss()
+	if len(realized) != 2 {
+		t.Fatalf("expect 2 exchange dates, got %v", realized)
+	}
+
+	if realized["20240103"] <= 0 {
+		t.Errorf("expect a gain on 20240103, got %v", realized["20240103"])
+	}
+
+	// the fills of a day are realized together
+	want := lot.realize(lot.LotMatches[1:]).ProfitLoss()
+	if realized["20240104"] != want {
+		t.Errorf("expect profit loss %v on 20240104, got %v", want, realized["20240104"])
+	}
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+type Timeframe string
+
+const (
+	TimeframeDaily   Timeframe = "daily"
+	TimeframeWeekly  Timeframe = "weekly"
+	TimeframeMonthly Timeframe = "monthly"
+
+	exchangeDateLayout = "20060102"
+)
+
+// TradingDays is the rough number of exchange days covered by one bar, used
+// to size the daily history needed for a number of resampled bars.
+//
+//nolint:nolintlint, gomnd
+func (t Timeframe) TradingDays() int {
+	switch t {
+	case TimeframeWeekly:
+		return 5
+	case TimeframeMonthly:
+		return 22
+	default:
+		return 1
+	}
+}
+
+func ParseTimeframe(s string) (Timeframe, error) {
+	switch tf := Timeframe(strings.ToLower(s)); tf {
+	case "", TimeframeDaily:
+		return TimeframeDaily, nil
+	case TimeframeWeekly, TimeframeMonthly:
+		return tf, nil
+	default:
+		return "", &DataValidationError{dataType: "timeframe"}
+	}
+}
+
+// Resample aggregates daily bars of one or more stocks into weekly or monthly
+// OHLCV bars, returned in ascending exchange date order per stock. Bars are
+// grouped by ISO week or calendar month of the days actually traded, so
+// exchange holidays simply shorten a bucket and the resampled bar carries the
+// date of the last trading day it contains.
+func Resample(bars []*DailyClose, timeframe Timeframe) ([]*DailyClose, error) {
+	sorted := make([]*DailyClose, len(bars))
+	copy(sorted, bars)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].StockID != sorted[j].StockID {
+			return sorted[i].StockID < sorted[j].StockID
+		}
+
+		return sorted[i].ExchangeDate < sorted[j].ExchangeDate
+	})
+
+	if timeframe == TimeframeDaily {
+		return sorted, nil
+	}
+
+	output := []*DailyClose{}
+
+	var current *DailyClose
+	currentKey := ""
+	for _, bar := range sorted {
+		key, err := bucketKey(bar.ExchangeDate, timeframe)
+		if err != nil {
+			return nil, err
+		}
+		key = bar.StockID + "|" + key
+
+		if current == nil || key != currentKey {
+			current = &DailyClose{
+				StockID: bar.StockID,
+				Open:    bar.Open,
+				High:    bar.High,
+				Low:     bar.Low,
+				// keep the previous close so the bucket diff can be derived
+				PriceDiff: bar.Close - bar.PriceDiff,
+			}
+			currentKey = key
+			output = append(output, current)
+		}
+
+		current.ExchangeDate = bar.ExchangeDate
+		current.Close = bar.Close
+		current.High = max(current.High, bar.High)
+		current.Low = min(current.Low, bar.Low)
+		current.TradedShares += bar.TradedShares
+		current.Transactions += bar.Transactions
+		current.Turnover += bar.Turnover
+	}
+
+	for _, bar := range output {
+		bar.PriceDiff = bar.Close - bar.PriceDiff
+	}
+
+	return output, nil
+}
+
+func bucketKey(exchangeDate string, timeframe Timeframe) (string, error) {
+	date, err := time.Parse(exchangeDateLayout, exchangeDate)
+	if err != nil {
+		return "", fmt.Errorf("parse exchange date %q: %w", exchangeDate, err)
+	}
+
+	switch timeframe {
+	case TimeframeWeekly:
+		year, week := date.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week), nil
+	case TimeframeMonthly:
+		return date.Format("200601"), nil
+	default:
+		return exchangeDate, nil
+	}
+}
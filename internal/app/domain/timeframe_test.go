@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func dailyBar(date string, open, high, low, closePrice, diff float32, shares int64) *DailyClose {
+	return &DailyClose{
+		StockID:      "2330",
+		ExchangeDate: date,
+		Open:         open,
+		High:         high,
+		Low:          low,
+		Close:        closePrice,
+		PriceDiff:    diff,
+		TradedShares: shares,
+	}
+}
+
+func TestResample(t *testing.T) {
+	t.Parallel()
+
+	// 2024-02-05 ~ 2024-02-07 trade before the Lunar New Year holiday,
+	// the exchange reopens on 2024-02-15 (Thursday).
+	bars := []*DailyClose{
+		dailyBar("20240216", 628, 630, 620, 625, -3, 200),
+		dailyBar("20240215", 630, 635, 625, 628, 12, 300),
+		dailyBar("20240207", 615, 618, 612, 616, 4, 100),
+		dailyBar("20240206", 610, 614, 605, 612, 2, 150),
+		dailyBar("20240205", 612, 615, 608, 610, -5, 120),
+		dailyBar("20240131", 620, 622, 614, 615, -3, 90),
+	}
+
+	tests := []struct {
+		name      string
+		timeframe Timeframe
+		expect    []*DailyClose
+	}{
+		{
+			name:      "weekly skips holiday week",
+			timeframe: TimeframeWeekly,
+			expect: []*DailyClose{
+				dailyBar("20240131", 620, 622, 614, 615, -3, 90),
+				dailyBar("20240207", 612, 618, 605, 616, 1, 370),
+				dailyBar("20240216", 630, 635, 620, 625, 9, 500),
+			},
+		},
+		{
+			name:      "monthly",
+			timeframe: TimeframeMonthly,
+			expect: []*DailyClose{
+				dailyBar("20240131", 620, 622, 614, 615, -3, 90),
+				dailyBar("20240216", 612, 635, 605, 625, 10, 870),
+			},
+		},
+		{
+			name:      "daily is sorted ascending",
+			timeframe: TimeframeDaily,
+			expect: []*DailyClose{
+				bars[5], bars[4], bars[3], bars[2], bars[1], bars[0],
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Resample(bars, tt.timeframe)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(got) != len(tt.expect) {
+				t.Fatalf("expect %d bars, got %d", len(tt.expect), len(got))
+			}
+
+			for i := range got {
+				if !reflect.DeepEqual(got[i], tt.expect[i]) {
+					t.Errorf("bar %d: expect %+v, got %+v", i, tt.expect[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseTimeframe(t *testing.T) {
+	t.Parallel()
+
+	for in, want := range map[string]Timeframe{
+		"":        TimeframeDaily,
+		"WEEKLY":  TimeframeWeekly,
+		"monthly": TimeframeMonthly,
+	} {
+		got, err := ParseTimeframe(in)
+		if err != nil || got != want {
+			t.Errorf("%q: expect %s, got %s (%v)", in, want, got, err)
+		}
+	}
+
+	var validationErr *DataValidationError
+	if _, err := ParseTimeframe("hourly"); !errors.As(err, &validationErr) {
+		t.Errorf("expect validation error, got %v", err)
+	}
+}
@@ -0,0 +1,206 @@
+package domain
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// Trade stats are grouped by the tags or by the setup of the journal entries.
+const (
+	TradeStatsByTag      = "tag"
+	TradeStatsByStrategy = "strategy"
+
+	// UntaggedSetup groups the trades without a tag or a setup.
+	UntaggedSetup = "untagged"
+
+	maxJournalTags   = 16
+	maxJournalTagLen = 32
+)
+
+// JournalEntry records why a position was taken. The setup is either a
+// selection strategy or a screen of the user.
+type JournalEntry struct {
+	Time
+	Notes    string
+	Strategy string
+	Tags     []string
+	ID
+	UserID   uuid.UUID
+	OrderID  uuid.UUID
+	ScreenID uuid.UUID
+}
+
+// NewJournalEntry normalizes the tags into a sorted lower case set.
+func NewJournalEntry(
+	userID, orderID uuid.UUID,
+	notes string,
+	tags []string,
+	strategy string,
+	screenID uuid.UUID,
+) (*JournalEntry, error) {
+	if strategy != "" && screenID != uuid.Nil {
+		return nil, &DataValidationError{dataType: "journal setup"}
+	}
+
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+
+		if len(tag) > maxJournalTagLen {
+			return nil, &DataValidationError{dataType: "journal tag"}
+		}
+
+		if !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+
+	if len(normalized) > maxJournalTags {
+		return nil, &DataValidationError{dataType: "journal tags"}
+	}
+	sort.Strings(normalized)
+
+	if strings.TrimSpace(notes) == "" && len(normalized) == 0 && strategy == "" && screenID == uuid.Nil {
+		return nil, &DataValidationError{dataType: "journal entry"}
+	}
+
+	return &JournalEntry{
+		UserID:   userID,
+		OrderID:  orderID,
+		Notes:    notes,
+		Tags:     normalized,
+		Strategy: strategy,
+		ScreenID: screenID,
+	}, nil
+}
+
+// TradeStats summarizes the closed positions of a tag or a setup, the win
+// rate is expressed in percent.
+type TradeStats struct {
+	Key                string
+	Trades             int32
+	Wins               int32
+	WinRate            float32
+	TotalProfitLoss    float32
+	AverageProfitLoss  float32
+	AverageHoldingDays float32
+}
+
+// NewTradeStats breaks the closed positions down by the tags or the setup of
+// their journal entries, a position counts toward each of its tags and toward
+// the setup of its latest entry naming one. The profit loss of the positions
+// has to be calculated first. Screens are named by screenNames.
+func NewTradeStats(
+	groupBy string,
+	orders []*Order,
+	entries []*JournalEntry,
+	screenNames map[uuid.UUID]string,
+) ([]*TradeStats, error) {
+	if groupBy != TradeStatsByTag && groupBy != TradeStatsByStrategy {
+		return nil, &DataValidationError{dataType: "trade stats group"}
+	}
+
+	journals := make(map[uuid.UUID][]*JournalEntry)
+	for _, entry := range entries {
+		journals[entry.OrderID] = append(journals[entry.OrderID], entry)
+	}
+
+	stats := []*TradeStats{}
+	index := make(map[string]*TradeStats)
+	for _, order := range orders {
+		if !order.IsPosition() || order.Status != string(orderClosedState) {
+			continue
+		}
+
+		var keys []string
+		if groupBy == TradeStatsByTag {
+			keys = journalTags(journals[order.ID])
+		} else if setup := journalSetup(journals[order.ID], screenNames); setup != "" {
+			keys = []string{setup}
+		}
+
+		if len(keys) == 0 {
+			keys = []string{UntaggedSetup}
+		}
+
+		for _, key := range keys {
+			stat, ok := index[key]
+			if !ok {
+				stat = &TradeStats{Key: key}
+				index[key] = stat
+				stats = append(stats, stat)
+			}
+
+			stat.add(order)
+		}
+	}
+
+	for _, stat := range stats {
+		stat.WinRate = float32(stat.Wins) / float32(stat.Trades) * percent
+		stat.AverageProfitLoss = stat.TotalProfitLoss / float32(stat.Trades)
+		stat.AverageHoldingDays /= float32(stat.Trades)
+	}
+
+	sort.SliceStable(stats, func(i, j int) bool {
+		return stats[i].Key < stats[j].Key
+	})
+
+	return stats, nil
+}
+
+// add counts the position, AverageHoldingDays sums the days until the stats
+// are averaged.
+func (s *TradeStats) add(order *Order) {
+	s.Trades++
+	if order.ProfitLoss > 0 {
+		s.Wins++
+	}
+	s.TotalProfitLoss += order.ProfitLoss
+	s.AverageHoldingDays += float32(order.HoldingDays())
+}
+
+// HoldingDays counts the calendar days from the opening to the closing fill
+// of the position.
+func (order *Order) HoldingDays() int {
+	return exchangeDays(order.OpeningExchangeDate(), order.ClosingExchangeDate())
+}
+
+func journalTags(entries []*JournalEntry) []string {
+	tags := []string{}
+	for _, entry := range entries {
+		for _, tag := range entry.Tags {
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+	}
+
+	return tags
+}
+
+// journalSetup names the setup of the latest entry naming one, entries are
+// ordered by creation.
+func journalSetup(entries []*JournalEntry, screenNames map[uuid.UUID]string) string {
+	for idx := len(entries) - 1; idx >= 0; idx-- {
+		entry := entries[idx]
+		if entry.Strategy != "" {
+			return entry.Strategy
+		}
+
+		if entry.ScreenID != uuid.Nil {
+			if name, ok := screenNames[entry.ScreenID]; ok {
+				return name
+			}
+
+			return entry.ScreenID.String()
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func TestNewJournalEntry(t *testing.T) {
+	t.Parallel()
+
+	screenID := uuid.Must(uuid.NewV4())
+	tests := []struct {
+		name     string
+		notes    string
+		tags     []string
+		strategy string
+		screenID uuid.UUID
+		want     []string
+		wantErr  bool
+	}{
+		{name: "normalized tags", notes: "earnings gap", tags: []string{" Gap ", "earnings", "gap", ""}, want: []string{"earnings", "gap"}},
+		{name: "strategy only", strategy: "breakout", want: []string{}},
+		{name: "screen only", screenID: screenID, want: []string{}},
+		{name: "strategy and screen", strategy: "breakout", screenID: screenID, wantErr: true},
+		{name: "empty entry", notes: "  ", tags: []string{" "}, wantErr: true},
+		{name: "long tag", tags: []string{strings.Repeat("x", maxJournalTagLen+1)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			entry, err := NewJournalEntry(uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4()), tt.notes, tt.tags,
+				tt.strategy, tt.screenID)
+			if tt.wantErr {
+				var validationErr *DataValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("expect validation error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !slices.Equal(entry.Tags, tt.want) {
+				t.Errorf("expect tags %v, got %v", tt.want, entry.Tags)
+			}
+		})
+	}
+}
+
+func TestNewTradeStats(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.Must(uuid.NewV4())
+	closedPosition := func(buyDate, sellDate string, profitLoss float32) *Order {
+		order, err := NewOrder(userID, OrderTypeBuy, LotTypeBoard, TradeTypeCash, "2330", buyDate, 100, 1000, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = order.MatchLot(OrderTypeSell, sellDate, 110, 1000, 100, LotMethodFIFO); err != nil {
+			t.Fatal(err)
+		}
+		order.ProfitLoss = profitLoss
+
+		return order
+	}
+
+	win := closedPosition("20240102", "20240112", 9000)
+	loss := closedPosition("20240102", "20240104", -3000)
+	untagged := closedPosition("20240102", "20240103", 1000)
+	open, err := NewOrder(userID, OrderTypeBuy, LotTypeBoard, TradeTypeCash, "2330", "20240102", 100, 1000, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	screenID := uuid.Must(uuid.NewV4())
+	entries := []*JournalEntry{
+		{OrderID: win.ID, Tags: []string{"breakout", "earnings"}, Strategy: "breakout"},
+		{OrderID: win.ID, Tags: []string{"earnings"}},
+		{OrderID: loss.ID, Tags: []string{"breakout"}, Strategy: "breakout"},
+		// the latest setup of the position wins
+		{OrderID: loss.ID, ScreenID: screenID},
+		{OrderID: open.ID, Tags: []string{"breakout"}},
+	}
+	orders := []*Order{win, loss, untagged, open}
+	screenNames := map[uuid.UUID]string{screenID: "gap-up"}
+
+	tests := []struct {
+		groupBy string
+		want    []*TradeStats
+	}{
+		{
+			groupBy: TradeStatsByTag,
+			want: []*TradeStats{
+				{Key: "breakout", Trades: 2, Wins: 1, WinRate: 50, TotalProfitLoss: 6000, AverageProfitLoss: 3000, AverageHoldingDays: 6},
+				{Key: "earnings", Trades: 1, Wins: 1, WinRate: 100, TotalProfitLoss: 9000, AverageProfitLoss: 9000, AverageHoldingDays: 10},
+				{Key: UntaggedSetup, Trades: 1, Wins: 1, WinRate: 100, TotalProfitLoss: 1000, AverageProfitLoss: 1000, AverageHoldingDays: 1},
+			},
+		},
+		{
+			groupBy: TradeStatsByStrategy,
+			want: []*TradeStats{
+				{Key: "breakout", Trades: 1, Wins: 1, WinRate: 100, TotalProfitLoss: 9000, AverageProfitLoss: 9000, AverageHoldingDays: 10},
+				{Key: "gap-up", Trades: 1, WinRate: 0, TotalProfitLoss: -3000, AverageProfitLoss: -3000, AverageHoldingDays: 2},
+				{Key: UntaggedSetup, Trades: 1, Wins: 1, WinRate: 100, TotalProfitLoss: 1000, AverageProfitLoss: 1000, AverageHoldingDays: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.groupBy, func(t *testing.T) {
+			t.Parallel()
+
+			stats, err := NewTradeStats(tt.groupBy, orders, entries, screenNames)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(stats) != len(tt.want) {
+				t.Fatalf("expect %d groups, got %d", len(tt.want), len(stats))
+			}
+
+			for idx, stat := range stats {
+				if *stat != *tt.want[idx] {
+					t.Errorf("expect %+v, got %+v", tt.want[idx], stat)
+				}
+			}
+		})
+	}
+
+	if _, err := NewTradeStats("month", orders, entries, nil); err == nil {
+		t.Error("expect error of unknown group")
+	}
+}
@@ -15,6 +15,32 @@ const (
 	OrderTypeTax      = "Tax"
 	OrderTypeDeposit  = "Deposit"
 	OrderTypeWithdraw = "Withdraw"
+	// OrderTypeRebate credits back the fee discount of a monthly rebate broker,
+	// it stays created until the month is settled.
+	OrderTypeRebate = "Rebate"
+	// OrderTypeMarginLoan credits the part of a margin buy the broker finances.
+	OrderTypeMarginLoan = "MarginLoan"
+	// OrderTypeMarginRepay pays the loan of the margin shares sold back.
+	OrderTypeMarginRepay = "MarginRepay"
+	// OrderTypeMarginInterest charges the interest accrued on the repaid loan.
+	OrderTypeMarginInterest = "MarginInterest"
+	// OrderTypeShortCollateral holds the proceeds and the margin deposit of a
+	// short sale with the broker.
+	OrderTypeShortCollateral = "ShortCollateral"
+	// OrderTypeShortRelease returns the collateral of the short shares covered.
+	OrderTypeShortRelease = "ShortRelease"
+	// OrderTypeShortBorrowFee charges the fee of borrowing the short shares.
+	OrderTypeShortBorrowFee = "ShortBorrowFee"
+	// OrderTypeDividend credits the cash dividend of a held position.
+	OrderTypeDividend = "Dividend"
+	// OrderTypeStockDividend books the shares of a stock dividend, crediting
+	// the fractional share paid in cash.
+	OrderTypeStockDividend = "StockDividend"
+	// OrderTypeDividendPremium withholds the health insurance premium of a
+	// large dividend payment.
+	OrderTypeDividendPremium = "DividendPremium"
+	// OrderTypeRightsSubscription pays the shares subscribed in a rights issue.
+	OrderTypeRightsSubscription = "RightsSubscription"
 )
 
 // Define state machine
@@ -23,6 +49,7 @@ const (
 	transactionCreatedState   eventsourcing.State = "created"
 	transactionCompletedState eventsourcing.State = "completed"
 	transactionFailedState    eventsourcing.State = "failed"
+	transactionReversedState  eventsourcing.State = "reversed"
 )
 
 type Transaction struct {
@@ -52,6 +79,17 @@ func (tran *Transaction) GetCurrentState() eventsourcing.State {
 	return eventsourcing.State(tran.Status)
 }
 
+// IsDistribution reports whether the transaction books a corporate action
+// entitlement rather than a trade.
+func (tran *Transaction) IsDistribution() bool {
+	switch tran.OrderType {
+	case OrderTypeDividend, OrderTypeStockDividend, OrderTypeDividendPremium, OrderTypeRightsSubscription:
+		return true
+	}
+
+	return false
+}
+
 // Apply updates the aggregate according to a event.
 func (tran *Transaction) Apply(event eventsourcing.Event) error {
 	newState, err := eventsourcing.TransistOnEvent(tran, event)
@@ -75,6 +113,8 @@ func (tran *Transaction) Apply(event eventsourcing.Event) error {
 		tran.UpdatedAt = event.CreatedAt
 	case *TransactionFailed:
 		tran.UpdatedAt = event.CreatedAt
+	case *TransactionReversed:
+		tran.UpdatedAt = event.CreatedAt
 	default:
 		return &UnsupportedEventError{event: event}
 	}
@@ -102,6 +142,11 @@ func (tran *Transaction) GetTransitions() []eventsourcing.Transition {
 			Event:     &TransactionFailed{},
 			ToState:   transactionFailedState,
 		},
+		{
+			FromState: transactionCompletedState,
+			Event:     &TransactionReversed{},
+			ToState:   transactionReversedState,
+		},
 	}
 }
 
@@ -181,3 +226,23 @@ func (tran *Transaction) Fail() error {
 
 	return nil
 }
+
+// Reverse voids a completed transaction, the caller moves the funds back.
+func (tran *Transaction) Reverse() error {
+	event := &TransactionReversed{}
+
+	// fill base event data
+	event.SetAggregateID(tran.ID)
+	event.SetParentID(tran.UserID)
+	event.SetVersion(tran.Version + 1)
+	event.SetCreatedAt(time.Now())
+
+	// apply the event
+	if err := tran.Apply(event); err != nil {
+		return err
+	}
+	// record uncommitted events
+	tran.AppendChanges(event)
+
+	return nil
+}
@@ -35,3 +35,12 @@ type TransactionFailed struct {
 func (*TransactionFailed) EventType() eventsourcing.EventType {
 	return "transaction.failed"
 }
+
+type TransactionReversed struct {
+	eventsourcing.BaseEvent
+}
+
+// EventType returns the name of event
+func (*TransactionReversed) EventType() eventsourcing.EventType {
+	return "transaction.reversed"
+}
@@ -32,8 +32,10 @@ type ListDailyCloseSearchParams struct {
 
 type ListDailyCloseRequest struct {
 	SearchParams *ListDailyCloseSearchParams `json:"searchParams"`
+	Timeframe    string                      `json:"timeframe"`
 	Offset       int32                       `json:"offset"`
 	Limit        int32                       `json:"limit"`
+	Adjusted     bool                        `json:"adjusted"`
 }
 
 type ListDailyCloseResponse struct {
@@ -92,8 +94,10 @@ type GetStakeConcentrationRequest struct {
 }
 
 type ListSelectionRequest struct {
-	Date   string `json:"date"`
-	Strict bool   `json:"strict"`
+	Date     string `json:"date"`
+	Strategy string `json:"strategy"`
+	Strict   bool   `json:"strict"`
+	Adjusted bool   `json:"adjusted"`
 }
 
 type ListSelectionResponse struct {
@@ -192,15 +196,54 @@ type CreateTransactionResponse struct {
 	Status       int    `json:"status"`
 }
 
+// CreateOrderRequest books a market order at TradePrice, any other Kind
+// places a pending order filled by the simulator, see domain.NewPendingOrder.
 type CreateOrderRequest struct {
+	OrderType    string   `json:"orderType"`
+	StockID      string   `json:"stockID"`
+	ExchangeDate string   `json:"exchangeDate"`
+	Kind         string   `json:"kind"`
+	LotType      string   `json:"lotType"`
+	TradeType    string   `json:"tradeType"`
+	PositionID   string   `json:"positionID"`
+	LotMethod    string   `json:"lotMethod"`
+	LotIDs       []string `json:"lotIDs"`
+	TradePrice   float32  `json:"tradePrice"`
+	LimitPrice   float32  `json:"limitPrice"`
+	StopPrice    float32  `json:"stopPrice"`
+	Quantity     uint64   `json:"quantity"`
+}
+
+type CreateOrderResponse struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+	Success      bool   `json:"success"`
+	Status       int    `json:"status"`
+}
+
+type CancelOrderRequest struct {
+	ID string `json:"id"`
+}
+
+type CancelOrderResponse struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+	Success      bool   `json:"success"`
+	Status       int    `json:"status"`
+}
+
+// AmendOrderRequest corrects one side of a booked position, a zero quantity
+// removes its closing trade.
+type AmendOrderRequest struct {
+	ID           string  `json:"id"`
 	OrderType    string  `json:"orderType"`
-	StockID      string  `json:"stockID"`
+	LotType      string  `json:"lotType"`
 	ExchangeDate string  `json:"exchangeDate"`
 	TradePrice   float32 `json:"tradePrice"`
 	Quantity     uint64  `json:"quantity"`
 }
 
-type CreateOrderResponse struct {
+type AmendOrderResponse struct {
 	ErrorCode    string `json:"errorCode"`
 	ErrorMessage string `json:"errorMessage"`
 	Success      bool   `json:"success"`
@@ -225,3 +268,226 @@ type ListOrderResponse struct {
 	Limit      int32           `json:"limit"`
 	TotalCount int64           `json:"totalCount"`
 }
+
+type CreateScreenRequest struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+}
+
+type CreateScreenResponse struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+	ID           string `json:"id"`
+	Success      bool   `json:"success"`
+	Status       int    `json:"status"`
+}
+
+type ListScreensResponse struct {
+	Entries []*domain.Screen `json:"entries"`
+}
+
+type RunScreenRequest struct {
+	ScreenID string `json:"screenID"`
+	Date     string `json:"date"`
+	Adjusted bool   `json:"adjusted"`
+}
+
+type RunScreenResponse struct {
+	Entries []*domain.Selection `json:"entries"`
+}
+
+// RunBacktestRequest replays a selection strategy between two dates.
+// StopLoss and TakeProfit are percentages of the entry price, zero disables them.
+type RunBacktestRequest struct {
+	StartDate      string  `json:"startDate"`
+	EndDate        string  `json:"endDate"`
+	Strategy       string  `json:"strategy"`
+	Quantity       uint64  `json:"quantity"`
+	StopLoss       float32 `json:"stopLoss"`
+	TakeProfit     float32 `json:"takeProfit"`
+	InitialCapital float32 `json:"initialCapital"`
+	HoldingDays    int32   `json:"holdingDays"`
+	Strict         bool    `json:"strict"`
+	Adjusted       bool    `json:"adjusted"`
+}
+
+type RunBacktestResponse struct {
+	Report *domain.BacktestReport `json:"report"`
+}
+
+// SubscribeQuotesRequest streams the realtime quotes of the stocks, an empty
+// list subscribes to every monitored stock.
+type SubscribeQuotesRequest struct {
+	StockIDs []string `json:"stockIDs"`
+}
+
+// ListIntradayBarsRequest rolls the crawled ticks of a session into bars of
+// 1, 5 or 15 minutes, the date defaults to today.
+type ListIntradayBarsRequest struct {
+	StockID  string `json:"stockID"`
+	Date     string `json:"date"`
+	Interval int32  `json:"interval"`
+}
+
+type ListIntradayBarsResponse struct {
+	Entries []*domain.IntradayBar `json:"entries"`
+}
+
+// CreateAlertRuleRequest defines a price alert, see domain.AlertRule for the
+// conditions and channels.
+type CreateAlertRuleRequest struct {
+	StockID   string  `json:"stockID"`
+	Condition string  `json:"condition"`
+	Channel   string  `json:"channel"`
+	Target    string  `json:"target"`
+	Threshold float32 `json:"threshold"`
+}
+
+type CreateAlertRuleResponse struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+	ID           string `json:"id"`
+	Success      bool   `json:"success"`
+	Status       int    `json:"status"`
+}
+
+type ListAlertRulesResponse struct {
+	Entries []*domain.AlertRule `json:"entries"`
+}
+
+type UpdateAlertRuleRequest struct {
+	ID        string  `json:"id"`
+	StockID   string  `json:"stockID"`
+	Condition string  `json:"condition"`
+	Channel   string  `json:"channel"`
+	Target    string  `json:"target"`
+	Threshold float32 `json:"threshold"`
+}
+
+type UpdateAlertRuleResponse struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+	Success      bool   `json:"success"`
+	Status       int    `json:"status"`
+}
+
+type DeleteAlertRuleRequest struct {
+	ID string `json:"id"`
+}
+
+type DeleteAlertRuleResponse struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+	Success      bool   `json:"success"`
+	Status       int    `json:"status"`
+}
+
+// CreateBrokerProfileRequest defines a fee schedule, rates are fractions of
+// the trade amount and fees are in TWD.
+type CreateBrokerProfileRequest struct {
+	Name            string  `json:"name"`
+	RebateTiming    string  `json:"rebateTiming"`
+	FeeRate         float32 `json:"feeRate"`
+	FeeDiscount     float32 `json:"feeDiscount"`
+	MinFee          float32 `json:"minFee"`
+	OddLotMinFee    float32 `json:"oddLotMinFee"`
+	TaxRate         float32 `json:"taxRate"`
+	DayTradeTaxRate float32 `json:"dayTradeTaxRate"`
+	ETFTaxRate      float32 `json:"etfTaxRate"`
+	BondETFTaxRate  float32 `json:"bondETFTaxRate"`
+}
+
+type CreateBrokerProfileResponse struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+	ID           string `json:"id"`
+	Success      bool   `json:"success"`
+	Status       int    `json:"status"`
+}
+
+type ListBrokerProfilesResponse struct {
+	SelectedID string                  `json:"selectedID"`
+	Entries    []*domain.BrokerProfile `json:"entries"`
+}
+
+type SelectBrokerProfileRequest struct {
+	ID string `json:"id"`
+}
+
+type SelectBrokerProfileResponse struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+	Success      bool   `json:"success"`
+	Status       int    `json:"status"`
+}
+
+type ListEntitlementsResponse struct {
+	Entries []*domain.Entitlement `json:"entries"`
+}
+
+type SubscribeRightsRequest struct {
+	ID string `json:"id"`
+}
+
+type SubscribeRightsResponse struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+	Success      bool   `json:"success"`
+	Status       int    `json:"status"`
+}
+
+type GetPerformanceRequest struct {
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+}
+
+type ImportOrdersRequest struct {
+	Broker  string `json:"broker"`
+	Content []byte `json:"content"`
+	DryRun  bool   `json:"dryRun"`
+}
+
+type ImportOrdersResponse struct {
+	Entries []*domain.TradeConfirmation `json:"entries"`
+	Counts  map[string]int32            `json:"counts"`
+}
+
+type CreateJournalEntryRequest struct {
+	OrderID  string   `json:"orderID"`
+	Notes    string   `json:"notes"`
+	Strategy string   `json:"strategy"`
+	ScreenID string   `json:"screenID"`
+	Tags     []string `json:"tags"`
+}
+
+type CreateJournalEntryResponse struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+	ID           string `json:"id"`
+	Success      bool   `json:"success"`
+	Status       int    `json:"status"`
+}
+
+type ListJournalEntriesRequest struct {
+	OrderID string `json:"orderID"`
+}
+
+type ListJournalEntriesResponse struct {
+	Entries []*domain.JournalEntry `json:"entries"`
+}
+
+// GetTradeStatsRequest groups the positions closed between the dates by the
+// journal tags or setups, an empty date leaves the range open.
+type GetTradeStatsRequest struct {
+	GroupBy   string `json:"groupBy"`
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+}
+
+type GetTradeStatsResponse struct {
+	Entries []*domain.TradeStats `json:"entries"`
+}
+
+type GetAnnualReportRequest struct {
+	Year int32 `json:"year"`
+}
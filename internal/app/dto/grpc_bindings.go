@@ -17,6 +17,7 @@ import (
 	"encoding/json"
 	"math"
 
+	"github.com/gofrs/uuid/v5"
 	"github.com/samwang0723/jarvis/internal/app/domain"
 	pb "github.com/samwang0723/jarvis/internal/app/pb"
 	"github.com/samwang0723/jarvis/internal/helper"
@@ -33,6 +34,8 @@ func ListDailyCloseRequestFromPB(in *pb.ListDailyCloseRequest) *ListDailyCloseRe
 		Offset:       in.Offset,
 		Limit:        in.Limit,
 		SearchParams: listDailyCloseSearchParamsFromPB(in.SearchParams),
+		Timeframe:    in.Timeframe,
+		Adjusted:     in.Adjusted,
 	}
 
 	return out
@@ -428,8 +431,10 @@ func ListSelectionRequestFromPB(in *pb.ListSelectionRequest) *ListSelectionReque
 		return nil
 	}
 	out := &ListSelectionRequest{
-		Date:   in.Date,
-		Strict: in.Strict,
+		Date:     in.Date,
+		Strict:   in.Strict,
+		Strategy: in.Strategy,
+		Adjusted: in.Adjusted,
 	}
 
 	return out
@@ -518,6 +523,40 @@ func SelectionToPB(in *domain.Selection) *pb.Selection {
 		Trust10:          pbTrust10,
 		Foreign10:        pbForeign10,
 		QuoteChange:      pbQuoteChange,
+		Indicators:       IndicatorsToPB(in.Analysis),
+	}
+}
+
+func IndicatorsToPB(in *domain.Analysis) *pb.Indicators {
+	if in == nil {
+		return nil
+	}
+
+	return &pb.Indicators{
+		Ma8:             in.MA8,
+		Ma21:            in.MA21,
+		Ma55:            in.MA55,
+		Mv5:             in.MV5,
+		Mv13:            in.MV13,
+		Mv34:            in.MV34,
+		Ema12:           in.EMA12,
+		Ema26:           in.EMA26,
+		Rsi14:           in.RSI14,
+		Macd:            in.MACD,
+		MacdSignal:      in.MACDSignal,
+		MacdHistogram:   in.MACDHistogram,
+		BollingerUpper:  in.BollingerUpper,
+		BollingerMiddle: in.BollingerMiddle,
+		BollingerLower:  in.BollingerLower,
+		K9:              in.K9,
+		D9:              in.D9,
+		Atr14:           in.ATR14,
+		Obv:             in.OBV,
+		WeeklyMA5:       in.WeeklyMA5,
+		WeeklyMA10:      in.WeeklyMA10,
+		WeeklyMA20:      in.WeeklyMA20,
+		MonthlyMA3:      in.MonthlyMA3,
+		MonthlyMA5:      in.MonthlyMA5,
 	}
 }
 
@@ -721,6 +760,364 @@ func GetBalanceResponseToPB(in *domain.BalanceView) *pb.GetBalanceResponse {
 	}
 }
 
+func PositionToPB(in *domain.Position) *pb.Position {
+	if in == nil {
+		return nil
+	}
+
+	pbStockID := in.StockID
+	pbStockName := in.StockName
+	pbSide := in.Side
+	pbQuantity := in.Quantity
+	pbAverageCost := helper.RoundDecimalTwo(in.AverageCost)
+	pbCurrentPrice := in.CurrentPrice
+	pbMarketValue := helper.RoundDecimal(in.MarketValue)
+	pbUnrealizedProfitLoss := helper.RoundDecimal(in.UnrealizedProfitLoss)
+	pbUnrealizedProfitLossPercent := in.UnrealizedProfitLossPercent
+	pbWeight := helper.RoundDecimalTwo(in.Weight)
+	pbFinancedAmount := helper.RoundDecimal(in.FinancedAmount)
+	pbCollateral := helper.RoundDecimal(in.Collateral)
+
+	return &pb.Position{
+		StockID:                     pbStockID,
+		StockName:                   pbStockName,
+		Side:                        pbSide,
+		Quantity:                    pbQuantity,
+		AverageCost:                 pbAverageCost,
+		CurrentPrice:                pbCurrentPrice,
+		MarketValue:                 pbMarketValue,
+		UnrealizedProfitLoss:        pbUnrealizedProfitLoss,
+		UnrealizedProfitLossPercent: pbUnrealizedProfitLossPercent,
+		Weight:                      pbWeight,
+		FinancedAmount:              pbFinancedAmount,
+		Collateral:                  pbCollateral,
+	}
+}
+
+func GetPortfolioResponseToPB(in *domain.Portfolio) *pb.GetPortfolioResponse {
+	if in == nil {
+		return nil
+	}
+
+	pbPositions := make([]*pb.Position, 0, len(in.Positions))
+	for _, position := range in.Positions {
+		pbPositions = append(pbPositions, PositionToPB(position))
+	}
+
+	return &pb.GetPortfolioResponse{
+		Portfolio: &pb.Portfolio{
+			Positions:            pbPositions,
+			Cash:                 helper.RoundDecimal(in.Cash),
+			Pending:              helper.RoundDecimal(in.Pending),
+			MarketValue:          helper.RoundDecimal(in.MarketValue),
+			UnrealizedProfitLoss: helper.RoundDecimal(in.UnrealizedProfitLoss),
+			Equity:               helper.RoundDecimal(in.Equity),
+		},
+	}
+}
+
+func ImportOrdersRequestFromPB(in *pb.ImportOrdersRequest) *ImportOrdersRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbBroker := in.Broker
+	pbContent := in.Content
+	pbDryRun := in.DryRun
+
+	return &ImportOrdersRequest{
+		Broker:  pbBroker,
+		Content: pbContent,
+		DryRun:  pbDryRun,
+	}
+}
+
+func ImportedTradeToPB(in *domain.TradeConfirmation) *pb.ImportedTrade {
+	if in == nil {
+		return nil
+	}
+
+	pbError := ""
+	if in.Err != nil {
+		pbError = in.Err.Error()
+	}
+
+	return &pb.ImportedTrade{
+		Line:         int32(in.Line),
+		StockID:      in.StockID,
+		OrderType:    in.OrderType,
+		TradeType:    in.TradeType,
+		ExchangeDate: in.ExchangeDate,
+		Price:        in.Price,
+		Quantity:     in.Quantity,
+		Status:       in.Status,
+		Error:        pbError,
+	}
+}
+
+func ImportOrdersResponseToPB(in *ImportOrdersResponse) *pb.ImportOrdersResponse {
+	if in == nil {
+		return nil
+	}
+
+	entries := make([]*pb.ImportedTrade, 0, len(in.Entries))
+	for _, entry := range in.Entries {
+		entries = append(entries, ImportedTradeToPB(entry))
+	}
+
+	return &pb.ImportOrdersResponse{
+		Entries:        entries,
+		NewCount:       in.Counts[domain.ImportStatusNew],
+		DuplicateCount: in.Counts[domain.ImportStatusDuplicate],
+		InvalidCount:   in.Counts[domain.ImportStatusInvalid],
+		CreatedCount:   in.Counts[domain.ImportStatusCreated],
+		FailedCount:    in.Counts[domain.ImportStatusFailed],
+	}
+}
+
+func GetPerformanceRequestFromPB(in *pb.GetPerformanceRequest) *GetPerformanceRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbStartDate := in.StartDate
+	pbEndDate := in.EndDate
+
+	return &GetPerformanceRequest{
+		StartDate: pbStartDate,
+		EndDate:   pbEndDate,
+	}
+}
+
+func GetPerformanceResponseToPB(in *domain.Performance) *pb.GetPerformanceResponse {
+	if in == nil {
+		return nil
+	}
+
+	months := make([]*pb.MonthlyReturn, 0, len(in.MonthlyReturns))
+	for _, month := range in.MonthlyReturns {
+		months = append(months, &pb.MonthlyReturn{
+			Month:  month.Month,
+			Return: helper.RoundDecimalTwo(month.Return),
+		})
+	}
+
+	snapshots := make([]*pb.EquitySnapshot, 0, len(in.Snapshots))
+	for _, snapshot := range in.Snapshots {
+		snapshots = append(snapshots, &pb.EquitySnapshot{
+			ExchangeDate:   snapshot.ExchangeDate,
+			Cash:           helper.RoundDecimal(snapshot.Cash),
+			MarketValue:    helper.RoundDecimal(snapshot.MarketValue),
+			Equity:         helper.RoundDecimal(snapshot.Equity),
+			NetDeposits:    helper.RoundDecimal(snapshot.NetDeposits),
+			BenchmarkClose: snapshot.BenchmarkClose,
+		})
+	}
+
+	return &pb.GetPerformanceResponse{
+		Performance: &pb.Performance{
+			StartDate:          in.StartDate,
+			EndDate:            in.EndDate,
+			TimeWeightedReturn: helper.RoundDecimalTwo(in.TimeWeightedReturn),
+			BenchmarkReturn:    helper.RoundDecimalTwo(in.BenchmarkReturn),
+			ExcessReturn:       helper.RoundDecimalTwo(in.ExcessReturn),
+			SharpeRatio:        helper.RoundDecimalTwo(in.SharpeRatio),
+			MaxDrawdown:        helper.RoundDecimalTwo(in.MaxDrawdown),
+			MonthlyReturns:     months,
+			Snapshots:          snapshots,
+		},
+	}
+}
+
+func CreateJournalEntryRequestFromPB(in *pb.CreateJournalEntryRequest) *CreateJournalEntryRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbOrderID := in.OrderID
+	pbNotes := in.Notes
+	pbTags := in.Tags
+	pbStrategy := in.Strategy
+	pbScreenID := in.ScreenID
+
+	return &CreateJournalEntryRequest{
+		OrderID:  pbOrderID,
+		Notes:    pbNotes,
+		Tags:     pbTags,
+		Strategy: pbStrategy,
+		ScreenID: pbScreenID,
+	}
+}
+
+func CreateJournalEntryResponseToPB(in *CreateJournalEntryResponse) *pb.CreateJournalEntryResponse {
+	if in == nil {
+		return nil
+	}
+
+	pbSuccess := in.Success
+	pbStatus := int32(in.Status)
+	pbErrorCode := in.ErrorCode
+	pbErrorMessage := in.ErrorMessage
+	pbID := in.ID
+
+	return &pb.CreateJournalEntryResponse{
+		Success:      pbSuccess,
+		Status:       pbStatus,
+		ErrorCode:    pbErrorCode,
+		ErrorMessage: pbErrorMessage,
+		Id:           pbID,
+	}
+}
+
+func ListJournalEntriesRequestFromPB(in *pb.ListJournalEntriesRequest) *ListJournalEntriesRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbOrderID := in.OrderID
+
+	return &ListJournalEntriesRequest{
+		OrderID: pbOrderID,
+	}
+}
+
+func ListJournalEntriesResponseToPB(in *ListJournalEntriesResponse) *pb.ListJournalEntriesResponse {
+	if in == nil {
+		return nil
+	}
+
+	entries := make([]*pb.JournalEntry, 0, len(in.Entries))
+
+	for _, obj := range in.Entries {
+		entries = append(entries, JournalEntryToPB(obj))
+	}
+
+	return &pb.ListJournalEntriesResponse{
+		Entries: entries,
+	}
+}
+
+func JournalEntryToPB(in *domain.JournalEntry) *pb.JournalEntry {
+	if in == nil {
+		return nil
+	}
+
+	pbID := in.ID.ID
+	pbOrderID := in.OrderID
+	pbNotes := in.Notes
+	pbTags := in.Tags
+	pbStrategy := in.Strategy
+
+	var pbScreenID string
+	if in.ScreenID != uuid.Nil {
+		pbScreenID = in.ScreenID.String()
+	}
+
+	var pbCreatedAt *timestamppb.Timestamp
+	if in.Time.CreatedAt != nil {
+		pbCreatedAt = timestamppb.New(*in.Time.CreatedAt)
+	}
+
+	return &pb.JournalEntry{
+		Id:        pbID.String(),
+		OrderID:   pbOrderID.String(),
+		Notes:     pbNotes,
+		Tags:      pbTags,
+		Strategy:  pbStrategy,
+		ScreenID:  pbScreenID,
+		CreatedAt: pbCreatedAt,
+	}
+}
+
+func GetTradeStatsRequestFromPB(in *pb.GetTradeStatsRequest) *GetTradeStatsRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbGroupBy := in.GroupBy
+	pbStartDate := in.StartDate
+	pbEndDate := in.EndDate
+
+	return &GetTradeStatsRequest{
+		GroupBy:   pbGroupBy,
+		StartDate: pbStartDate,
+		EndDate:   pbEndDate,
+	}
+}
+
+func GetTradeStatsResponseToPB(in *GetTradeStatsResponse) *pb.GetTradeStatsResponse {
+	if in == nil {
+		return nil
+	}
+
+	entries := make([]*pb.TradeStats, 0, len(in.Entries))
+
+	for _, obj := range in.Entries {
+		entries = append(entries, &pb.TradeStats{
+			Key:                obj.Key,
+			Trades:             obj.Trades,
+			Wins:               obj.Wins,
+			WinRate:            helper.RoundDecimalTwo(obj.WinRate),
+			TotalProfitLoss:    helper.RoundDecimal(obj.TotalProfitLoss),
+			AverageProfitLoss:  helper.RoundDecimal(obj.AverageProfitLoss),
+			AverageHoldingDays: helper.RoundDecimalTwo(obj.AverageHoldingDays),
+		})
+	}
+
+	return &pb.GetTradeStatsResponse{
+		Entries: entries,
+	}
+}
+
+func GetAnnualReportRequestFromPB(in *pb.GetAnnualReportRequest) *GetAnnualReportRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbYear := in.Year
+
+	return &GetAnnualReportRequest{
+		Year: pbYear,
+	}
+}
+
+func GetAnnualReportResponseToPB(in *domain.AnnualReport) *pb.GetAnnualReportResponse {
+	if in == nil {
+		return nil
+	}
+
+	months := make([]*pb.ReportPeriod, 0, len(in.Months))
+	for _, month := range in.Months {
+		months = append(months, ReportPeriodToPB(month))
+	}
+
+	return &pb.GetAnnualReportResponse{
+		Report: &pb.AnnualReport{
+			Year:   int32(in.Year),
+			Total:  ReportPeriodToPB(in.Total),
+			Months: months,
+		},
+	}
+}
+
+func ReportPeriodToPB(in *domain.ReportPeriod) *pb.ReportPeriod {
+	if in == nil {
+		return nil
+	}
+
+	return &pb.ReportPeriod{
+		Period:             in.Period,
+		Deposit:            helper.RoundDecimal(in.Deposit),
+		Withdraw:           helper.RoundDecimal(in.Withdraw),
+		Buy:                helper.RoundDecimal(in.Buy),
+		Sell:               helper.RoundDecimal(in.Sell),
+		Fee:                helper.RoundDecimal(in.Fee),
+		Tax:                helper.RoundDecimal(in.Tax),
+		RealizedProfitLoss: helper.RoundDecimal(in.RealizedProfitLoss),
+		ClosedOrders:       in.ClosedOrders,
+	}
+}
+
 func CreateTransactionRequestFromPB(in *pb.CreateTransactionRequest) *CreateTransactionRequest {
 	if in == nil {
 		return nil
@@ -765,6 +1162,14 @@ func CreateOrderRequestFromPB(in *pb.CreateOrderRequest) *CreateOrderRequest {
 	pbExchangeDate := in.ExchangeDate
 	pbTradePrice := in.TradePrice
 	pbQuantity := in.Quantity
+	pbKind := in.Kind
+	pbLimitPrice := in.LimitPrice
+	pbStopPrice := in.StopPrice
+	pbPositionID := in.PositionID
+	pbLotMethod := in.LotMethod
+	pbLotIDs := in.LotIDs
+	pbLotType := in.LotType
+	pbTradeType := in.TradeType
 
 	request := &CreateOrderRequest{
 		OrderType:    pbOrderType,
@@ -772,6 +1177,14 @@ func CreateOrderRequestFromPB(in *pb.CreateOrderRequest) *CreateOrderRequest {
 		ExchangeDate: pbExchangeDate,
 		TradePrice:   pbTradePrice,
 		Quantity:     pbQuantity,
+		Kind:         pbKind,
+		LimitPrice:   pbLimitPrice,
+		StopPrice:    pbStopPrice,
+		PositionID:   pbPositionID,
+		LotMethod:    pbLotMethod,
+		LotIDs:       pbLotIDs,
+		LotType:      pbLotType,
+		TradeType:    pbTradeType,
 	}
 
 	return request
@@ -869,24 +1282,68 @@ func OrderToPB(in *domain.Order) *pb.Order {
 	pbProfitLossPercent := helper.RoundDecimalTwo(in.ProfitLossPercent)
 	pbStockName := in.StockName
 	pbCurrentPrice := in.CurrentPrice
+	pbKind := in.Kind
+	pbSide := in.Side
+	pbLotType := in.LotType
+	pbTradeType := in.TradeType
+	pbQuantity := in.Quantity
+	pbLimitPrice := in.LimitPrice
+	pbStopPrice := in.StopPrice
+	pbPlacedExchangeDate := in.PlacedExchangeDate
+	pbFilledPrice := in.FilledPrice
+	pbFilledExchangeDate := in.FilledExchangeDate
+	pbFinancedAmount := helper.RoundDecimal(in.FinancedAmount)
+	pbCollateral := helper.RoundDecimal(in.Collateral)
+	pbAccruedInterest := helper.RoundDecimal(in.AccruedInterest)
+	pbMaintenanceRatio := helper.RoundDecimalTwo(in.MaintenanceRatio)
+	pbDividends := helper.RoundDecimal(in.Dividends)
+	pbTotalReturn := helper.RoundDecimal(in.TotalReturn())
+
+	pbPositionID := ""
+	if in.PositionID != uuid.Nil {
+		pbPositionID = in.PositionID.String()
+	}
+
+	pbLotMatches := make([]*pb.LotMatch, 0, len(in.LotMatches))
+	for _, match := range in.LotMatches {
+		pbLotMatches = append(pbLotMatches, LotMatchToPB(match))
+	}
 
 	return &pb.Order{
-		Id:                pbID.String(),
-		StockID:           pbStockID,
-		BuyPrice:          pbBuyPrice,
-		BuyQuantity:       pbBuyQuantity,
-		BuyExchangeDate:   pbBuyExchangeDate,
-		SellPrice:         pbSellPrice,
-		SellQuantity:      pbSellQuantity,
-		SellExchangeDate:  pbSellExchangeDate,
-		ProfitablePrice:   pbProfitablePrice,
-		Status:            pbStatus,
-		ProfitLoss:        pbProfitLoss,
-		ProfitLossPercent: pbProfitLossPercent,
-		StockName:         pbStockName,
-		CurrentPrice:      pbCurrentPrice,
-		CreatedAt:         timestamppb.New(in.CreatedAt),
-		UpdatedAt:         timestamppb.New(in.UpdatedAt),
+		Id:                 pbID.String(),
+		StockID:            pbStockID,
+		BuyPrice:           pbBuyPrice,
+		BuyQuantity:        pbBuyQuantity,
+		BuyExchangeDate:    pbBuyExchangeDate,
+		SellPrice:          pbSellPrice,
+		SellQuantity:       pbSellQuantity,
+		SellExchangeDate:   pbSellExchangeDate,
+		ProfitablePrice:    pbProfitablePrice,
+		Status:             pbStatus,
+		ProfitLoss:         pbProfitLoss,
+		ProfitLossPercent:  pbProfitLossPercent,
+		StockName:          pbStockName,
+		CurrentPrice:       pbCurrentPrice,
+		Kind:               pbKind,
+		Side:               pbSide,
+		LotType:            pbLotType,
+		TradeType:          pbTradeType,
+		FinancedAmount:     pbFinancedAmount,
+		Collateral:         pbCollateral,
+		AccruedInterest:    pbAccruedInterest,
+		MaintenanceRatio:   pbMaintenanceRatio,
+		Dividends:          pbDividends,
+		TotalReturn:        pbTotalReturn,
+		Quantity:           pbQuantity,
+		LimitPrice:         pbLimitPrice,
+		StopPrice:          pbStopPrice,
+		PositionID:         pbPositionID,
+		PlacedExchangeDate: pbPlacedExchangeDate,
+		FilledPrice:        pbFilledPrice,
+		FilledExchangeDate: pbFilledExchangeDate,
+		LotMatches:         pbLotMatches,
+		CreatedAt:          timestamppb.New(in.CreatedAt),
+		UpdatedAt:          timestamppb.New(in.UpdatedAt),
 	}
 }
 
@@ -941,3 +1398,757 @@ func LogoutResponseToPB(in *LogoutResponse) *pb.LogoutResponse {
 		ErrorMessage: pbErrorMessage,
 	}
 }
+
+func CreateScreenRequestFromPB(in *pb.CreateScreenRequest) *CreateScreenRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbName := in.Name
+	pbExpression := in.Expression
+
+	return &CreateScreenRequest{
+		Name:       pbName,
+		Expression: pbExpression,
+	}
+}
+
+func CreateScreenResponseToPB(in *CreateScreenResponse) *pb.CreateScreenResponse {
+	if in == nil {
+		return nil
+	}
+
+	pbSuccess := in.Success
+	pbStatus := int32(in.Status)
+	pbErrorCode := in.ErrorCode
+	pbErrorMessage := in.ErrorMessage
+	pbID := in.ID
+
+	return &pb.CreateScreenResponse{
+		Success:      pbSuccess,
+		Status:       pbStatus,
+		ErrorCode:    pbErrorCode,
+		ErrorMessage: pbErrorMessage,
+		Id:           pbID,
+	}
+}
+
+func ListScreensResponseToPB(in *ListScreensResponse) *pb.ListScreensResponse {
+	if in == nil {
+		return nil
+	}
+
+	entries := make([]*pb.Screen, 0, len(in.Entries))
+
+	for _, obj := range in.Entries {
+		entries = append(entries, ScreenToPB(obj))
+	}
+
+	return &pb.ListScreensResponse{
+		Entries: entries,
+	}
+}
+
+func ScreenToPB(in *domain.Screen) *pb.Screen {
+	if in == nil {
+		return nil
+	}
+
+	pbID := in.ID.ID
+	pbName := in.Name
+	pbExpression := in.Expression
+
+	var pbCreatedAt *timestamppb.Timestamp
+	if in.Time.CreatedAt != nil {
+		pbCreatedAt = timestamppb.New(*in.Time.CreatedAt)
+	}
+
+	var pbUpdatedAt *timestamppb.Timestamp
+	if in.Time.UpdatedAt != nil {
+		pbUpdatedAt = timestamppb.New(*in.Time.UpdatedAt)
+	}
+
+	return &pb.Screen{
+		Id:         pbID.String(),
+		Name:       pbName,
+		Expression: pbExpression,
+		CreatedAt:  pbCreatedAt,
+		UpdatedAt:  pbUpdatedAt,
+	}
+}
+
+func RunScreenRequestFromPB(in *pb.RunScreenRequest) *RunScreenRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbScreenID := in.ScreenID
+	pbDate := in.Date
+	pbAdjusted := in.Adjusted
+
+	return &RunScreenRequest{
+		ScreenID: pbScreenID,
+		Date:     pbDate,
+		Adjusted: pbAdjusted,
+	}
+}
+
+func RunScreenResponseToPB(in *RunScreenResponse) *pb.RunScreenResponse {
+	if in == nil {
+		return nil
+	}
+
+	entries := make([]*pb.Selection, 0, len(in.Entries))
+
+	for _, obj := range in.Entries {
+		entries = append(entries, SelectionToPB(obj))
+	}
+
+	return &pb.RunScreenResponse{
+		Entries: entries,
+	}
+}
+
+func RunBacktestRequestFromPB(in *pb.RunBacktestRequest) *RunBacktestRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbStartDate := in.StartDate
+	pbEndDate := in.EndDate
+	pbStrategy := in.Strategy
+	pbStrict := in.Strict
+	pbHoldingDays := in.HoldingDays
+	pbStopLoss := in.StopLoss
+	pbTakeProfit := in.TakeProfit
+	pbQuantity := in.Quantity
+	pbInitialCapital := in.InitialCapital
+	pbAdjusted := in.Adjusted
+
+	return &RunBacktestRequest{
+		StartDate:      pbStartDate,
+		EndDate:        pbEndDate,
+		Strategy:       pbStrategy,
+		Strict:         pbStrict,
+		HoldingDays:    pbHoldingDays,
+		StopLoss:       pbStopLoss,
+		TakeProfit:     pbTakeProfit,
+		Quantity:       pbQuantity,
+		InitialCapital: pbInitialCapital,
+		Adjusted:       pbAdjusted,
+	}
+}
+
+func RunBacktestResponseToPB(in *RunBacktestResponse) *pb.RunBacktestResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &pb.RunBacktestResponse{
+		Report: BacktestReportToPB(in.Report),
+	}
+}
+
+func BacktestReportToPB(in *domain.BacktestReport) *pb.BacktestReport {
+	if in == nil {
+		return nil
+	}
+
+	trades := make([]*pb.BacktestTrade, 0, len(in.Trades))
+	for _, t := range in.Trades {
+		trades = append(trades, &pb.BacktestTrade{
+			StockID:    t.StockID,
+			EntryDate:  t.EntryDate,
+			ExitDate:   t.ExitDate,
+			ExitReason: t.ExitReason,
+			EntryPrice: t.EntryPrice,
+			ExitPrice:  t.ExitPrice,
+			Quantity:   t.Quantity,
+			Fee:        t.Fee,
+			Tax:        t.Tax,
+			ProfitLoss: t.ProfitLoss,
+			Return:     t.Return,
+		})
+	}
+
+	curve := make([]*pb.EquityPoint, 0, len(in.EquityCurve))
+	for _, p := range in.EquityCurve {
+		curve = append(curve, &pb.EquityPoint{
+			Date:   p.Date,
+			Equity: p.Equity,
+		})
+	}
+
+	return &pb.BacktestReport{
+		StartDate:       in.StartDate,
+		EndDate:         in.EndDate,
+		Strategy:        in.Strategy,
+		InitialCapital:  in.InitialCapital,
+		FinalEquity:     in.FinalEquity,
+		TotalProfitLoss: in.TotalProfitLoss,
+		TotalTrades:     in.TotalTrades,
+		WinningTrades:   in.WinningTrades,
+		WinRate:         in.WinRate,
+		AverageReturn:   in.AverageReturn,
+		MaxDrawdown:     in.MaxDrawdown,
+		Trades:          trades,
+		EquityCurve:     curve,
+	}
+}
+
+func SubscribeQuotesRequestFromPB(in *pb.SubscribeQuotesRequest) *SubscribeQuotesRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbStockIDs := in.StockIDs
+
+	return &SubscribeQuotesRequest{
+		StockIDs: pbStockIDs,
+	}
+}
+
+func QuoteToPB(in *domain.Realtime) *pb.Quote {
+	if in == nil {
+		return nil
+	}
+
+	pbStockID := in.StockID
+	pbName := in.Name
+	pbDate := in.Date
+	pbParseTime := in.ParseTime
+	pbOpen := in.Open
+	pbHigh := in.High
+	pbLow := in.Low
+	pbClose := in.Close
+	pbVolume := in.Volume
+
+	return &pb.Quote{
+		StockID:   pbStockID,
+		Name:      pbName,
+		Date:      pbDate,
+		ParseTime: pbParseTime,
+		Open:      pbOpen,
+		High:      pbHigh,
+		Low:       pbLow,
+		Close:     pbClose,
+		Volume:    pbVolume,
+	}
+}
+
+func ListIntradayBarsRequestFromPB(in *pb.ListIntradayBarsRequest) *ListIntradayBarsRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbStockID := in.StockID
+	pbDate := in.Date
+	pbInterval := in.Interval
+
+	return &ListIntradayBarsRequest{
+		StockID:  pbStockID,
+		Date:     pbDate,
+		Interval: pbInterval,
+	}
+}
+
+func ListIntradayBarsResponseToPB(in *ListIntradayBarsResponse) *pb.ListIntradayBarsResponse {
+	if in == nil {
+		return nil
+	}
+
+	entries := make([]*pb.IntradayBar, 0, len(in.Entries))
+	for _, obj := range in.Entries {
+		entries = append(entries, IntradayBarToPB(obj))
+	}
+
+	return &pb.ListIntradayBarsResponse{
+		Entries: entries,
+	}
+}
+
+func IntradayBarToPB(in *domain.IntradayBar) *pb.IntradayBar {
+	if in == nil {
+		return nil
+	}
+
+	pbStockID := in.StockID
+	pbExchangeDate := in.ExchangeDate
+	pbStartTime := in.StartTime
+	pbOpen := in.Open
+	pbHigh := in.High
+	pbLow := in.Low
+	pbClose := in.Close
+	pbVolume := in.Volume
+	pbInterval := in.Interval
+
+	return &pb.IntradayBar{
+		StockID:      pbStockID,
+		ExchangeDate: pbExchangeDate,
+		StartTime:    pbStartTime,
+		Open:         pbOpen,
+		High:         pbHigh,
+		Low:          pbLow,
+		Close:        pbClose,
+		Volume:       pbVolume,
+		Interval:     pbInterval,
+	}
+}
+
+func CreateAlertRuleRequestFromPB(in *pb.CreateAlertRuleRequest) *CreateAlertRuleRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbStockID := in.StockID
+	pbCondition := in.Condition
+	pbThreshold := in.Threshold
+	pbChannel := in.Channel
+	pbTarget := in.Target
+
+	return &CreateAlertRuleRequest{
+		StockID:   pbStockID,
+		Condition: pbCondition,
+		Threshold: pbThreshold,
+		Channel:   pbChannel,
+		Target:    pbTarget,
+	}
+}
+
+func CreateAlertRuleResponseToPB(in *CreateAlertRuleResponse) *pb.CreateAlertRuleResponse {
+	if in == nil {
+		return nil
+	}
+
+	pbSuccess := in.Success
+	pbStatus := int32(in.Status)
+	pbErrorCode := in.ErrorCode
+	pbErrorMessage := in.ErrorMessage
+	pbID := in.ID
+
+	return &pb.CreateAlertRuleResponse{
+		Success:      pbSuccess,
+		Status:       pbStatus,
+		ErrorCode:    pbErrorCode,
+		ErrorMessage: pbErrorMessage,
+		Id:           pbID,
+	}
+}
+
+func ListAlertRulesResponseToPB(in *ListAlertRulesResponse) *pb.ListAlertRulesResponse {
+	if in == nil {
+		return nil
+	}
+
+	entries := make([]*pb.AlertRule, 0, len(in.Entries))
+
+	for _, obj := range in.Entries {
+		entries = append(entries, AlertRuleToPB(obj))
+	}
+
+	return &pb.ListAlertRulesResponse{
+		Entries: entries,
+	}
+}
+
+func AlertRuleToPB(in *domain.AlertRule) *pb.AlertRule {
+	if in == nil {
+		return nil
+	}
+
+	pbID := in.ID.ID
+	pbStockID := in.StockID
+	pbCondition := in.Condition
+	pbThreshold := in.Threshold
+	pbChannel := in.Channel
+	pbTarget := in.Target
+	pbLastTriggeredDate := in.LastTriggeredDate
+
+	var pbCreatedAt *timestamppb.Timestamp
+	if in.Time.CreatedAt != nil {
+		pbCreatedAt = timestamppb.New(*in.Time.CreatedAt)
+	}
+
+	var pbUpdatedAt *timestamppb.Timestamp
+	if in.Time.UpdatedAt != nil {
+		pbUpdatedAt = timestamppb.New(*in.Time.UpdatedAt)
+	}
+
+	return &pb.AlertRule{
+		Id:                pbID.String(),
+		StockID:           pbStockID,
+		Condition:         pbCondition,
+		Threshold:         pbThreshold,
+		Channel:           pbChannel,
+		Target:            pbTarget,
+		LastTriggeredDate: pbLastTriggeredDate,
+		CreatedAt:         pbCreatedAt,
+		UpdatedAt:         pbUpdatedAt,
+	}
+}
+
+func UpdateAlertRuleRequestFromPB(in *pb.UpdateAlertRuleRequest) *UpdateAlertRuleRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbID := in.Id
+	pbStockID := in.StockID
+	pbCondition := in.Condition
+	pbThreshold := in.Threshold
+	pbChannel := in.Channel
+	pbTarget := in.Target
+
+	return &UpdateAlertRuleRequest{
+		ID:        pbID,
+		StockID:   pbStockID,
+		Condition: pbCondition,
+		Threshold: pbThreshold,
+		Channel:   pbChannel,
+		Target:    pbTarget,
+	}
+}
+
+func UpdateAlertRuleResponseToPB(in *UpdateAlertRuleResponse) *pb.UpdateAlertRuleResponse {
+	if in == nil {
+		return nil
+	}
+
+	pbSuccess := in.Success
+	pbStatus := int32(in.Status)
+	pbErrorCode := in.ErrorCode
+	pbErrorMessage := in.ErrorMessage
+
+	return &pb.UpdateAlertRuleResponse{
+		Success:      pbSuccess,
+		Status:       pbStatus,
+		ErrorCode:    pbErrorCode,
+		ErrorMessage: pbErrorMessage,
+	}
+}
+
+func DeleteAlertRuleRequestFromPB(in *pb.DeleteAlertRuleRequest) *DeleteAlertRuleRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbID := in.Id
+
+	return &DeleteAlertRuleRequest{
+		ID: pbID,
+	}
+}
+
+func DeleteAlertRuleResponseToPB(in *DeleteAlertRuleResponse) *pb.DeleteAlertRuleResponse {
+	if in == nil {
+		return nil
+	}
+
+	pbSuccess := in.Success
+	pbStatus := int32(in.Status)
+	pbErrorCode := in.ErrorCode
+	pbErrorMessage := in.ErrorMessage
+
+	return &pb.DeleteAlertRuleResponse{
+		Success:      pbSuccess,
+		Status:       pbStatus,
+		ErrorCode:    pbErrorCode,
+		ErrorMessage: pbErrorMessage,
+	}
+}
+
+func CancelOrderRequestFromPB(in *pb.CancelOrderRequest) *CancelOrderRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbID := in.Id
+
+	return &CancelOrderRequest{
+		ID: pbID,
+	}
+}
+
+func CancelOrderResponseToPB(in *CancelOrderResponse) *pb.CancelOrderResponse {
+	if in == nil {
+		return nil
+	}
+
+	pbSuccess := in.Success
+	pbStatus := int32(in.Status)
+	pbErrorCode := in.ErrorCode
+	pbErrorMessage := in.ErrorMessage
+
+	return &pb.CancelOrderResponse{
+		Success:      pbSuccess,
+		Status:       pbStatus,
+		ErrorCode:    pbErrorCode,
+		ErrorMessage: pbErrorMessage,
+	}
+}
+
+func AmendOrderRequestFromPB(in *pb.AmendOrderRequest) *AmendOrderRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbID := in.Id
+	pbOrderType := in.OrderType
+	pbExchangeDate := in.ExchangeDate
+	pbTradePrice := in.TradePrice
+	pbQuantity := in.Quantity
+	pbLotType := in.LotType
+
+	return &AmendOrderRequest{
+		ID:           pbID,
+		OrderType:    pbOrderType,
+		LotType:      pbLotType,
+		ExchangeDate: pbExchangeDate,
+		TradePrice:   pbTradePrice,
+		Quantity:     pbQuantity,
+	}
+}
+
+func AmendOrderResponseToPB(in *AmendOrderResponse) *pb.AmendOrderResponse {
+	if in == nil {
+		return nil
+	}
+
+	pbSuccess := in.Success
+	pbStatus := int32(in.Status)
+	pbErrorCode := in.ErrorCode
+	pbErrorMessage := in.ErrorMessage
+
+	return &pb.AmendOrderResponse{
+		Success:      pbSuccess,
+		Status:       pbStatus,
+		ErrorCode:    pbErrorCode,
+		ErrorMessage: pbErrorMessage,
+	}
+}
+
+func LotMatchToPB(in *domain.LotMatch) *pb.LotMatch {
+	if in == nil {
+		return nil
+	}
+
+	pbID := in.ID
+	pbOrderType := in.OrderType
+	pbMethod := in.Method
+	pbExchangeDate := in.ExchangeDate
+	pbQuantity := in.Quantity
+	pbPrice := in.Price
+	pbCostPrice := in.CostPrice
+
+	return &pb.LotMatch{
+		Id:           pbID.String(),
+		OrderType:    pbOrderType,
+		Method:       pbMethod,
+		ExchangeDate: pbExchangeDate,
+		Quantity:     pbQuantity,
+		Price:        pbPrice,
+		CostPrice:    pbCostPrice,
+	}
+}
+
+func CreateBrokerProfileRequestFromPB(in *pb.CreateBrokerProfileRequest) *CreateBrokerProfileRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbName := in.Name
+	pbRebateTiming := in.RebateTiming
+	pbFeeRate := in.FeeRate
+	pbFeeDiscount := in.FeeDiscount
+	pbMinFee := in.MinFee
+	pbOddLotMinFee := in.OddLotMinFee
+	pbTaxRate := in.TaxRate
+	pbDayTradeTaxRate := in.DayTradeTaxRate
+	pbETFTaxRate := in.EtfTaxRate
+	pbBondETFTaxRate := in.BondETFTaxRate
+
+	return &CreateBrokerProfileRequest{
+		Name:            pbName,
+		RebateTiming:    pbRebateTiming,
+		FeeRate:         pbFeeRate,
+		FeeDiscount:     pbFeeDiscount,
+		MinFee:          pbMinFee,
+		OddLotMinFee:    pbOddLotMinFee,
+		TaxRate:         pbTaxRate,
+		DayTradeTaxRate: pbDayTradeTaxRate,
+		ETFTaxRate:      pbETFTaxRate,
+		BondETFTaxRate:  pbBondETFTaxRate,
+	}
+}
+
+func CreateBrokerProfileResponseToPB(in *CreateBrokerProfileResponse) *pb.CreateBrokerProfileResponse {
+	if in == nil {
+		return nil
+	}
+
+	pbSuccess := in.Success
+	pbStatus := int32(in.Status)
+	pbErrorCode := in.ErrorCode
+	pbErrorMessage := in.ErrorMessage
+	pbID := in.ID
+
+	return &pb.CreateBrokerProfileResponse{
+		Success:      pbSuccess,
+		Status:       pbStatus,
+		ErrorCode:    pbErrorCode,
+		ErrorMessage: pbErrorMessage,
+		Id:           pbID,
+	}
+}
+
+func ListBrokerProfilesResponseToPB(in *ListBrokerProfilesResponse) *pb.ListBrokerProfilesResponse {
+	if in == nil {
+		return nil
+	}
+
+	entries := make([]*pb.BrokerProfile, 0, len(in.Entries))
+
+	for _, obj := range in.Entries {
+		entries = append(entries, BrokerProfileToPB(obj))
+	}
+
+	return &pb.ListBrokerProfilesResponse{
+		Entries:    entries,
+		SelectedID: in.SelectedID,
+	}
+}
+
+func BrokerProfileToPB(in *domain.BrokerProfile) *pb.BrokerProfile {
+	if in == nil {
+		return nil
+	}
+
+	// the default schedule is not stored and goes by the empty id
+	pbID := ""
+	if in.ID.ID != uuid.Nil {
+		pbID = in.ID.ID.String()
+	}
+
+	var pbCreatedAt *timestamppb.Timestamp
+	if in.Time.CreatedAt != nil {
+		pbCreatedAt = timestamppb.New(*in.Time.CreatedAt)
+	}
+
+	var pbUpdatedAt *timestamppb.Timestamp
+	if in.Time.UpdatedAt != nil {
+		pbUpdatedAt = timestamppb.New(*in.Time.UpdatedAt)
+	}
+
+	return &pb.BrokerProfile{
+		Id:              pbID,
+		Name:            in.Name,
+		RebateTiming:    in.RebateTiming,
+		FeeRate:         in.FeeRate,
+		FeeDiscount:     in.FeeDiscount,
+		MinFee:          in.MinFee,
+		OddLotMinFee:    in.OddLotMinFee,
+		TaxRate:         in.TaxRate,
+		DayTradeTaxRate: in.DayTradeTaxRate,
+		EtfTaxRate:      in.ETFTaxRate,
+		BondETFTaxRate:  in.BondETFTaxRate,
+		CreatedAt:       pbCreatedAt,
+		UpdatedAt:       pbUpdatedAt,
+	}
+}
+
+func SelectBrokerProfileRequestFromPB(in *pb.SelectBrokerProfileRequest) *SelectBrokerProfileRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbID := in.Id
+
+	return &SelectBrokerProfileRequest{
+		ID: pbID,
+	}
+}
+
+func SelectBrokerProfileResponseToPB(in *SelectBrokerProfileResponse) *pb.SelectBrokerProfileResponse {
+	if in == nil {
+		return nil
+	}
+
+	pbSuccess := in.Success
+	pbStatus := int32(in.Status)
+	pbErrorCode := in.ErrorCode
+	pbErrorMessage := in.ErrorMessage
+
+	return &pb.SelectBrokerProfileResponse{
+		Success:      pbSuccess,
+		Status:       pbStatus,
+		ErrorCode:    pbErrorCode,
+		ErrorMessage: pbErrorMessage,
+	}
+}
+
+func ListEntitlementsResponseToPB(in *ListEntitlementsResponse) *pb.ListEntitlementsResponse {
+	if in == nil {
+		return nil
+	}
+
+	entries := make([]*pb.Entitlement, 0, len(in.Entries))
+
+	for _, obj := range in.Entries {
+		entries = append(entries, EntitlementToPB(obj))
+	}
+
+	return &pb.ListEntitlementsResponse{
+		Entries: entries,
+	}
+}
+
+func EntitlementToPB(in *domain.Entitlement) *pb.Entitlement {
+	if in == nil {
+		return nil
+	}
+
+	return &pb.Entitlement{
+		Id:               in.ID.String(),
+		OrderID:          in.OrderID.String(),
+		StockID:          in.StockID,
+		PaymentDate:      in.PaymentDate,
+		HeldQuantity:     in.HeldQuantity,
+		StockShares:      in.StockShares,
+		CashDividend:     in.CashDividend,
+		CashInLieu:       in.CashInLieu,
+		Premium:          in.Premium,
+		RightsShares:     in.RightsShares,
+		RightsCost:       in.RightsCost,
+		RightsSubscribed: in.RightsSubscribed,
+	}
+}
+
+func SubscribeRightsRequestFromPB(in *pb.SubscribeRightsRequest) *SubscribeRightsRequest {
+	if in == nil {
+		return nil
+	}
+
+	pbID := in.Id
+
+	return &SubscribeRightsRequest{
+		ID: pbID,
+	}
+}
+
+func SubscribeRightsResponseToPB(in *SubscribeRightsResponse) *pb.SubscribeRightsResponse {
+	if in == nil {
+		return nil
+	}
+
+	pbSuccess := in.Success
+	pbStatus := int32(in.Status)
+	pbErrorCode := in.ErrorCode
+	pbErrorMessage := in.ErrorMessage
+
+	return &pb.SubscribeRightsResponse{
+		Success:      pbSuccess,
+		Status:       pbStatus,
+		ErrorCode:    pbErrorCode,
+		ErrorMessage: pbErrorMessage,
+	}
+}
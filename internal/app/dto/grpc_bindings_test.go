@@ -72,6 +72,27 @@ func TestListDailyCloseRequestFromPB(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "WeeklyTimeframe",
+			args: args{
+				in: &pb.ListDailyCloseRequest{
+					Limit:     10,
+					Timeframe: "weekly",
+					SearchParams: &pb.ListDailyCloseSearchParams{
+						StockID: "2330",
+						Start:   "20240101",
+					},
+				},
+			},
+			want: &dto.ListDailyCloseRequest{
+				Limit:     10,
+				Timeframe: "weekly",
+				SearchParams: &dto.ListDailyCloseSearchParams{
+					StockID: "2330",
+					Start:   "20240101",
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
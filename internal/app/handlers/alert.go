@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+)
+
+func (h *handlerImpl) CreateAlertRule(
+	ctx context.Context,
+	req *dto.CreateAlertRuleRequest,
+) (*dto.CreateAlertRuleResponse, error) {
+	obj := &domain.AlertRule{
+		StockID:   req.StockID,
+		Condition: req.Condition,
+		Threshold: req.Threshold,
+		Channel:   req.Channel,
+		Target:    req.Target,
+	}
+
+	err := h.dataService.WithUserID(ctx).CreateAlertRule(ctx, obj)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to create alert rule")
+
+		return &dto.CreateAlertRuleResponse{
+			Status:       dto.StatusError,
+			ErrorCode:    "",
+			ErrorMessage: err.Error(),
+			Success:      false,
+		}, err
+	}
+
+	return &dto.CreateAlertRuleResponse{
+		Status:       dto.StatusSuccess,
+		ErrorCode:    "",
+		ErrorMessage: "",
+		Success:      true,
+		ID:           obj.ID.ID.String(),
+	}, nil
+}
+
+func (h *handlerImpl) ListAlertRules(ctx context.Context) (*dto.ListAlertRulesResponse, error) {
+	entries, err := h.dataService.WithUserID(ctx).ListAlertRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ListAlertRulesResponse{
+		Entries: entries,
+	}, nil
+}
+
+func (h *handlerImpl) UpdateAlertRule(
+	ctx context.Context,
+	req *dto.UpdateAlertRuleRequest,
+) (*dto.UpdateAlertRuleResponse, error) {
+	id, err := uuid.FromString(req.ID)
+	if err == nil {
+		err = h.dataService.WithUserID(ctx).UpdateAlertRule(ctx, &domain.AlertRule{
+			ID:        domain.ID{ID: id},
+			StockID:   req.StockID,
+			Condition: req.Condition,
+			Threshold: req.Threshold,
+			Channel:   req.Channel,
+			Target:    req.Target,
+		})
+	}
+
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to update alert rule")
+
+		return &dto.UpdateAlertRuleResponse{
+			Status:       dto.StatusError,
+			ErrorCode:    "",
+			ErrorMessage: err.Error(),
+			Success:      false,
+		}, err
+	}
+
+	return &dto.UpdateAlertRuleResponse{
+		Status:       dto.StatusSuccess,
+		ErrorCode:    "",
+		ErrorMessage: "",
+		Success:      true,
+	}, nil
+}
+
+func (h *handlerImpl) DeleteAlertRule(
+	ctx context.Context,
+	req *dto.DeleteAlertRuleRequest,
+) (*dto.DeleteAlertRuleResponse, error) {
+	err := h.dataService.WithUserID(ctx).DeleteAlertRule(ctx, req.ID)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to delete alert rule")
+
+		return &dto.DeleteAlertRuleResponse{
+			Status:       dto.StatusError,
+			ErrorCode:    "",
+			ErrorMessage: err.Error(),
+			Success:      false,
+		}, err
+	}
+
+	return &dto.DeleteAlertRuleResponse{
+		Status:       dto.StatusSuccess,
+		ErrorCode:    "",
+		ErrorMessage: "",
+		Success:      true,
+	}, nil
+}
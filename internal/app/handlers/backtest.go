@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/samwang0723/jarvis/internal/app/dto"
+)
+
+func (h *handlerImpl) RunBacktest(
+	ctx context.Context,
+	req *dto.RunBacktestRequest,
+) (*dto.RunBacktestResponse, error) {
+	report, err := h.dataService.RunBacktest(ctx, req)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to run backtest")
+
+		return nil, err
+	}
+
+	return &dto.RunBacktestResponse{
+		Report: report,
+	}, nil
+}
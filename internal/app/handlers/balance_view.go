@@ -28,3 +28,12 @@ func (h *handlerImpl) GetBalance(ctx context.Context) (*domain.BalanceView, erro
 
 	return balanceView, nil
 }
+
+func (h *handlerImpl) GetPortfolio(ctx context.Context) (*domain.Portfolio, error) {
+	portfolio, err := h.dataService.WithUserID(ctx).GetPortfolio(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return portfolio, nil
+}
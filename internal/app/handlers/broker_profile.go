@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+)
+
+func (h *handlerImpl) CreateBrokerProfile(
+	ctx context.Context,
+	req *dto.CreateBrokerProfileRequest,
+) (*dto.CreateBrokerProfileResponse, error) {
+	obj := &domain.BrokerProfile{
+		Name:            req.Name,
+		RebateTiming:    req.RebateTiming,
+		FeeRate:         req.FeeRate,
+		FeeDiscount:     req.FeeDiscount,
+		MinFee:          req.MinFee,
+		OddLotMinFee:    req.OddLotMinFee,
+		TaxRate:         req.TaxRate,
+		DayTradeTaxRate: req.DayTradeTaxRate,
+		ETFTaxRate:      req.ETFTaxRate,
+		BondETFTaxRate:  req.BondETFTaxRate,
+	}
+
+	err := h.dataService.WithUserID(ctx).CreateBrokerProfile(ctx, obj)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to create broker profile")
+
+		return &dto.CreateBrokerProfileResponse{
+			Status:       dto.StatusError,
+			ErrorCode:    "",
+			ErrorMessage: err.Error(),
+			Success:      false,
+		}, err
+	}
+
+	return &dto.CreateBrokerProfileResponse{
+		Status:       dto.StatusSuccess,
+		ErrorCode:    "",
+		ErrorMessage: "",
+		Success:      true,
+		ID:           obj.ID.ID.String(),
+	}, nil
+}
+
+func (h *handlerImpl) ListBrokerProfiles(ctx context.Context) (*dto.ListBrokerProfilesResponse, error) {
+	service := h.dataService.WithUserID(ctx)
+
+	entries, err := service.ListBrokerProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	selected, err := service.GetBrokerProfile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	selectedID := ""
+	if selected.ID.ID != uuid.Nil {
+		selectedID = selected.ID.ID.String()
+	}
+
+	return &dto.ListBrokerProfilesResponse{
+		Entries:    entries,
+		SelectedID: selectedID,
+	}, nil
+}
+
+func (h *handlerImpl) SelectBrokerProfile(
+	ctx context.Context,
+	req *dto.SelectBrokerProfileRequest,
+) (*dto.SelectBrokerProfileResponse, error) {
+	id := uuid.Nil
+
+	var err error
+	if req.ID != "" {
+		id, err = uuid.FromString(req.ID)
+	}
+
+	if err == nil {
+		err = h.dataService.WithUserID(ctx).SelectBrokerProfile(ctx, id)
+	}
+
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to select broker profile")
+
+		return &dto.SelectBrokerProfileResponse{
+			Status:       dto.StatusError,
+			ErrorCode:    "",
+			ErrorMessage: err.Error(),
+			Success:      false,
+		}, err
+	}
+
+	return &dto.SelectBrokerProfileResponse{
+		Status:       dto.StatusSuccess,
+		ErrorCode:    "",
+		ErrorMessage: "",
+		Success:      true,
+	}, nil
+}
+
+func (h *handlerImpl) SettleFeeRebates(ctx context.Context, schedule string) error {
+	err := h.dataService.AddJob(ctx, schedule, func() {
+		err := h.dataService.SettleFeeRebates(ctx)
+		if err != nil {
+			h.logger.Error().Err(err).Msg("failed to settle fee rebates")
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
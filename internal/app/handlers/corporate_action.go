@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+)
+
+func (h *handlerImpl) DistributeCorporateActions(ctx context.Context, schedule string) error {
+	err := h.dataService.AddJob(ctx, schedule, func() {
+		err := h.dataService.DistributeCorporateActions(ctx)
+		if err != nil {
+			h.logger.Error().Err(err).Msg("failed to distribute corporate actions")
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h *handlerImpl) ListEntitlements(ctx context.Context) (*dto.ListEntitlementsResponse, error) {
+	entries, err := h.dataService.WithUserID(ctx).ListEntitlements(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ListEntitlementsResponse{
+		Entries: entries,
+	}, nil
+}
+
+func (h *handlerImpl) SubscribeRights(
+	ctx context.Context,
+	req *dto.SubscribeRightsRequest,
+) (*dto.SubscribeRightsResponse, error) {
+	id, err := uuid.FromString(req.ID)
+	if err == nil {
+		err = h.dataService.WithUserID(ctx).SubscribeRights(ctx, id)
+	}
+
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to subscribe rights")
+
+		return &dto.SubscribeRightsResponse{
+			Status:       dto.StatusError,
+			ErrorCode:    "",
+			ErrorMessage: err.Error(),
+			Success:      false,
+		}, err
+	}
+
+	return &dto.SubscribeRightsResponse{
+		Status:       dto.StatusSuccess,
+		ErrorCode:    "",
+		ErrorMessage: "",
+		Success:      true,
+	}, nil
+}
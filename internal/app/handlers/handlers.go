@@ -43,6 +43,15 @@ type IHandler interface {
 		req *dto.ListThreePrimaryRequest,
 	) (*dto.ListThreePrimaryResponse, error)
 	ListeningKafkaInput(ctx context.Context)
+	ListeningRealtimeQuotes(ctx context.Context) error
+	ListIntradayBars(
+		ctx context.Context,
+		req *dto.ListIntradayBarsRequest,
+	) (*dto.ListIntradayBarsResponse, error)
+	SubscribeQuotes(
+		ctx context.Context,
+		req *dto.SubscribeQuotesRequest,
+	) (<-chan *domain.Realtime, error)
 	CronjobPresetRealtimeMonitoringKeys(ctx context.Context, schedule string) error
 	CrawlingRealTimePrice(ctx context.Context, schedule string) error
 	ListPickedStocks(ctx context.Context) (*dto.ListPickedStocksResponse, error)
@@ -59,12 +68,64 @@ type IHandler interface {
 	CreateUser(ctx context.Context, req *dto.CreateUserRequest) (*dto.CreateUserResponse, error)
 	ListUsers(ctx context.Context, req *dto.ListUsersRequest) (*dto.ListUsersResponse, error)
 	GetBalance(ctx context.Context) (*domain.BalanceView, error)
+	GetPortfolio(ctx context.Context) (*domain.Portfolio, error)
+	SnapshotEquity(ctx context.Context, schedule string) error
+	GetPerformance(ctx context.Context, req *dto.GetPerformanceRequest) (*domain.Performance, error)
+	GetAnnualReport(ctx context.Context, req *dto.GetAnnualReportRequest) (*domain.AnnualReport, error)
 	CreateTransaction(
 		ctx context.Context,
 		req *dto.CreateTransactionRequest,
 	) (*dto.CreateTransactionResponse, error)
 	CreateOrder(ctx context.Context, req *dto.CreateOrderRequest) (*dto.CreateOrderResponse, error)
 	ListOrders(ctx context.Context, req *dto.ListOrderRequest) (*dto.ListOrderResponse, error)
+	CancelOrder(ctx context.Context, req *dto.CancelOrderRequest) (*dto.CancelOrderResponse, error)
+	AmendOrder(ctx context.Context, req *dto.AmendOrderRequest) (*dto.AmendOrderResponse, error)
+	ImportOrders(ctx context.Context, req *dto.ImportOrdersRequest) (*dto.ImportOrdersResponse, error)
+	CreateJournalEntry(
+		ctx context.Context,
+		req *dto.CreateJournalEntryRequest,
+	) (*dto.CreateJournalEntryResponse, error)
+	ListJournalEntries(
+		ctx context.Context,
+		req *dto.ListJournalEntriesRequest,
+	) (*dto.ListJournalEntriesResponse, error)
+	GetTradeStats(ctx context.Context, req *dto.GetTradeStatsRequest) (*dto.GetTradeStatsResponse, error)
+	CreateScreen(
+		ctx context.Context,
+		req *dto.CreateScreenRequest,
+	) (*dto.CreateScreenResponse, error)
+	ListScreens(ctx context.Context) (*dto.ListScreensResponse, error)
+	RunScreen(ctx context.Context, req *dto.RunScreenRequest) (*dto.RunScreenResponse, error)
+	RunBacktest(ctx context.Context, req *dto.RunBacktestRequest) (*dto.RunBacktestResponse, error)
+	CreateAlertRule(
+		ctx context.Context,
+		req *dto.CreateAlertRuleRequest,
+	) (*dto.CreateAlertRuleResponse, error)
+	ListAlertRules(ctx context.Context) (*dto.ListAlertRulesResponse, error)
+	UpdateAlertRule(
+		ctx context.Context,
+		req *dto.UpdateAlertRuleRequest,
+	) (*dto.UpdateAlertRuleResponse, error)
+	DeleteAlertRule(
+		ctx context.Context,
+		req *dto.DeleteAlertRuleRequest,
+	) (*dto.DeleteAlertRuleResponse, error)
+	CreateBrokerProfile(
+		ctx context.Context,
+		req *dto.CreateBrokerProfileRequest,
+	) (*dto.CreateBrokerProfileResponse, error)
+	ListBrokerProfiles(ctx context.Context) (*dto.ListBrokerProfilesResponse, error)
+	SelectBrokerProfile(
+		ctx context.Context,
+		req *dto.SelectBrokerProfileRequest,
+	) (*dto.SelectBrokerProfileResponse, error)
+	SettleFeeRebates(ctx context.Context, schedule string) error
+	DistributeCorporateActions(ctx context.Context, schedule string) error
+	ListEntitlements(ctx context.Context) (*dto.ListEntitlementsResponse, error)
+	SubscribeRights(
+		ctx context.Context,
+		req *dto.SubscribeRightsRequest,
+	) (*dto.SubscribeRightsResponse, error)
 }
 
 type handlerImpl struct {
@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/samwang0723/jarvis/internal/app/dto"
+)
+
+func (h *handlerImpl) ListIntradayBars(
+	ctx context.Context,
+	req *dto.ListIntradayBarsRequest,
+) (*dto.ListIntradayBarsResponse, error) {
+	entries, err := h.dataService.ListIntradayBars(ctx, req)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to list intraday bars")
+
+		return nil, err
+	}
+
+	return &dto.ListIntradayBarsResponse{
+		Entries: entries,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+)
+
+func (h *handlerImpl) CreateJournalEntry(
+	ctx context.Context,
+	req *dto.CreateJournalEntryRequest,
+) (*dto.CreateJournalEntryResponse, error) {
+	var entry *domain.JournalEntry
+	orderID, err := uuid.FromString(req.OrderID)
+	if err == nil {
+		entry, err = h.dataService.WithUserID(ctx).CreateJournalEntry(ctx, orderID, req)
+	}
+
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to create journal entry")
+
+		return &dto.CreateJournalEntryResponse{
+			Status:       dto.StatusError,
+			ErrorCode:    "",
+			ErrorMessage: err.Error(),
+			Success:      false,
+		}, err
+	}
+
+	return &dto.CreateJournalEntryResponse{
+		Status:       dto.StatusSuccess,
+		ErrorCode:    "",
+		ErrorMessage: "",
+		Success:      true,
+		ID:           entry.ID.ID.String(),
+	}, nil
+}
+
+func (h *handlerImpl) ListJournalEntries(
+	ctx context.Context,
+	req *dto.ListJournalEntriesRequest,
+) (*dto.ListJournalEntriesResponse, error) {
+	orderID, err := uuid.FromString(req.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := h.dataService.WithUserID(ctx).ListJournalEntries(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ListJournalEntriesResponse{
+		Entries: entries,
+	}, nil
+}
+
+func (h *handlerImpl) GetTradeStats(
+	ctx context.Context,
+	req *dto.GetTradeStatsRequest,
+) (*dto.GetTradeStatsResponse, error) {
+	entries, err := h.dataService.WithUserID(ctx).GetTradeStats(ctx, req)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to get trade stats")
+
+		return nil, err
+	}
+
+	return &dto.GetTradeStatsResponse{
+		Entries: entries,
+	}, nil
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 
+	"github.com/gofrs/uuid/v5"
 	"github.com/samwang0723/jarvis/internal/app/domain"
 	"github.com/samwang0723/jarvis/internal/app/dto"
 )
@@ -60,3 +61,81 @@ func (h *handlerImpl) ListOrders(
 		TotalCount: totalCount,
 	}, nil
 }
+
+func (h *handlerImpl) CancelOrder(
+	ctx context.Context,
+	req *dto.CancelOrderRequest,
+) (*dto.CancelOrderResponse, error) {
+	id, err := uuid.FromString(req.ID)
+	if err == nil {
+		err = h.dataService.WithUserID(ctx).CancelOrder(ctx, id)
+	}
+
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to cancel order")
+
+		return &dto.CancelOrderResponse{
+			Status:       dto.StatusError,
+			ErrorCode:    "",
+			ErrorMessage: err.Error(),
+			Success:      false,
+		}, err
+	}
+
+	return &dto.CancelOrderResponse{
+		Status:       dto.StatusSuccess,
+		ErrorCode:    "",
+		ErrorMessage: "",
+		Success:      true,
+	}, nil
+}
+
+func (h *handlerImpl) AmendOrder(
+	ctx context.Context,
+	req *dto.AmendOrderRequest,
+) (*dto.AmendOrderResponse, error) {
+	id, err := uuid.FromString(req.ID)
+	if err == nil {
+		err = h.dataService.WithUserID(ctx).AmendOrder(ctx, id, req)
+	}
+
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to amend order")
+
+		return &dto.AmendOrderResponse{
+			Status:       dto.StatusError,
+			ErrorCode:    "",
+			ErrorMessage: err.Error(),
+			Success:      false,
+		}, err
+	}
+
+	return &dto.AmendOrderResponse{
+		Status:       dto.StatusSuccess,
+		ErrorCode:    "",
+		ErrorMessage: "",
+		Success:      true,
+	}, nil
+}
+
+func (h *handlerImpl) ImportOrders(
+	ctx context.Context,
+	req *dto.ImportOrdersRequest,
+) (*dto.ImportOrdersResponse, error) {
+	trades, err := h.dataService.WithUserID(ctx).ImportOrders(ctx, req)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to import orders")
+
+		return nil, err
+	}
+
+	counts := make(map[string]int32)
+	for _, trade := range trades {
+		counts[trade.Status]++
+	}
+
+	return &dto.ImportOrdersResponse{
+		Entries: trades,
+		Counts:  counts,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+)
+
+func (h *handlerImpl) SnapshotEquity(ctx context.Context, schedule string) error {
+	err := h.dataService.AddJob(ctx, schedule, func() {
+		err := h.dataService.SnapshotEquity(ctx)
+		if err != nil {
+			h.logger.Error().Err(err).Msg("failed to snapshot equity")
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h *handlerImpl) GetPerformance(
+	ctx context.Context,
+	req *dto.GetPerformanceRequest,
+) (*domain.Performance, error) {
+	performance, err := h.dataService.WithUserID(ctx).GetPerformance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return performance, nil
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+)
+
+func (h *handlerImpl) ListeningRealtimeQuotes(ctx context.Context) error {
+	return h.dataService.ListeningRealtimeQuotes(ctx)
+}
+
+func (h *handlerImpl) SubscribeQuotes(
+	ctx context.Context,
+	req *dto.SubscribeQuotesRequest,
+) (<-chan *domain.Realtime, error) {
+	quotes, err := h.dataService.SubscribeQuotes(ctx, req.StockIDs)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to subscribe quotes")
+
+		return nil, err
+	}
+
+	return quotes, nil
+}
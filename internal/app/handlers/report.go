@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+)
+
+func (h *handlerImpl) GetAnnualReport(
+	ctx context.Context,
+	req *dto.GetAnnualReportRequest,
+) (*domain.AnnualReport, error) {
+	report, err := h.dataService.WithUserID(ctx).GetAnnualReport(ctx, req)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to get annual report")
+
+		return nil, err
+	}
+
+	return report, nil
+}
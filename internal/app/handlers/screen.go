@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+)
+
+func (h *handlerImpl) CreateScreen(
+	ctx context.Context,
+	req *dto.CreateScreenRequest,
+) (*dto.CreateScreenResponse, error) {
+	obj := &domain.Screen{
+		Name:       req.Name,
+		Expression: req.Expression,
+	}
+
+	err := h.dataService.WithUserID(ctx).CreateScreen(ctx, obj)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to create screen")
+
+		return &dto.CreateScreenResponse{
+			Status:       dto.StatusError,
+			ErrorCode:    "",
+			ErrorMessage: err.Error(),
+			Success:      false,
+		}, err
+	}
+
+	return &dto.CreateScreenResponse{
+		Status:       dto.StatusSuccess,
+		ErrorCode:    "",
+		ErrorMessage: "",
+		Success:      true,
+		ID:           obj.ID.ID.String(),
+	}, nil
+}
+
+func (h *handlerImpl) ListScreens(ctx context.Context) (*dto.ListScreensResponse, error) {
+	entries, err := h.dataService.WithUserID(ctx).ListScreens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ListScreensResponse{
+		Entries: entries,
+	}, nil
+}
+
+func (h *handlerImpl) RunScreen(
+	ctx context.Context,
+	req *dto.RunScreenRequest,
+) (*dto.RunScreenResponse, error) {
+	entries, err := h.dataService.WithUserID(ctx).RunScreen(ctx, req)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to run screen")
+
+		return nil, err
+	}
+
+	return &dto.RunScreenResponse{
+		Entries: entries,
+	}, nil
+}
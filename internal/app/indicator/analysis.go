@@ -0,0 +1,63 @@
+package indicator
+
+import (
+	"math"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+)
+
+const (
+	weeklyMA5  = 5
+	weeklyMA10 = 10
+	weeklyMA20 = 20
+	monthlyMA3 = 3
+	monthlyMA5 = 5
+)
+
+// Apply fills the latest indicator values of the series into the analysis,
+// indicators without enough history are left as zero. Weekly and monthly
+// moving averages are computed on the resampled series, the current week or
+// month counts as a bar even while it is still in progress.
+func Apply(analysis *domain.Analysis, series []*domain.DailyClose) {
+	if analysis == nil || len(series) == 0 {
+		return
+	}
+
+	macd := MACD(series, defaultFast, defaultSlow, defaultSignal)
+	bollinger := Bollinger(series, defaultBollinger, defaultBollingerWidth)
+	kd := KD(series, defaultKD)
+
+	analysis.EMA12 = latest(EMA(series, defaultFast))
+	analysis.EMA26 = latest(EMA(series, defaultSlow))
+	analysis.RSI14 = latest(RSI(series, defaultRSI))
+	analysis.MACD = latest(macd.MACD)
+	analysis.MACDSignal = latest(macd.Signal)
+	analysis.MACDHistogram = latest(macd.Histogram)
+	analysis.BollingerUpper = latest(bollinger.Upper)
+	analysis.BollingerMiddle = latest(bollinger.Middle)
+	analysis.BollingerLower = latest(bollinger.Lower)
+	analysis.K9 = latest(kd.K)
+	analysis.D9 = latest(kd.D)
+	analysis.ATR14 = latest(ATR(series, defaultATR))
+	analysis.OBV = int64(Last(OBV(series)))
+
+	if weekly, err := domain.Resample(series, domain.TimeframeWeekly); err == nil {
+		analysis.WeeklyMA5 = latest(SMA(weekly, weeklyMA5))
+		analysis.WeeklyMA10 = latest(SMA(weekly, weeklyMA10))
+		analysis.WeeklyMA20 = latest(SMA(weekly, weeklyMA20))
+	}
+
+	if monthly, err := domain.Resample(series, domain.TimeframeMonthly); err == nil {
+		analysis.MonthlyMA3 = latest(SMA(monthly, monthlyMA3))
+		analysis.MonthlyMA5 = latest(SMA(monthly, monthlyMA5))
+	}
+}
+
+func latest(values []float64) float32 {
+	v := Last(values)
+	if math.IsNaN(v) {
+		return 0
+	}
+
+	return float32(v)
+}
@@ -0,0 +1,100 @@
+// Package indicator computes technical indicators on daily close series.
+// Every function expects the series ordered by exchange date ascending and
+// returns values aligned with the input, entries without enough history to
+// be computed are NaN.
+package indicator
+
+import (
+	"math"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+)
+
+func closes(series []*domain.DailyClose) []float64 {
+	out := make([]float64, len(series))
+	for i, s := range series {
+		out[i] = float64(s.Close)
+	}
+
+	return out
+}
+
+func nanSlice(size int) []float64 {
+	out := make([]float64, size)
+	for i := range out {
+		out[i] = math.NaN()
+	}
+
+	return out
+}
+
+// Last returns the most recent computed value, or NaN if none.
+func Last(values []float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+
+	return values[len(values)-1]
+}
+
+// SMA is the simple moving average of close.
+func SMA(series []*domain.DailyClose, period int) []float64 {
+	return sma(closes(series), period)
+}
+
+// EMA is the exponential moving average of close, seeded with the SMA of the
+// first period values.
+func EMA(series []*domain.DailyClose, period int) []float64 {
+	return ema(closes(series), period)
+}
+
+func sma(values []float64, period int) []float64 {
+	out := nanSlice(len(values))
+	if period <= 0 {
+		return out
+	}
+
+	sum := 0.0
+	for i, v := range values {
+		sum += v
+		if i >= period {
+			sum -= values[i-period]
+		}
+		if i >= period-1 {
+			out[i] = sum / float64(period)
+		}
+	}
+
+	return out
+}
+
+// ema skips the leading NaN values so it can be chained on other indicators.
+func ema(values []float64, period int) []float64 {
+	out := nanSlice(len(values))
+	if period <= 0 {
+		return out
+	}
+
+	start := 0
+	for start < len(values) && math.IsNaN(values[start]) {
+		start++
+	}
+	if len(values)-start < period {
+		return out
+	}
+
+	seed := 0.0
+	for i := start; i < start+period; i++ {
+		seed += values[i]
+	}
+
+	idx := start + period - 1
+	out[idx] = seed / float64(period)
+
+	k := 2 / float64(period+1)
+	for i := idx + 1; i < len(values); i++ {
+		out[i] = (values[i]-out[i-1])*k + out[i-1]
+	}
+
+	return out
+}
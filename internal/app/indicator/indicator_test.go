@@ -0,0 +1,230 @@
+package indicator
+
+import (
+	"flag"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"go.uber.org/goleak"
+)
+
+func TestMain(m *testing.M) {
+	leak := flag.Bool("leak", false, "use leak detector")
+
+	if *leak {
+		goleak.VerifyTestMain(m)
+
+		return
+	}
+
+	os.Exit(m.Run())
+}
+
+func closeSeries(values ...float32) []*domain.DailyClose {
+	series := make([]*domain.DailyClose, 0, len(values))
+	for _, v := range values {
+		series = append(series, &domain.DailyClose{Close: v})
+	}
+
+	return series
+}
+
+func linearSeries(size int) []*domain.DailyClose {
+	series := make([]*domain.DailyClose, 0, size)
+	for i := 1; i <= size; i++ {
+		series = append(series, &domain.DailyClose{Close: float32(i)})
+	}
+
+	return series
+}
+
+func assertValues(t *testing.T, name string, got []float64, offset int, want []float64, tolerance float64) {
+	t.Helper()
+
+	for i := 0; i < offset; i++ {
+		if !math.IsNaN(got[i]) {
+			t.Errorf("%s[%d]: expect NaN during warm up, got %f", name, i, got[i])
+		}
+	}
+
+	for i, w := range want {
+		if math.Abs(got[offset+i]-w) > tolerance {
+			t.Errorf("%s[%d]: expect %.4f, got %.4f", name, offset+i, w, got[offset+i])
+		}
+	}
+}
+
+// Reference values from the StockCharts 10-day EMA example.
+func TestEMA(t *testing.T) {
+	t.Parallel()
+
+	series := closeSeries(
+		22.27, 22.19, 22.08, 22.17, 22.18, 22.13, 22.23, 22.43, 22.24, 22.29,
+		22.15, 22.39, 22.38, 22.61, 23.36, 24.05, 23.75, 23.83, 23.95, 23.63,
+		23.82, 23.87, 23.65, 23.19, 23.10, 23.33, 22.68, 23.10, 22.40, 22.17,
+	)
+
+	assertValues(t, "EMA", EMA(series, 10), 9, []float64{
+		22.22, 22.21, 22.24, 22.27, 22.33, 22.52, 22.80, 22.97, 23.13, 23.28,
+		23.34, 23.43, 23.51, 23.54, 23.47, 23.40, 23.39, 23.26, 23.23, 23.08, 22.92,
+	}, 0.01)
+}
+
+// Reference values from the StockCharts 14-day RSI example.
+func TestRSI(t *testing.T) {
+	t.Parallel()
+
+	series := closeSeries(
+		44.3389, 44.0902, 44.1497, 43.6124, 44.3278, 44.8264, 45.0955, 45.4245,
+		45.8433, 46.0826, 45.8931, 46.0328, 45.6140, 46.2820, 46.2820, 46.0028,
+		46.0328, 46.4116, 46.2222, 45.6439, 46.2122, 46.2521, 45.7137, 46.4515,
+		45.7835, 45.3548, 44.0288, 44.1783, 44.2181, 44.5672, 43.4205, 42.6628, 43.1314,
+	)
+
+	assertValues(t, "RSI", RSI(series, 14), 14, []float64{
+		70.53, 66.32, 66.55, 69.41, 66.36, 57.97, 62.93, 63.26, 56.06, 62.38,
+		54.71, 50.42, 39.99, 41.46, 41.87, 45.46, 37.30, 33.08, 37.77,
+	}, 0.01)
+}
+
+// The EMA of a linear series lags by (period-1)/2, so MACD(12, 26, 9) of
+// 1, 2, 3... is constant 12.5 - 5.5 = 7 and the histogram is zero.
+func TestMACD(t *testing.T) {
+	t.Parallel()
+
+	res := MACD(linearSeries(40), 12, 26, 9)
+
+	assertValues(t, "MACD", res.MACD, 25, []float64{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7}, 1e-6)
+	assertValues(t, "Signal", res.Signal, 33, []float64{7, 7, 7, 7, 7, 7, 7}, 1e-6)
+	assertValues(t, "Histogram", res.Histogram, 33, []float64{0, 0, 0, 0, 0, 0, 0}, 1e-6)
+}
+
+// Population standard deviation of 1..20 is sqrt(399/12).
+func TestBollinger(t *testing.T) {
+	t.Parallel()
+
+	res := Bollinger(linearSeries(20), 20, 2)
+	deviation := math.Sqrt(399.0 / 12.0)
+
+	assertValues(t, "Middle", res.Middle, 19, []float64{10.5}, 1e-6)
+	assertValues(t, "Upper", res.Upper, 19, []float64{10.5 + 2*deviation}, 1e-6)
+	assertValues(t, "Lower", res.Lower, 19, []float64{10.5 - 2*deviation}, 1e-6)
+}
+
+func TestKD(t *testing.T) {
+	t.Parallel()
+
+	series := []*domain.DailyClose{
+		{High: 10, Low: 8, Close: 9},
+		{High: 11, Low: 9, Close: 10},
+		{High: 12, Low: 10, Close: 11},
+		{High: 13, Low: 11, Close: 12},
+		{High: 12, Low: 12, Close: 12},
+	}
+
+	// RSV: 75, 75 then (12-10)/(13-10) = 66.67
+	res := KD(series, 3)
+
+	assertValues(t, "K", res.K, 2, []float64{58.3333, 63.8889, 64.8148}, 1e-4)
+	assertValues(t, "D", res.D, 2, []float64{52.7778, 56.4815, 59.2593}, 1e-4)
+}
+
+// Reference values from the StockCharts 14-day ATR example, rounded to two
+// decimals there.
+func TestATR(t *testing.T) {
+	t.Parallel()
+
+	hlc := [][3]float32{
+		{48.70, 47.79, 48.16}, {48.72, 48.14, 48.61}, {48.90, 48.39, 48.75},
+		{48.87, 48.37, 48.63}, {48.82, 48.24, 48.74}, {49.05, 48.64, 49.03},
+		{49.20, 48.94, 49.07}, {49.35, 48.86, 49.32}, {49.92, 49.50, 49.91},
+		{50.19, 49.87, 50.13}, {50.12, 49.20, 49.53}, {49.66, 48.90, 49.50},
+		{49.88, 49.43, 49.75}, {50.19, 49.73, 50.03}, {50.36, 49.26, 50.31},
+		{50.57, 50.09, 50.52}, {50.65, 50.30, 50.41}, {50.43, 49.21, 49.34},
+		{49.63, 48.98, 49.37}, {50.33, 49.61, 50.23}, {50.29, 49.20, 49.24},
+		{50.17, 49.43, 49.93}, {49.32, 48.08, 48.43}, {48.50, 47.64, 48.18},
+		{48.32, 41.55, 46.57}, {46.80, 44.28, 45.41}, {47.80, 47.31, 47.77},
+		{48.39, 47.20, 47.72}, {48.66, 47.90, 48.62}, {48.79, 47.73, 47.85},
+	}
+	series := make([]*domain.DailyClose, 0, len(hlc))
+	for _, v := range hlc {
+		series = append(series, &domain.DailyClose{High: v[0], Low: v[1], Close: v[2]})
+	}
+
+	assertValues(t, "ATR", ATR(series, 14), 13, []float64{
+		0.56, 0.59, 0.59, 0.57, 0.62, 0.62, 0.64, 0.67, 0.69, 0.78,
+		0.78, 1.21, 1.30, 1.38, 1.37, 1.34, 1.32,
+	}, 0.015)
+}
+
+func TestOBV(t *testing.T) {
+	t.Parallel()
+
+	series := []*domain.DailyClose{
+		{Close: 10, TradedShares: 100},
+		{Close: 11, TradedShares: 200},
+		{Close: 11, TradedShares: 300},
+		{Close: 10, TradedShares: 400},
+		{Close: 12, TradedShares: 500},
+	}
+
+	got := OBV(series)
+	want := []float64{0, 200, 200, -200, 300}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("OBV[%d]: expect %f, got %f", i, want[i], got[i])
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	analysis := &domain.Analysis{}
+	Apply(analysis, linearSeries(40))
+
+	if analysis.MACD != 7 || analysis.MACDHistogram != 0 || analysis.BollingerMiddle != 30.5 {
+		t.Errorf("unexpected analysis %+v", analysis)
+	}
+
+	if analysis.RSI14 != 100 {
+		t.Errorf("expect RSI 100 for a rising series, got %f", analysis.RSI14)
+	}
+
+	short := &domain.Analysis{}
+	Apply(short, linearSeries(5))
+
+	if short.EMA26 != 0 || short.ATR14 != 0 {
+		t.Errorf("expect zero values without enough history, got %+v", short)
+	}
+}
+
+func TestApplyMultiTimeframe(t *testing.T) {
+	t.Parallel()
+
+	// six full weeks of trading days starting on Monday 2024-01-01
+	series := linearSeries(30)
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, s := range series {
+		for day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			day = day.AddDate(0, 0, 1)
+		}
+		s.ExchangeDate = day.Format("20060102")
+		day = day.AddDate(0, 0, 1)
+	}
+
+	analysis := &domain.Analysis{}
+	Apply(analysis, series)
+
+	// weekly closes are 5, 10, ..., 30
+	if analysis.WeeklyMA5 != 20 || analysis.WeeklyMA10 != 0 {
+		t.Errorf("unexpected weekly MA %f/%f", analysis.WeeklyMA5, analysis.WeeklyMA10)
+	}
+
+	if analysis.MonthlyMA3 != 0 {
+		t.Errorf("expect no monthly MA with two months, got %f", analysis.MonthlyMA3)
+	}
+}
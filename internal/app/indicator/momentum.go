@@ -0,0 +1,135 @@
+package indicator
+
+import (
+	"math"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+)
+
+const (
+	percent       = 100
+	neutralRSV    = 50
+	kdInitial     = 50
+	kdSmoothing   = 3
+	defaultRSI    = 14
+	defaultFast   = 12
+	defaultSlow   = 26
+	defaultSignal = 9
+	defaultKD     = 9
+)
+
+// RSI is the Wilder relative strength index of close.
+func RSI(series []*domain.DailyClose, period int) []float64 {
+	values := closes(series)
+	out := nanSlice(len(values))
+	if period <= 0 || len(values) <= period {
+		return out
+	}
+
+	gain, loss := 0.0, 0.0
+	for i := 1; i <= period; i++ {
+		diff := values[i] - values[i-1]
+		if diff > 0 {
+			gain += diff
+		} else {
+			loss -= diff
+		}
+	}
+	gain /= float64(period)
+	loss /= float64(period)
+	out[period] = rsi(gain, loss)
+
+	for i := period + 1; i < len(values); i++ {
+		diff := values[i] - values[i-1]
+		g, l := 0.0, 0.0
+		if diff > 0 {
+			g = diff
+		} else {
+			l = -diff
+		}
+		gain = (gain*float64(period-1) + g) / float64(period)
+		loss = (loss*float64(period-1) + l) / float64(period)
+		out[i] = rsi(gain, loss)
+	}
+
+	return out
+}
+
+func rsi(gain, loss float64) float64 {
+	if loss == 0 {
+		return percent
+	}
+
+	return percent - percent/(1+gain/loss)
+}
+
+type MACDResult struct {
+	MACD      []float64
+	Signal    []float64
+	Histogram []float64
+}
+
+// MACD is the difference between the fast and slow EMA of close (DIF), the
+// signal line is the EMA of MACD and the histogram (OSC) their difference.
+func MACD(series []*domain.DailyClose, fast, slow, signal int) *MACDResult {
+	values := closes(series)
+	fastEMA := ema(values, fast)
+	slowEMA := ema(values, slow)
+
+	macd := nanSlice(len(values))
+	for i := range values {
+		if !math.IsNaN(fastEMA[i]) && !math.IsNaN(slowEMA[i]) {
+			macd[i] = fastEMA[i] - slowEMA[i]
+		}
+	}
+
+	signalLine := ema(macd, signal)
+	histogram := nanSlice(len(values))
+	for i := range values {
+		if !math.IsNaN(signalLine[i]) {
+			histogram[i] = macd[i] - signalLine[i]
+		}
+	}
+
+	return &MACDResult{
+		MACD:      macd,
+		Signal:    signalLine,
+		Histogram: histogram,
+	}
+}
+
+type KDResult struct {
+	K []float64
+	D []float64
+}
+
+// KD is the stochastic oscillator as quoted by Taiwan brokers: RSV over the
+// period, K and D smoothed by 1/3 and starting from 50.
+func KD(series []*domain.DailyClose, period int) *KDResult {
+	k := nanSlice(len(series))
+	d := nanSlice(len(series))
+	if period <= 0 || len(series) < period {
+		return &KDResult{K: k, D: d}
+	}
+
+	prevK, prevD := float64(kdInitial), float64(kdInitial)
+	for i := period - 1; i < len(series); i++ {
+		highest, lowest := float64(series[i].High), float64(series[i].Low)
+		for j := i - period + 1; j < i; j++ {
+			highest = math.Max(highest, float64(series[j].High))
+			lowest = math.Min(lowest, float64(series[j].Low))
+		}
+
+		rsv := float64(neutralRSV)
+		if highest != lowest {
+			rsv = (float64(series[i].Close) - lowest) / (highest - lowest) * percent
+		}
+
+		prevK = prevK*(kdSmoothing-1)/kdSmoothing + rsv/kdSmoothing
+		prevD = prevD*(kdSmoothing-1)/kdSmoothing + prevK/kdSmoothing
+		k[i] = prevK
+		d[i] = prevD
+	}
+
+	return &KDResult{K: k, D: d}
+}
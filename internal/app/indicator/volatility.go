@@ -0,0 +1,82 @@
+package indicator
+
+import (
+	"math"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+)
+
+const (
+	defaultBollinger      = 20
+	defaultBollingerWidth = 2
+	defaultATR            = 14
+)
+
+type BollingerResult struct {
+	Upper  []float64
+	Middle []float64
+	Lower  []float64
+}
+
+// Bollinger returns the SMA of close with bands at width population standard
+// deviations.
+func Bollinger(series []*domain.DailyClose, period int, width float64) *BollingerResult {
+	values := closes(series)
+	middle := sma(values, period)
+	upper := nanSlice(len(values))
+	lower := nanSlice(len(values))
+
+	for i := range values {
+		if math.IsNaN(middle[i]) {
+			continue
+		}
+
+		variance := 0.0
+		for j := i - period + 1; j <= i; j++ {
+			variance += (values[j] - middle[i]) * (values[j] - middle[i])
+		}
+		deviation := math.Sqrt(variance / float64(period))
+
+		upper[i] = middle[i] + width*deviation
+		lower[i] = middle[i] - width*deviation
+	}
+
+	return &BollingerResult{
+		Upper:  upper,
+		Middle: middle,
+		Lower:  lower,
+	}
+}
+
+// ATR is the Wilder average true range.
+func ATR(series []*domain.DailyClose, period int) []float64 {
+	out := nanSlice(len(series))
+	if period <= 0 || len(series) < period {
+		return out
+	}
+
+	trueRange := make([]float64, len(series))
+	for i, s := range series {
+		high, low := float64(s.High), float64(s.Low)
+		trueRange[i] = high - low
+		if i > 0 {
+			prevClose := float64(series[i-1].Close)
+			trueRange[i] = math.Max(trueRange[i], math.Max(
+				math.Abs(high-prevClose),
+				math.Abs(low-prevClose),
+			))
+		}
+	}
+
+	sum := 0.0
+	for i := 0; i < period; i++ {
+		sum += trueRange[i]
+	}
+	out[period-1] = sum / float64(period)
+
+	for i := period; i < len(series); i++ {
+		out[i] = (out[i-1]*float64(period-1) + trueRange[i]) / float64(period)
+	}
+
+	return out
+}
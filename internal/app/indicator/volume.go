@@ -0,0 +1,20 @@
+package indicator
+
+import "github.com/samwang0723/jarvis/internal/app/domain"
+
+// OBV is the on-balance volume in traded shares.
+func OBV(series []*domain.DailyClose) []float64 {
+	out := make([]float64, len(series))
+
+	for i := 1; i < len(series); i++ {
+		out[i] = out[i-1]
+		switch {
+		case series[i].Close > series[i-1].Close:
+			out[i] += float64(series[i].TradedShares)
+		case series[i].Close < series[i-1].Close:
+			out[i] -= float64(series[i].TradedShares)
+		}
+	}
+
+	return out
+}
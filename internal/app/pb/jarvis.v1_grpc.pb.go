@@ -34,6 +34,30 @@ const (
 	JarvisV1_CreateTransaction_FullMethodName     = "/jarvis.v1.JarvisV1/CreateTransaction"
 	JarvisV1_CreateOrder_FullMethodName           = "/jarvis.v1.JarvisV1/CreateOrder"
 	JarvisV1_ListOrders_FullMethodName            = "/jarvis.v1.JarvisV1/ListOrders"
+	JarvisV1_CreateScreen_FullMethodName          = "/jarvis.v1.JarvisV1/CreateScreen"
+	JarvisV1_ListScreens_FullMethodName           = "/jarvis.v1.JarvisV1/ListScreens"
+	JarvisV1_RunScreen_FullMethodName             = "/jarvis.v1.JarvisV1/RunScreen"
+	JarvisV1_RunBacktest_FullMethodName           = "/jarvis.v1.JarvisV1/RunBacktest"
+	JarvisV1_ListIntradayBars_FullMethodName      = "/jarvis.v1.JarvisV1/ListIntradayBars"
+	JarvisV1_CreateAlertRule_FullMethodName       = "/jarvis.v1.JarvisV1/CreateAlertRule"
+	JarvisV1_ListAlertRules_FullMethodName        = "/jarvis.v1.JarvisV1/ListAlertRules"
+	JarvisV1_UpdateAlertRule_FullMethodName       = "/jarvis.v1.JarvisV1/UpdateAlertRule"
+	JarvisV1_DeleteAlertRule_FullMethodName       = "/jarvis.v1.JarvisV1/DeleteAlertRule"
+	JarvisV1_CancelOrder_FullMethodName           = "/jarvis.v1.JarvisV1/CancelOrder"
+	JarvisV1_AmendOrder_FullMethodName            = "/jarvis.v1.JarvisV1/AmendOrder"
+	JarvisV1_ImportOrders_FullMethodName          = "/jarvis.v1.JarvisV1/ImportOrders"
+	JarvisV1_CreateBrokerProfile_FullMethodName   = "/jarvis.v1.JarvisV1/CreateBrokerProfile"
+	JarvisV1_ListBrokerProfiles_FullMethodName    = "/jarvis.v1.JarvisV1/ListBrokerProfiles"
+	JarvisV1_SelectBrokerProfile_FullMethodName   = "/jarvis.v1.JarvisV1/SelectBrokerProfile"
+	JarvisV1_ListEntitlements_FullMethodName      = "/jarvis.v1.JarvisV1/ListEntitlements"
+	JarvisV1_SubscribeRights_FullMethodName       = "/jarvis.v1.JarvisV1/SubscribeRights"
+	JarvisV1_GetPortfolio_FullMethodName          = "/jarvis.v1.JarvisV1/GetPortfolio"
+	JarvisV1_GetPerformance_FullMethodName        = "/jarvis.v1.JarvisV1/GetPerformance"
+	JarvisV1_CreateJournalEntry_FullMethodName    = "/jarvis.v1.JarvisV1/CreateJournalEntry"
+	JarvisV1_ListJournalEntries_FullMethodName    = "/jarvis.v1.JarvisV1/ListJournalEntries"
+	JarvisV1_GetTradeStats_FullMethodName         = "/jarvis.v1.JarvisV1/GetTradeStats"
+	JarvisV1_GetAnnualReport_FullMethodName       = "/jarvis.v1.JarvisV1/GetAnnualReport"
+	JarvisV1_SubscribeQuotes_FullMethodName       = "/jarvis.v1.JarvisV1/SubscribeQuotes"
 	JarvisV1_Login_FullMethodName                 = "/jarvis.v1.JarvisV1/Login"
 	JarvisV1_Logout_FullMethodName                = "/jarvis.v1.JarvisV1/Logout"
 )
@@ -57,6 +81,43 @@ type JarvisV1Client interface {
 	CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*CreateTransactionResponse, error)
 	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderResponse, error)
 	ListOrders(ctx context.Context, in *ListOrderRequest, opts ...grpc.CallOption) (*ListOrderResponse, error)
+	CreateScreen(ctx context.Context, in *CreateScreenRequest, opts ...grpc.CallOption) (*CreateScreenResponse, error)
+	ListScreens(ctx context.Context, in *ListScreensRequest, opts ...grpc.CallOption) (*ListScreensResponse, error)
+	RunScreen(ctx context.Context, in *RunScreenRequest, opts ...grpc.CallOption) (*RunScreenResponse, error)
+	RunBacktest(ctx context.Context, in *RunBacktestRequest, opts ...grpc.CallOption) (*RunBacktestResponse, error)
+	ListIntradayBars(ctx context.Context, in *ListIntradayBarsRequest, opts ...grpc.CallOption) (*ListIntradayBarsResponse, error)
+	CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error)
+	ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error)
+	UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleRequest, opts ...grpc.CallOption) (*UpdateAlertRuleResponse, error)
+	DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error)
+	// voids a booked position with its transactions or withdraws a pending order
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	// corrects one side of a booked position and rebooks its transactions
+	AmendOrder(ctx context.Context, in *AmendOrderRequest, opts ...grpc.CallOption) (*AmendOrderResponse, error)
+	// books the fills of a broker trade confirmation CSV export, a dry run
+	// only reports which fills are new
+	ImportOrders(ctx context.Context, in *ImportOrdersRequest, opts ...grpc.CallOption) (*ImportOrdersResponse, error)
+	CreateBrokerProfile(ctx context.Context, in *CreateBrokerProfileRequest, opts ...grpc.CallOption) (*CreateBrokerProfileResponse, error)
+	ListBrokerProfiles(ctx context.Context, in *ListBrokerProfilesRequest, opts ...grpc.CallOption) (*ListBrokerProfilesResponse, error)
+	// picks the fee schedule of the user, an empty id picks the default one
+	SelectBrokerProfile(ctx context.Context, in *SelectBrokerProfileRequest, opts ...grpc.CallOption) (*SelectBrokerProfileResponse, error)
+	// the dividends, stock dividends and rights offered to the positions
+	ListEntitlements(ctx context.Context, in *ListEntitlementsRequest, opts ...grpc.CallOption) (*ListEntitlementsResponse, error)
+	// subscribes the rights an entitlement offers, paid from the balance
+	SubscribeRights(ctx context.Context, in *SubscribeRightsRequest, opts ...grpc.CallOption) (*SubscribeRightsResponse, error)
+	GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error)
+	GetPerformance(ctx context.Context, in *GetPerformanceRequest, opts ...grpc.CallOption) (*GetPerformanceResponse, error)
+	// notes why a position was taken, the setup names a strategy or a screen
+	CreateJournalEntry(ctx context.Context, in *CreateJournalEntryRequest, opts ...grpc.CallOption) (*CreateJournalEntryResponse, error)
+	ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error)
+	// win rate, average profit loss and holding period of the closed positions
+	// grouped by journal tag or setup
+	GetTradeStats(ctx context.Context, in *GetTradeStatsRequest, opts ...grpc.CallOption) (*GetTradeStatsResponse, error)
+	// fees, taxes and realized profit loss of a year by month, also served as a
+	// CSV or JSON download by the gateway at /v1/reports/annual/{year}/export
+	GetAnnualReport(ctx context.Context, in *GetAnnualReportRequest, opts ...grpc.CallOption) (*GetAnnualReportResponse, error)
+	// served over server-sent events by the gateway at /v1/quotes/stream
+	SubscribeQuotes(ctx context.Context, in *SubscribeQuotesRequest, opts ...grpc.CallOption) (JarvisV1_SubscribeQuotesClient, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
 }
@@ -219,6 +280,269 @@ func (c *jarvisV1Client) ListOrders(ctx context.Context, in *ListOrderRequest, o
 	return out, nil
 }
 
+func (c *jarvisV1Client) CreateScreen(ctx context.Context, in *CreateScreenRequest, opts ...grpc.CallOption) (*CreateScreenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateScreenResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_CreateScreen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) ListScreens(ctx context.Context, in *ListScreensRequest, opts ...grpc.CallOption) (*ListScreensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScreensResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_ListScreens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) RunScreen(ctx context.Context, in *RunScreenRequest, opts ...grpc.CallOption) (*RunScreenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunScreenResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_RunScreen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) RunBacktest(ctx context.Context, in *RunBacktestRequest, opts ...grpc.CallOption) (*RunBacktestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunBacktestResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_RunBacktest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) ListIntradayBars(ctx context.Context, in *ListIntradayBarsRequest, opts ...grpc.CallOption) (*ListIntradayBarsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIntradayBarsResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_ListIntradayBars_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAlertRuleResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_CreateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertRulesResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_ListAlertRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleRequest, opts ...grpc.CallOption) (*UpdateAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAlertRuleResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_UpdateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertRuleResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_DeleteAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_CancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) AmendOrder(ctx context.Context, in *AmendOrderRequest, opts ...grpc.CallOption) (*AmendOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AmendOrderResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_AmendOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) ImportOrders(ctx context.Context, in *ImportOrdersRequest, opts ...grpc.CallOption) (*ImportOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportOrdersResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_ImportOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) CreateBrokerProfile(ctx context.Context, in *CreateBrokerProfileRequest, opts ...grpc.CallOption) (*CreateBrokerProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBrokerProfileResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_CreateBrokerProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) ListBrokerProfiles(ctx context.Context, in *ListBrokerProfilesRequest, opts ...grpc.CallOption) (*ListBrokerProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBrokerProfilesResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_ListBrokerProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) SelectBrokerProfile(ctx context.Context, in *SelectBrokerProfileRequest, opts ...grpc.CallOption) (*SelectBrokerProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SelectBrokerProfileResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_SelectBrokerProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) ListEntitlements(ctx context.Context, in *ListEntitlementsRequest, opts ...grpc.CallOption) (*ListEntitlementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEntitlementsResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_ListEntitlements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) SubscribeRights(ctx context.Context, in *SubscribeRightsRequest, opts ...grpc.CallOption) (*SubscribeRightsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscribeRightsResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_SubscribeRights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortfolioResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_GetPortfolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) GetPerformance(ctx context.Context, in *GetPerformanceRequest, opts ...grpc.CallOption) (*GetPerformanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPerformanceResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_GetPerformance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) CreateJournalEntry(ctx context.Context, in *CreateJournalEntryRequest, opts ...grpc.CallOption) (*CreateJournalEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateJournalEntryResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_CreateJournalEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJournalEntriesResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_ListJournalEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) GetTradeStats(ctx context.Context, in *GetTradeStatsRequest, opts ...grpc.CallOption) (*GetTradeStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTradeStatsResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_GetTradeStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) GetAnnualReport(ctx context.Context, in *GetAnnualReportRequest, opts ...grpc.CallOption) (*GetAnnualReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAnnualReportResponse)
+	err := c.cc.Invoke(ctx, JarvisV1_GetAnnualReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisV1Client) SubscribeQuotes(ctx context.Context, in *SubscribeQuotesRequest, opts ...grpc.CallOption) (JarvisV1_SubscribeQuotesClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &JarvisV1_ServiceDesc.Streams[0], JarvisV1_SubscribeQuotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jarvisV1SubscribeQuotesClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JarvisV1_SubscribeQuotesClient interface {
+	Recv() (*Quote, error)
+	grpc.ClientStream
+}
+
+type jarvisV1SubscribeQuotesClient struct {
+	grpc.ClientStream
+}
+
+func (x *jarvisV1SubscribeQuotesClient) Recv() (*Quote, error) {
+	m := new(Quote)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *jarvisV1Client) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LoginResponse)
@@ -258,6 +582,43 @@ type JarvisV1Server interface {
 	CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error)
 	CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error)
 	ListOrders(context.Context, *ListOrderRequest) (*ListOrderResponse, error)
+	CreateScreen(context.Context, *CreateScreenRequest) (*CreateScreenResponse, error)
+	ListScreens(context.Context, *ListScreensRequest) (*ListScreensResponse, error)
+	RunScreen(context.Context, *RunScreenRequest) (*RunScreenResponse, error)
+	RunBacktest(context.Context, *RunBacktestRequest) (*RunBacktestResponse, error)
+	ListIntradayBars(context.Context, *ListIntradayBarsRequest) (*ListIntradayBarsResponse, error)
+	CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error)
+	ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error)
+	UpdateAlertRule(context.Context, *UpdateAlertRuleRequest) (*UpdateAlertRuleResponse, error)
+	DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error)
+	// voids a booked position with its transactions or withdraws a pending order
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	// corrects one side of a booked position and rebooks its transactions
+	AmendOrder(context.Context, *AmendOrderRequest) (*AmendOrderResponse, error)
+	// books the fills of a broker trade confirmation CSV export, a dry run
+	// only reports which fills are new
+	ImportOrders(context.Context, *ImportOrdersRequest) (*ImportOrdersResponse, error)
+	CreateBrokerProfile(context.Context, *CreateBrokerProfileRequest) (*CreateBrokerProfileResponse, error)
+	ListBrokerProfiles(context.Context, *ListBrokerProfilesRequest) (*ListBrokerProfilesResponse, error)
+	// picks the fee schedule of the user, an empty id picks the default one
+	SelectBrokerProfile(context.Context, *SelectBrokerProfileRequest) (*SelectBrokerProfileResponse, error)
+	// the dividends, stock dividends and rights offered to the positions
+	ListEntitlements(context.Context, *ListEntitlementsRequest) (*ListEntitlementsResponse, error)
+	// subscribes the rights an entitlement offers, paid from the balance
+	SubscribeRights(context.Context, *SubscribeRightsRequest) (*SubscribeRightsResponse, error)
+	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
+	GetPerformance(context.Context, *GetPerformanceRequest) (*GetPerformanceResponse, error)
+	// notes why a position was taken, the setup names a strategy or a screen
+	CreateJournalEntry(context.Context, *CreateJournalEntryRequest) (*CreateJournalEntryResponse, error)
+	ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error)
+	// win rate, average profit loss and holding period of the closed positions
+	// grouped by journal tag or setup
+	GetTradeStats(context.Context, *GetTradeStatsRequest) (*GetTradeStatsResponse, error)
+	// fees, taxes and realized profit loss of a year by month, also served as a
+	// CSV or JSON download by the gateway at /v1/reports/annual/{year}/export
+	GetAnnualReport(context.Context, *GetAnnualReportRequest) (*GetAnnualReportResponse, error)
+	// served over server-sent events by the gateway at /v1/quotes/stream
+	SubscribeQuotes(*SubscribeQuotesRequest, JarvisV1_SubscribeQuotesServer) error
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
 }
@@ -311,6 +672,78 @@ func (UnimplementedJarvisV1Server) CreateOrder(context.Context, *CreateOrderRequ
 func (UnimplementedJarvisV1Server) ListOrders(context.Context, *ListOrderRequest) (*ListOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
 }
+func (UnimplementedJarvisV1Server) CreateScreen(context.Context, *CreateScreenRequest) (*CreateScreenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScreen not implemented")
+}
+func (UnimplementedJarvisV1Server) ListScreens(context.Context, *ListScreensRequest) (*ListScreensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScreens not implemented")
+}
+func (UnimplementedJarvisV1Server) RunScreen(context.Context, *RunScreenRequest) (*RunScreenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunScreen not implemented")
+}
+func (UnimplementedJarvisV1Server) RunBacktest(context.Context, *RunBacktestRequest) (*RunBacktestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunBacktest not implemented")
+}
+func (UnimplementedJarvisV1Server) ListIntradayBars(context.Context, *ListIntradayBarsRequest) (*ListIntradayBarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIntradayBars not implemented")
+}
+func (UnimplementedJarvisV1Server) CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedJarvisV1Server) ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertRules not implemented")
+}
+func (UnimplementedJarvisV1Server) UpdateAlertRule(context.Context, *UpdateAlertRuleRequest) (*UpdateAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlertRule not implemented")
+}
+func (UnimplementedJarvisV1Server) DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedJarvisV1Server) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedJarvisV1Server) AmendOrder(context.Context, *AmendOrderRequest) (*AmendOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendOrder not implemented")
+}
+func (UnimplementedJarvisV1Server) ImportOrders(context.Context, *ImportOrdersRequest) (*ImportOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportOrders not implemented")
+}
+func (UnimplementedJarvisV1Server) CreateBrokerProfile(context.Context, *CreateBrokerProfileRequest) (*CreateBrokerProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBrokerProfile not implemented")
+}
+func (UnimplementedJarvisV1Server) ListBrokerProfiles(context.Context, *ListBrokerProfilesRequest) (*ListBrokerProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBrokerProfiles not implemented")
+}
+func (UnimplementedJarvisV1Server) SelectBrokerProfile(context.Context, *SelectBrokerProfileRequest) (*SelectBrokerProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectBrokerProfile not implemented")
+}
+func (UnimplementedJarvisV1Server) ListEntitlements(context.Context, *ListEntitlementsRequest) (*ListEntitlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntitlements not implemented")
+}
+func (UnimplementedJarvisV1Server) SubscribeRights(context.Context, *SubscribeRightsRequest) (*SubscribeRightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeRights not implemented")
+}
+func (UnimplementedJarvisV1Server) GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
+}
+func (UnimplementedJarvisV1Server) GetPerformance(context.Context, *GetPerformanceRequest) (*GetPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPerformance not implemented")
+}
+func (UnimplementedJarvisV1Server) CreateJournalEntry(context.Context, *CreateJournalEntryRequest) (*CreateJournalEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJournalEntry not implemented")
+}
+func (UnimplementedJarvisV1Server) ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJournalEntries not implemented")
+}
+func (UnimplementedJarvisV1Server) GetTradeStats(context.Context, *GetTradeStatsRequest) (*GetTradeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTradeStats not implemented")
+}
+func (UnimplementedJarvisV1Server) GetAnnualReport(context.Context, *GetAnnualReportRequest) (*GetAnnualReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnnualReport not implemented")
+}
+func (UnimplementedJarvisV1Server) SubscribeQuotes(*SubscribeQuotesRequest, JarvisV1_SubscribeQuotesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeQuotes not implemented")
+}
 func (UnimplementedJarvisV1Server) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
@@ -599,85 +1032,520 @@ func _JarvisV1_ListOrders_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JarvisV1_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _JarvisV1_CreateScreen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScreenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JarvisV1Server).Login(ctx, in)
+		return srv.(JarvisV1Server).CreateScreen(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: JarvisV1_Login_FullMethodName,
+		FullMethod: JarvisV1_CreateScreen_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JarvisV1Server).Login(ctx, req.(*LoginRequest))
+		return srv.(JarvisV1Server).CreateScreen(ctx, req.(*CreateScreenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JarvisV1_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogoutRequest)
+func _JarvisV1_ListScreens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScreensRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JarvisV1Server).Logout(ctx, in)
+		return srv.(JarvisV1Server).ListScreens(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: JarvisV1_Logout_FullMethodName,
+		FullMethod: JarvisV1_ListScreens_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JarvisV1Server).Logout(ctx, req.(*LogoutRequest))
+		return srv.(JarvisV1Server).ListScreens(ctx, req.(*ListScreensRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// JarvisV1_ServiceDesc is the grpc.ServiceDesc for JarvisV1 service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var JarvisV1_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "jarvis.v1.JarvisV1",
-	HandlerType: (*JarvisV1Server)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListDailyClose",
-			Handler:    _JarvisV1_ListDailyClose_Handler,
-		},
-		{
-			MethodName: "ListStocks",
-			Handler:    _JarvisV1_ListStocks_Handler,
-		},
-		{
-			MethodName: "ListCategories",
-			Handler:    _JarvisV1_ListCategories_Handler,
-		},
-		{
-			MethodName: "GetStakeConcentration",
-			Handler:    _JarvisV1_GetStakeConcentration_Handler,
-		},
-		{
-			MethodName: "ListThreePrimary",
-			Handler:    _JarvisV1_ListThreePrimary_Handler,
-		},
-		{
-			MethodName: "ListSelections",
-			Handler:    _JarvisV1_ListSelections_Handler,
-		},
-		{
-			MethodName: "ListPickedStocks",
-			Handler:    _JarvisV1_ListPickedStocks_Handler,
-		},
-		{
-			MethodName: "InsertPickedStocks",
-			Handler:    _JarvisV1_InsertPickedStocks_Handler,
-		},
-		{
-			MethodName: "DeletePickedStocks",
-			Handler:    _JarvisV1_DeletePickedStocks_Handler,
-		},
+func _JarvisV1_RunScreen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunScreenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).RunScreen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_RunScreen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).RunScreen(ctx, req.(*RunScreenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_RunBacktest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunBacktestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).RunBacktest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_RunBacktest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).RunBacktest(ctx, req.(*RunBacktestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_ListIntradayBars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIntradayBarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).ListIntradayBars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_ListIntradayBars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).ListIntradayBars(ctx, req.(*ListIntradayBarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).CreateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_CreateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).CreateAlertRule(ctx, req.(*CreateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_ListAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).ListAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_ListAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).ListAlertRules(ctx, req.(*ListAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_UpdateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).UpdateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_UpdateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).UpdateAlertRule(ctx, req.(*UpdateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).DeleteAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_DeleteAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).DeleteAlertRule(ctx, req.(*DeleteAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_AmendOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmendOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).AmendOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_AmendOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).AmendOrder(ctx, req.(*AmendOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_ImportOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).ImportOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_ImportOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).ImportOrders(ctx, req.(*ImportOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_CreateBrokerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBrokerProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).CreateBrokerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_CreateBrokerProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).CreateBrokerProfile(ctx, req.(*CreateBrokerProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_ListBrokerProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBrokerProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).ListBrokerProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_ListBrokerProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).ListBrokerProfiles(ctx, req.(*ListBrokerProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_SelectBrokerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectBrokerProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).SelectBrokerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_SelectBrokerProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).SelectBrokerProfile(ctx, req.(*SelectBrokerProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_ListEntitlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntitlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).ListEntitlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_ListEntitlements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).ListEntitlements(ctx, req.(*ListEntitlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_SubscribeRights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeRightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).SubscribeRights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_SubscribeRights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).SubscribeRights(ctx, req.(*SubscribeRightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).GetPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_GetPortfolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_GetPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).GetPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_GetPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).GetPerformance(ctx, req.(*GetPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_CreateJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).CreateJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_CreateJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).CreateJournalEntry(ctx, req.(*CreateJournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_ListJournalEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJournalEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).ListJournalEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_ListJournalEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).ListJournalEntries(ctx, req.(*ListJournalEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_GetTradeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).GetTradeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_GetTradeStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).GetTradeStats(ctx, req.(*GetTradeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_GetAnnualReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnnualReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).GetAnnualReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_GetAnnualReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).GetAnnualReport(ctx, req.(*GetAnnualReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_SubscribeQuotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeQuotesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JarvisV1Server).SubscribeQuotes(m, &jarvisV1SubscribeQuotesServer{ServerStream: stream})
+}
+
+type JarvisV1_SubscribeQuotesServer interface {
+	Send(*Quote) error
+	grpc.ServerStream
+}
+
+type jarvisV1SubscribeQuotesServer struct {
+	grpc.ServerStream
+}
+
+func (x *jarvisV1SubscribeQuotesServer) Send(m *Quote) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JarvisV1_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisV1_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisV1Server).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisV1_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisV1Server).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JarvisV1_ServiceDesc is the grpc.ServiceDesc for JarvisV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JarvisV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "jarvis.v1.JarvisV1",
+	HandlerType: (*JarvisV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDailyClose",
+			Handler:    _JarvisV1_ListDailyClose_Handler,
+		},
+		{
+			MethodName: "ListStocks",
+			Handler:    _JarvisV1_ListStocks_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _JarvisV1_ListCategories_Handler,
+		},
+		{
+			MethodName: "GetStakeConcentration",
+			Handler:    _JarvisV1_GetStakeConcentration_Handler,
+		},
+		{
+			MethodName: "ListThreePrimary",
+			Handler:    _JarvisV1_ListThreePrimary_Handler,
+		},
+		{
+			MethodName: "ListSelections",
+			Handler:    _JarvisV1_ListSelections_Handler,
+		},
+		{
+			MethodName: "ListPickedStocks",
+			Handler:    _JarvisV1_ListPickedStocks_Handler,
+		},
+		{
+			MethodName: "InsertPickedStocks",
+			Handler:    _JarvisV1_InsertPickedStocks_Handler,
+		},
+		{
+			MethodName: "DeletePickedStocks",
+			Handler:    _JarvisV1_DeletePickedStocks_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _JarvisV1_CreateUser_Handler,
@@ -702,6 +1570,98 @@ var JarvisV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListOrders",
 			Handler:    _JarvisV1_ListOrders_Handler,
 		},
+		{
+			MethodName: "CreateScreen",
+			Handler:    _JarvisV1_CreateScreen_Handler,
+		},
+		{
+			MethodName: "ListScreens",
+			Handler:    _JarvisV1_ListScreens_Handler,
+		},
+		{
+			MethodName: "RunScreen",
+			Handler:    _JarvisV1_RunScreen_Handler,
+		},
+		{
+			MethodName: "RunBacktest",
+			Handler:    _JarvisV1_RunBacktest_Handler,
+		},
+		{
+			MethodName: "ListIntradayBars",
+			Handler:    _JarvisV1_ListIntradayBars_Handler,
+		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _JarvisV1_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlertRules",
+			Handler:    _JarvisV1_ListAlertRules_Handler,
+		},
+		{
+			MethodName: "UpdateAlertRule",
+			Handler:    _JarvisV1_UpdateAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _JarvisV1_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _JarvisV1_CancelOrder_Handler,
+		},
+		{
+			MethodName: "AmendOrder",
+			Handler:    _JarvisV1_AmendOrder_Handler,
+		},
+		{
+			MethodName: "ImportOrders",
+			Handler:    _JarvisV1_ImportOrders_Handler,
+		},
+		{
+			MethodName: "CreateBrokerProfile",
+			Handler:    _JarvisV1_CreateBrokerProfile_Handler,
+		},
+		{
+			MethodName: "ListBrokerProfiles",
+			Handler:    _JarvisV1_ListBrokerProfiles_Handler,
+		},
+		{
+			MethodName: "SelectBrokerProfile",
+			Handler:    _JarvisV1_SelectBrokerProfile_Handler,
+		},
+		{
+			MethodName: "ListEntitlements",
+			Handler:    _JarvisV1_ListEntitlements_Handler,
+		},
+		{
+			MethodName: "SubscribeRights",
+			Handler:    _JarvisV1_SubscribeRights_Handler,
+		},
+		{
+			MethodName: "GetPortfolio",
+			Handler:    _JarvisV1_GetPortfolio_Handler,
+		},
+		{
+			MethodName: "GetPerformance",
+			Handler:    _JarvisV1_GetPerformance_Handler,
+		},
+		{
+			MethodName: "CreateJournalEntry",
+			Handler:    _JarvisV1_CreateJournalEntry_Handler,
+		},
+		{
+			MethodName: "ListJournalEntries",
+			Handler:    _JarvisV1_ListJournalEntries_Handler,
+		},
+		{
+			MethodName: "GetTradeStats",
+			Handler:    _JarvisV1_GetTradeStats_Handler,
+		},
+		{
+			MethodName: "GetAnnualReport",
+			Handler:    _JarvisV1_GetAnnualReport_Handler,
+		},
 		{
 			MethodName: "Login",
 			Handler:    _JarvisV1_Login_Handler,
@@ -711,6 +1671,12 @@ var JarvisV1_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _JarvisV1_Logout_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeQuotes",
+			Handler:       _JarvisV1_SubscribeQuotes_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "jarvis.v1.proto",
 }
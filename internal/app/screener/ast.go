@@ -0,0 +1,120 @@
+package screener
+
+type node interface {
+	eval(env Env) float64
+	isBool() bool
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+type numberNode struct {
+	value float64
+}
+
+func (n *numberNode) eval(_ Env) float64 { return n.value }
+func (n *numberNode) isBool() bool       { return false }
+
+type fieldNode struct {
+	name string
+}
+
+func (n *fieldNode) eval(env Env) float64 { return env.Field(n.name) }
+func (n *fieldNode) isBool() bool         { return false }
+
+type callNode struct {
+	name   string
+	period int
+}
+
+func (n *callNode) eval(env Env) float64 { return env.Call(n.name, n.period) }
+func (n *callNode) isBool() bool         { return false }
+
+type negateNode struct {
+	operand node
+}
+
+func (n *negateNode) eval(env Env) float64 { return -n.operand.eval(env) }
+func (n *negateNode) isBool() bool         { return false }
+
+type arithmeticNode struct {
+	left  node
+	right node
+	op    tokenKind
+}
+
+func (n *arithmeticNode) eval(env Env) float64 {
+	left, right := n.left.eval(env), n.right.eval(env)
+
+	switch n.op {
+	case tokenPlus:
+		return left + right
+	case tokenMinus:
+		return left - right
+	case tokenMul:
+		return left * right
+	default:
+		if right == 0 {
+			return 0
+		}
+
+		return left / right
+	}
+}
+
+func (n *arithmeticNode) isBool() bool { return false }
+
+type compareNode struct {
+	left  node
+	right node
+	op    tokenKind
+}
+
+func (n *compareNode) eval(env Env) float64 {
+	left, right := n.left.eval(env), n.right.eval(env)
+
+	switch n.op {
+	case tokenGT:
+		return boolToFloat(left > right)
+	case tokenGTE:
+		return boolToFloat(left >= right)
+	case tokenLT:
+		return boolToFloat(left < right)
+	case tokenLTE:
+		return boolToFloat(left <= right)
+	case tokenEQ:
+		return boolToFloat(left == right)
+	default:
+		return boolToFloat(left != right)
+	}
+}
+
+func (n *compareNode) isBool() bool { return true }
+
+type logicalNode struct {
+	left  node
+	right node
+	op    tokenKind
+}
+
+func (n *logicalNode) eval(env Env) float64 {
+	if n.op == tokenAnd {
+		return boolToFloat(n.left.eval(env) != 0 && n.right.eval(env) != 0)
+	}
+
+	return boolToFloat(n.left.eval(env) != 0 || n.right.eval(env) != 0)
+}
+
+func (n *logicalNode) isBool() bool { return true }
+
+type notNode struct {
+	operand node
+}
+
+func (n *notNode) eval(env Env) float64 { return boolToFloat(n.operand.eval(env) == 0) }
+func (n *notNode) isBool() bool         { return true }
@@ -0,0 +1,124 @@
+package screener
+
+import "github.com/samwang0723/jarvis/internal/app/domain"
+
+const (
+	FieldHighest  = "highest"
+	FuncMA        = "ma"
+	FuncMV        = "mv"
+	FuncWeeklyMA  = "weekly_ma"
+	FuncMonthlyMA = "monthly_ma"
+)
+
+// Env resolves the fields and indicator functions referenced by a screen.
+type Env interface {
+	Field(name string) float64
+	Call(name string, period int) float64
+}
+
+type fieldGetter func(s *SelectionEnv) float64
+
+//nolint:nolintlint, gochecknoglobals
+var fields = map[string]fieldGetter{
+	"open":            func(s *SelectionEnv) float64 { return float64(s.Selection.Open) },
+	"high":            func(s *SelectionEnv) float64 { return float64(s.Selection.High) },
+	"low":             func(s *SelectionEnv) float64 { return float64(s.Selection.Low) },
+	"close":           func(s *SelectionEnv) float64 { return float64(s.Selection.Close) },
+	"pricediff":       func(s *SelectionEnv) float64 { return float64(s.Selection.PriceDiff) },
+	"volume":          func(s *SelectionEnv) float64 { return float64(s.Selection.Volume) },
+	"concentration1":  func(s *SelectionEnv) float64 { return float64(s.Selection.Concentration1) },
+	"concentration5":  func(s *SelectionEnv) float64 { return float64(s.Selection.Concentration5) },
+	"concentration10": func(s *SelectionEnv) float64 { return float64(s.Selection.Concentration10) },
+	"concentration20": func(s *SelectionEnv) float64 { return float64(s.Selection.Concentration20) },
+	"concentration60": func(s *SelectionEnv) float64 { return float64(s.Selection.Concentration60) },
+	"trust":           func(s *SelectionEnv) float64 { return float64(s.Selection.Trust) },
+	"foreign":         func(s *SelectionEnv) float64 { return float64(s.Selection.Foreign) },
+	"hedging":         func(s *SelectionEnv) float64 { return float64(s.Selection.Hedging) },
+	"dealer":          func(s *SelectionEnv) float64 { return float64(s.Selection.Dealer) },
+	"trust10":         func(s *SelectionEnv) float64 { return float64(s.Analysis.Trust) },
+	"foreign10":       func(s *SelectionEnv) float64 { return float64(s.Analysis.Foreign) },
+	"lastclose":       func(s *SelectionEnv) float64 { return float64(s.Analysis.LastClose) },
+	"ema12":           func(s *SelectionEnv) float64 { return float64(s.Analysis.EMA12) },
+	"ema26":           func(s *SelectionEnv) float64 { return float64(s.Analysis.EMA26) },
+	"rsi14":           func(s *SelectionEnv) float64 { return float64(s.Analysis.RSI14) },
+	"macd":            func(s *SelectionEnv) float64 { return float64(s.Analysis.MACD) },
+	"macdsignal":      func(s *SelectionEnv) float64 { return float64(s.Analysis.MACDSignal) },
+	"macdhist":        func(s *SelectionEnv) float64 { return float64(s.Analysis.MACDHistogram) },
+	"bbupper":         func(s *SelectionEnv) float64 { return float64(s.Analysis.BollingerUpper) },
+	"bbmiddle":        func(s *SelectionEnv) float64 { return float64(s.Analysis.BollingerMiddle) },
+	"bblower":         func(s *SelectionEnv) float64 { return float64(s.Analysis.BollingerLower) },
+	"k9":              func(s *SelectionEnv) float64 { return float64(s.Analysis.K9) },
+	"d9":              func(s *SelectionEnv) float64 { return float64(s.Analysis.D9) },
+	"atr14":           func(s *SelectionEnv) float64 { return float64(s.Analysis.ATR14) },
+	"obv":             func(s *SelectionEnv) float64 { return float64(s.Analysis.OBV) },
+	FieldHighest:      func(s *SelectionEnv) float64 { return float64(s.Highest) },
+}
+
+// functions lists the periods computed by the analysis engine.
+//
+//nolint:nolintlint, gochecknoglobals
+var functions = map[string]map[int]struct{}{
+	FuncMA: {8: {}, 21: {}, 55: {}},
+	FuncMV: {5: {}, 13: {}, 34: {}},
+	// resampled from the daily history, see domain.Resample
+	FuncWeeklyMA:  {5: {}, 10: {}, 20: {}},
+	FuncMonthlyMA: {3: {}, 5: {}},
+}
+
+// SelectionEnv exposes a selection and its analysis to a screen.
+type SelectionEnv struct {
+	Selection *domain.Selection
+	Analysis  *domain.Analysis
+	Highest   float32
+}
+
+func (s *SelectionEnv) Field(name string) float64 {
+	getter, ok := fields[name]
+	if !ok {
+		return 0
+	}
+
+	return getter(s)
+}
+
+//nolint:nolintlint, gomnd
+func (s *SelectionEnv) Call(name string, period int) float64 {
+	switch name {
+	case FuncMA:
+		switch period {
+		case 8:
+			return float64(s.Analysis.MA8)
+		case 21:
+			return float64(s.Analysis.MA21)
+		case 55:
+			return float64(s.Analysis.MA55)
+		}
+	case FuncMV:
+		switch period {
+		case 5:
+			return float64(s.Analysis.MV5)
+		case 13:
+			return float64(s.Analysis.MV13)
+		case 34:
+			return float64(s.Analysis.MV34)
+		}
+	case FuncWeeklyMA:
+		switch period {
+		case 5:
+			return float64(s.Analysis.WeeklyMA5)
+		case 10:
+			return float64(s.Analysis.WeeklyMA10)
+		case 20:
+			return float64(s.Analysis.WeeklyMA20)
+		}
+	case FuncMonthlyMA:
+		switch period {
+		case 3:
+			return float64(s.Analysis.MonthlyMA3)
+		case 5:
+			return float64(s.Analysis.MonthlyMA5)
+		}
+	}
+
+	return 0
+}
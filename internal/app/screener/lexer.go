@@ -0,0 +1,130 @@
+package screener
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+	tokenPlus
+	tokenMinus
+	tokenMul
+	tokenDiv
+	tokenGT
+	tokenGTE
+	tokenLT
+	tokenLTE
+	tokenEQ
+	tokenNEQ
+)
+
+type token struct {
+	text string
+	kind tokenKind
+	pos  int
+}
+
+//nolint:nolintlint, cyclop
+func tokenize(input string) ([]token, error) {
+	tokens := []token{}
+	runes := []rune(input)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: string(runes[start:i]), pos: start})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) &&
+				(unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			text := strings.ToLower(string(runes[start:i]))
+			tokens = append(tokens, token{kind: keywordKind(text), text: text, pos: start})
+		default:
+			kind, size, err := symbolKind(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: kind, text: string(runes[i : i+size]), pos: i})
+			i += size
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+func keywordKind(text string) tokenKind {
+	switch text {
+	case "and":
+		return tokenAnd
+	case "or":
+		return tokenOr
+	case "not":
+		return tokenNot
+	default:
+		return tokenIdent
+	}
+}
+
+//nolint:nolintlint, cyclop
+func symbolKind(runes []rune, i int) (tokenKind, int, error) {
+	next := rune(0)
+	if i+1 < len(runes) {
+		next = runes[i+1]
+	}
+
+	switch runes[i] {
+	case '(':
+		return tokenLParen, 1, nil
+	case ')':
+		return tokenRParen, 1, nil
+	case '+':
+		return tokenPlus, 1, nil
+	case '-':
+		return tokenMinus, 1, nil
+	case '*':
+		return tokenMul, 1, nil
+	case '/':
+		return tokenDiv, 1, nil
+	case '>':
+		if next == '=' {
+			return tokenGTE, 2, nil
+		}
+		return tokenGT, 1, nil
+	case '<':
+		if next == '=' {
+			return tokenLTE, 2, nil
+		}
+		return tokenLT, 1, nil
+	case '=':
+		if next == '=' {
+			return tokenEQ, 2, nil
+		}
+		return tokenEQ, 1, nil
+	case '!':
+		if next == '=' {
+			return tokenNEQ, 2, nil
+		}
+	}
+
+	return tokenEOF, 0, fmt.Errorf("%w: unexpected %q at %d", ErrSyntax, runes[i], i)
+}
@@ -0,0 +1,293 @@
+package screener
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var (
+	ErrSyntax          = errors.New("screen syntax error")
+	ErrUnknownField    = errors.New("unknown screen field")
+	ErrUnknownFunction = errors.New("unknown screen function")
+	ErrInvalidPeriod   = errors.New("unsupported indicator period")
+	ErrNotCondition    = errors.New("screen expression must be a condition")
+)
+
+// Expression is a compiled screen ready to be evaluated against an Env.
+type Expression struct {
+	root       node
+	source     string
+	references map[string]struct{}
+}
+
+// Compile parses the screen source and validates the referenced fields and
+// indicator functions.
+func Compile(source string) (*Expression, error) {
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, references: make(map[string]struct{})}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, fmt.Errorf("%w: unexpected %q at %d", ErrSyntax, tok.text, tok.pos)
+	}
+
+	if !root.isBool() {
+		return nil, ErrNotCondition
+	}
+
+	return &Expression{root: root, source: source, references: p.references}, nil
+}
+
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Uses reports whether the expression references the field or function.
+func (e *Expression) Uses(name string) bool {
+	_, ok := e.references[name]
+	return ok
+}
+
+func (e *Expression) Match(env Env) bool {
+	return e.root.eval(env) != 0
+}
+
+type parser struct {
+	references map[string]struct{}
+	tokens     []token
+	pos        int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+
+	return tok
+}
+
+func (p *parser) expect(kind tokenKind, text string) (token, error) {
+	tok := p.next()
+	if tok.kind != kind {
+		return tok, fmt.Errorf("%w: expected %s at %d", ErrSyntax, text, tok.pos)
+	}
+
+	return tok, nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenOr {
+		tok := p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		if !left.isBool() || !right.isBool() {
+			return nil, fmt.Errorf("%w: 'or' expects conditions at %d", ErrSyntax, tok.pos)
+		}
+		left = &logicalNode{op: tokenOr, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenAnd {
+		tok := p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		if !left.isBool() || !right.isBool() {
+			return nil, fmt.Errorf("%w: 'and' expects conditions at %d", ErrSyntax, tok.pos)
+		}
+		left = &logicalNode{op: tokenAnd, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseNot() (node, error) {
+	if p.peek().kind != tokenNot {
+		return p.parseComparison()
+	}
+
+	tok := p.next()
+	operand, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	if !operand.isBool() {
+		return nil, fmt.Errorf("%w: 'not' expects a condition at %d", ErrSyntax, tok.pos)
+	}
+
+	return &notNode{operand: operand}, nil
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+
+	switch p.peek().kind {
+	case tokenGT, tokenGTE, tokenLT, tokenLTE, tokenEQ, tokenNEQ:
+		tok := p.next()
+		right, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if left.isBool() || right.isBool() {
+			return nil, fmt.Errorf("%w: %q expects numbers at %d", ErrSyntax, tok.text, tok.pos)
+		}
+
+		return &compareNode{op: tok.kind, left: left, right: right}, nil
+	default:
+		return left, nil
+	}
+}
+
+func (p *parser) parseSum() (node, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenPlus || p.peek().kind == tokenMinus {
+		tok := p.next()
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		if left.isBool() || right.isBool() {
+			return nil, fmt.Errorf("%w: %q expects numbers at %d", ErrSyntax, tok.text, tok.pos)
+		}
+		left = &arithmeticNode{op: tok.kind, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseTerm() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenMul || p.peek().kind == tokenDiv {
+		tok := p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if left.isBool() || right.isBool() {
+			return nil, fmt.Errorf("%w: %q expects numbers at %d", ErrSyntax, tok.text, tok.pos)
+		}
+		left = &arithmeticNode{op: tok.kind, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.peek().kind != tokenMinus {
+		return p.parsePrimary()
+	}
+
+	tok := p.next()
+	operand, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	if operand.isBool() {
+		return nil, fmt.Errorf("%w: '-' expects a number at %d", ErrSyntax, tok.pos)
+	}
+
+	return &negateNode{operand: operand}, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case tokenNumber:
+		value, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid number %q at %d", ErrSyntax, tok.text, tok.pos)
+		}
+
+		return &numberNode{value: value}, nil
+	case tokenIdent:
+		if p.peek().kind == tokenLParen {
+			return p.parseCall(tok)
+		}
+		if _, ok := fields[tok.text]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownField, tok.text)
+		}
+		p.references[tok.text] = struct{}{}
+
+		return &fieldNode{name: tok.text}, nil
+	case tokenLParen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRParen, "')'"); err != nil {
+			return nil, err
+		}
+
+		return inner, nil
+	default:
+		return nil, fmt.Errorf("%w: unexpected %q at %d", ErrSyntax, tok.text, tok.pos)
+	}
+}
+
+func (p *parser) parseCall(name token) (node, error) {
+	periods, ok := functions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFunction, name.text)
+	}
+
+	p.next() // consume '('
+	arg, err := p.expect(tokenNumber, "period")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenRParen, "')'"); err != nil {
+		return nil, err
+	}
+
+	period, err := strconv.Atoi(arg.text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s(%s)", ErrInvalidPeriod, name.text, arg.text)
+	}
+	if _, ok := periods[period]; !ok {
+		return nil, fmt.Errorf("%w: %s(%d)", ErrInvalidPeriod, name.text, period)
+	}
+	p.references[name.text] = struct{}{}
+
+	return &callNode{name: name.text, period: period}, nil
+}
@@ -0,0 +1,164 @@
+package screener
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"go.uber.org/goleak"
+)
+
+func TestMain(m *testing.M) {
+	leak := flag.Bool("leak", false, "use leak detector")
+
+	if *leak {
+		goleak.VerifyTestMain(m)
+
+		return
+	}
+
+	os.Exit(m.Run())
+}
+
+func testEnv() *SelectionEnv {
+	return &SelectionEnv{
+		Selection: &domain.Selection{
+			StockID:         "2330",
+			Close:           100,
+			High:            101,
+			Concentration20: 6.5,
+		},
+		Analysis: &domain.Analysis{
+			MA8:        98,
+			MA21:       95,
+			MA55:       90,
+			MV5:        3000,
+			MV13:       2500,
+			MV34:       2000,
+			Trust:      120,
+			RSI14:      25,
+			WeeklyMA10: 92,
+			MonthlyMA3: 85,
+			K9:         30,
+			D9:         20,
+		},
+		Highest: 102,
+	}
+}
+
+func TestCompileAndMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		source string
+		want   bool
+	}{
+		{
+			name:   "combined screen",
+			source: "close > ma(21) and mv(5) > mv(34) and concentration20 > 5 and trust10 > 0",
+			want:   true,
+		},
+		{
+			name:   "or with failing left side",
+			source: "close < ma(55) or close >= highest * 0.96",
+			want:   true,
+		},
+		{
+			name:   "not and parentheses",
+			source: "not (close > ma(8) and mv(5) < mv(13))",
+			want:   true,
+		},
+		{
+			name:   "arithmetic",
+			source: "(close - ma(21)) / ma(21) * 100 <= 5.3",
+			want:   true,
+		},
+		{
+			name:   "negative number",
+			source: "trust10 > -1 and foreign10 == 0",
+			want:   true,
+		},
+		{
+			name:   "technical indicators",
+			source: "rsi14 < 30 and k9 > d9",
+			want:   true,
+		},
+		{
+			name:   "multi timeframe",
+			source: "close > weekly_ma(10) and weekly_ma(10) > monthly_ma(3)",
+			want:   true,
+		},
+		{
+			name:   "failing screen",
+			source: "CLOSE > MA(21) AND concentration20 > 10",
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := Compile(tt.source)
+			if err != nil {
+				t.Fatalf("compile %q: %v", tt.source, err)
+			}
+
+			if got := expr.Match(testEnv()); got != tt.want {
+				t.Errorf("expect %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		want   error
+		name   string
+		source string
+	}{
+		{name: "unknown field", source: "price > 1", want: ErrUnknownField},
+		{name: "unknown function", source: "ema(21) > 1", want: ErrUnknownFunction},
+		{name: "unsupported period", source: "ma(20) > 1", want: ErrInvalidPeriod},
+		{name: "unsupported weekly period", source: "weekly_ma(8) > 1", want: ErrInvalidPeriod},
+		{name: "not a condition", source: "close + 1", want: ErrNotCondition},
+		{name: "dangling operator", source: "close >", want: ErrSyntax},
+		{name: "unbalanced parentheses", source: "(close > 1", want: ErrSyntax},
+		{name: "illegal character", source: "close > 1 & trust > 0", want: ErrSyntax},
+		{name: "function with several arguments", source: "ma(21, 55) > 1", want: ErrSyntax},
+		{name: "logical on numbers", source: "close and trust", want: ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Compile(tt.source)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expect %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestUses(t *testing.T) {
+	t.Parallel()
+
+	expr, err := Compile("close > highest * 0.96 and mv(5) > 1000")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !expr.Uses(FieldHighest) || !expr.Uses(FuncMV) || expr.Uses(FuncMA) {
+		t.Errorf("unexpected references %v", expr.references)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/samwang0723/jarvis/internal/app/dto"
 	pb "github.com/samwang0723/jarvis/internal/app/pb"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -218,3 +219,304 @@ func (s *server) Logout(ctx context.Context, _ *pb.LogoutRequest) (*pb.LogoutRes
 
 	return dto.LogoutResponseToPB(res), nil
 }
+
+func (s *server) CreateScreen(
+	ctx context.Context,
+	req *pb.CreateScreenRequest,
+) (*pb.CreateScreenResponse, error) {
+	res, err := s.Handler().CreateScreen(ctx, dto.CreateScreenRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.CreateScreenResponseToPB(res), nil
+}
+
+func (s *server) ListScreens(
+	ctx context.Context,
+	_ *pb.ListScreensRequest,
+) (*pb.ListScreensResponse, error) {
+	res, err := s.Handler().ListScreens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ListScreensResponseToPB(res), nil
+}
+
+func (s *server) RunScreen(
+	ctx context.Context,
+	req *pb.RunScreenRequest,
+) (*pb.RunScreenResponse, error) {
+	res, err := s.Handler().RunScreen(ctx, dto.RunScreenRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.RunScreenResponseToPB(res), nil
+}
+
+func (s *server) RunBacktest(
+	ctx context.Context,
+	req *pb.RunBacktestRequest,
+) (*pb.RunBacktestResponse, error) {
+	res, err := s.Handler().RunBacktest(ctx, dto.RunBacktestRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.RunBacktestResponseToPB(res), nil
+}
+
+func (s *server) ListIntradayBars(
+	ctx context.Context,
+	req *pb.ListIntradayBarsRequest,
+) (*pb.ListIntradayBarsResponse, error) {
+	res, err := s.Handler().ListIntradayBars(ctx, dto.ListIntradayBarsRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ListIntradayBarsResponseToPB(res), nil
+}
+
+func (s *server) SubscribeQuotes(
+	req *pb.SubscribeQuotesRequest,
+	stream pb.JarvisV1_SubscribeQuotesServer,
+) error {
+	ctx := stream.Context()
+
+	quotes, err := s.Handler().SubscribeQuotes(ctx, dto.SubscribeQuotesRequestFromPB(req))
+	if err != nil {
+		return err
+	}
+
+	// flush the headers so bridges know the subscription is accepted
+	if err = stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for quote := range quotes {
+		if err = stream.Send(dto.QuoteToPB(quote)); err != nil {
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
+
+func (s *server) CreateAlertRule(
+	ctx context.Context,
+	req *pb.CreateAlertRuleRequest,
+) (*pb.CreateAlertRuleResponse, error) {
+	res, err := s.Handler().CreateAlertRule(ctx, dto.CreateAlertRuleRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.CreateAlertRuleResponseToPB(res), nil
+}
+
+func (s *server) ListAlertRules(
+	ctx context.Context,
+	_ *pb.ListAlertRulesRequest,
+) (*pb.ListAlertRulesResponse, error) {
+	res, err := s.Handler().ListAlertRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ListAlertRulesResponseToPB(res), nil
+}
+
+func (s *server) UpdateAlertRule(
+	ctx context.Context,
+	req *pb.UpdateAlertRuleRequest,
+) (*pb.UpdateAlertRuleResponse, error) {
+	res, err := s.Handler().UpdateAlertRule(ctx, dto.UpdateAlertRuleRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.UpdateAlertRuleResponseToPB(res), nil
+}
+
+func (s *server) DeleteAlertRule(
+	ctx context.Context,
+	req *pb.DeleteAlertRuleRequest,
+) (*pb.DeleteAlertRuleResponse, error) {
+	res, err := s.Handler().DeleteAlertRule(ctx, dto.DeleteAlertRuleRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.DeleteAlertRuleResponseToPB(res), nil
+}
+
+func (s *server) CancelOrder(
+	ctx context.Context,
+	req *pb.CancelOrderRequest,
+) (*pb.CancelOrderResponse, error) {
+	res, err := s.Handler().CancelOrder(ctx, dto.CancelOrderRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.CancelOrderResponseToPB(res), nil
+}
+
+func (s *server) AmendOrder(
+	ctx context.Context,
+	req *pb.AmendOrderRequest,
+) (*pb.AmendOrderResponse, error) {
+	res, err := s.Handler().AmendOrder(ctx, dto.AmendOrderRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.AmendOrderResponseToPB(res), nil
+}
+
+func (s *server) CreateBrokerProfile(
+	ctx context.Context,
+	req *pb.CreateBrokerProfileRequest,
+) (*pb.CreateBrokerProfileResponse, error) {
+	res, err := s.Handler().CreateBrokerProfile(ctx, dto.CreateBrokerProfileRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.CreateBrokerProfileResponseToPB(res), nil
+}
+
+func (s *server) ListBrokerProfiles(
+	ctx context.Context,
+	_ *pb.ListBrokerProfilesRequest,
+) (*pb.ListBrokerProfilesResponse, error) {
+	res, err := s.Handler().ListBrokerProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ListBrokerProfilesResponseToPB(res), nil
+}
+
+func (s *server) SelectBrokerProfile(
+	ctx context.Context,
+	req *pb.SelectBrokerProfileRequest,
+) (*pb.SelectBrokerProfileResponse, error) {
+	res, err := s.Handler().SelectBrokerProfile(ctx, dto.SelectBrokerProfileRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.SelectBrokerProfileResponseToPB(res), nil
+}
+
+func (s *server) ListEntitlements(
+	ctx context.Context,
+	_ *pb.ListEntitlementsRequest,
+) (*pb.ListEntitlementsResponse, error) {
+	res, err := s.Handler().ListEntitlements(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ListEntitlementsResponseToPB(res), nil
+}
+
+func (s *server) SubscribeRights(
+	ctx context.Context,
+	req *pb.SubscribeRightsRequest,
+) (*pb.SubscribeRightsResponse, error) {
+	res, err := s.Handler().SubscribeRights(ctx, dto.SubscribeRightsRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.SubscribeRightsResponseToPB(res), nil
+}
+
+func (s *server) GetPortfolio(
+	ctx context.Context,
+	_ *pb.GetPortfolioRequest,
+) (*pb.GetPortfolioResponse, error) {
+	res, err := s.Handler().GetPortfolio(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.GetPortfolioResponseToPB(res), nil
+}
+
+func (s *server) GetPerformance(
+	ctx context.Context,
+	req *pb.GetPerformanceRequest,
+) (*pb.GetPerformanceResponse, error) {
+	res, err := s.Handler().GetPerformance(ctx, dto.GetPerformanceRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.GetPerformanceResponseToPB(res), nil
+}
+
+func (s *server) ImportOrders(
+	ctx context.Context,
+	req *pb.ImportOrdersRequest,
+) (*pb.ImportOrdersResponse, error) {
+	res, err := s.Handler().ImportOrders(ctx, dto.ImportOrdersRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ImportOrdersResponseToPB(res), nil
+}
+
+func (s *server) CreateJournalEntry(
+	ctx context.Context,
+	req *pb.CreateJournalEntryRequest,
+) (*pb.CreateJournalEntryResponse, error) {
+	res, err := s.Handler().CreateJournalEntry(ctx, dto.CreateJournalEntryRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.CreateJournalEntryResponseToPB(res), nil
+}
+
+func (s *server) ListJournalEntries(
+	ctx context.Context,
+	req *pb.ListJournalEntriesRequest,
+) (*pb.ListJournalEntriesResponse, error) {
+	res, err := s.Handler().ListJournalEntries(ctx, dto.ListJournalEntriesRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ListJournalEntriesResponseToPB(res), nil
+}
+
+func (s *server) GetTradeStats(
+	ctx context.Context,
+	req *pb.GetTradeStatsRequest,
+) (*pb.GetTradeStatsResponse, error) {
+	res, err := s.Handler().GetTradeStats(ctx, dto.GetTradeStatsRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.GetTradeStatsResponseToPB(res), nil
+}
+
+func (s *server) GetAnnualReport(
+	ctx context.Context,
+	req *pb.GetAnnualReportRequest,
+) (*pb.GetAnnualReportResponse, error) {
+	res, err := s.Handler().GetAnnualReport(ctx, dto.GetAnnualReportRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.GetAnnualReportResponseToPB(res), nil
+}
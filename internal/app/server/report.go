@@ -0,0 +1,112 @@
+package server
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	pb "github.com/samwang0723/jarvis/internal/app/pb"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	reportFormatCSV  = "csv"
+	reportFormatJSON = "json"
+)
+
+var reportHeader = []string{
+	"period", "deposit", "withdraw", "buy", "sell", "fee", "tax", "realized_profit_loss", "closed_orders",
+}
+
+// annualReportExport serves the GetAnnualReport response as a file download,
+// the format query parameter picks csv (default) or json.
+func (s *server) annualReportExport(client pb.JarvisV1Client) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		format := r.URL.Query().Get("format")
+		if format == "" {
+			format = reportFormatCSV
+		}
+
+		if format != reportFormatCSV && format != reportFormatJSON {
+			http.Error(w, fmt.Sprintf("unsupported report format: %s", format), http.StatusBadRequest)
+
+			return
+		}
+
+		year, err := strconv.ParseInt(params["year"], 10, 32)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid report year: %s", params["year"]), http.StatusBadRequest)
+
+			return
+		}
+
+		res, err := client.GetAnnualReport(authorizedContext(r), &pb.GetAnnualReportRequest{Year: int32(year)})
+		if err != nil {
+			st := status.Convert(err)
+			http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+
+			return
+		}
+
+		w.Header().Set("Content-Disposition",
+			fmt.Sprintf("attachment; filename=\"annual-report-%d.%s\"", year, format))
+
+		if format == reportFormatJSON {
+			data, err := protojson.Marshal(res.GetReport())
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(data)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		if err := writeAnnualReportCSV(w, res.GetReport()); err != nil {
+			s.Logger().Error().Err(err).Msg("cannot write annual report")
+		}
+	}
+}
+
+// writeAnnualReportCSV writes a row per month followed by the year total.
+func writeAnnualReportCSV(w io.Writer, report *pb.AnnualReport) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(reportHeader); err != nil {
+		return err
+	}
+
+	periods := make([]*pb.ReportPeriod, 0, len(report.GetMonths())+1)
+	periods = append(periods, report.GetMonths()...)
+	for _, period := range append(periods, report.GetTotal()) {
+		err := writer.Write([]string{
+			period.GetPeriod(),
+			formatAmount(period.GetDeposit()),
+			formatAmount(period.GetWithdraw()),
+			formatAmount(period.GetBuy()),
+			formatAmount(period.GetSell()),
+			formatAmount(period.GetFee()),
+			formatAmount(period.GetTax()),
+			formatAmount(period.GetRealizedProfitLoss()),
+			strconv.Itoa(int(period.GetClosedOrders())),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+func formatAmount(amount float32) string {
+	return strconv.FormatFloat(float64(amount), 'f', -1, 32)
+}
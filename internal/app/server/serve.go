@@ -36,12 +36,16 @@ import (
 	config "github.com/samwang0723/jarvis/configs"
 	"github.com/samwang0723/jarvis/internal/app/adapter"
 	"github.com/samwang0723/jarvis/internal/app/adapter/sqlc"
+	"github.com/samwang0723/jarvis/internal/app/domain"
 	"github.com/samwang0723/jarvis/internal/app/handlers"
 	"github.com/samwang0723/jarvis/internal/app/middleware"
 	pb "github.com/samwang0723/jarvis/internal/app/pb"
 	gatewaypb "github.com/samwang0723/jarvis/internal/app/pb/gateway"
 	"github.com/samwang0723/jarvis/internal/app/services"
 	"github.com/samwang0723/jarvis/internal/db/pginit"
+	"github.com/samwang0723/jarvis/internal/kafka"
+	"github.com/samwang0723/jarvis/internal/kafka/ikafka"
+	"github.com/samwang0723/jarvis/internal/notifier"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -101,7 +105,7 @@ func Serve(cfg *config.Config, logger *zerolog.Logger) {
 		logger.Fatal().Err(err).Msg("unable to create connection pool")
 	}
 
-	repo := sqlc.NewSqlcRepository(pool, logger)
+	repo := sqlc.NewSqlcRepository(pool, logger, sqlc.WithReadModels())
 	adapter := adapter.NewAdapterImp(repo)
 
 	// Common service options
@@ -111,6 +115,8 @@ func Serve(cfg *config.Config, logger *zerolog.Logger) {
 	}
 
 	// Conditionally add the WithKafka option if the environment is not local
+	var producer ikafka.IProducer
+	var outboxRelay *kafka.OutboxRelay
 	if cfg.Kafka.GroupID != "" {
 		options = append(options, services.WithKafka(services.KafkaConfig{
 			GroupID: cfg.Kafka.GroupID,
@@ -118,6 +124,13 @@ func Serve(cfg *config.Config, logger *zerolog.Logger) {
 			Topics:  cfg.Kafka.Topics,
 			Logger:  logger,
 		}))
+
+		// publish the domain events of the outbox
+		producer = kafka.NewProducer(kafka.ProducerConfig{
+			Brokers: cfg.Kafka.Brokers,
+			Logger:  logger,
+		}, nil)
+		outboxRelay = kafka.NewOutboxRelay(kafka.OutboxRelayConfig{Logger: logger}, adapter, producer)
 	}
 
 	if cfg.RedisCache.Master != "" {
@@ -151,6 +164,25 @@ func Serve(cfg *config.Config, logger *zerolog.Logger) {
 		options = append(options, services.WithProxy(proxy))
 	}
 
+	// replay recorded quotes instead of crawling the exchanges
+	if cfg.Quote.ReplayFile != "" {
+		provider, rErr := services.NewReplayQuoteProvider(cfg.Quote.ReplayFile)
+		if rErr != nil {
+			logger.Fatal().Err(rErr).Msg("failed to load quote replay")
+		}
+		options = append(options, services.WithQuoteProvider(provider))
+	}
+
+	if cfg.SMTP.Host != "" {
+		options = append(options, services.WithNotifier(domain.AlertChannelEmail, notifier.NewSMTP(notifier.SMTPConfig{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			From:     cfg.SMTP.From,
+		})))
+	}
+
 	// bind DAL layer with service
 	dataService := services.New(options...)
 	// associate service with handler
@@ -198,9 +230,21 @@ func Serve(cfg *config.Config, logger *zerolog.Logger) {
 			return nil
 		}),
 		AfterStart(func(ctx context.Context) error {
+			// project the recorded events to the read models
+			go repo.RunProjections(logger.WithContext(ctx))
+
 			if cfg.Kafka.GroupID != "" {
 				// listening kafka
 				handler.ListeningKafkaInput(ctx)
+
+				go outboxRelay.Run(ctx)
+			}
+
+			if cfg.RedisCache.Master != "" {
+				// relay realtime quotes to the subscribed streams
+				if err := handler.ListeningRealtimeQuotes(ctx); err != nil {
+					return fmt.Errorf("listening realtime quotes: %w", err)
+				}
 			}
 
 			return nil
@@ -219,6 +263,11 @@ func Serve(cfg *config.Config, logger *zerolog.Logger) {
 				if err != nil {
 					return fmt.Errorf("StopKafka error: %w", err)
 				}
+
+				err = producer.Close()
+				if err != nil {
+					return fmt.Errorf("close producer error: %w", err)
+				}
 			}
 			defer pool.Close()
 
@@ -269,6 +318,21 @@ func (s *server) Start(ctx context.Context) error {
 		}
 	}
 
+	// credit the monthly broker fee rebates of the previous month
+	if err := s.Handler().SettleFeeRebates(ctx, "0 6 1 * *"); err != nil {
+		s.Logger().Error().Err(err).Msg("SettleFeeRebates error")
+	}
+
+	// pay the dividends and rights issues of the corporate actions due today
+	if err := s.Handler().DistributeCorporateActions(ctx, "0 7 * * *"); err != nil {
+		s.Logger().Error().Err(err).Msg("DistributeCorporateActions error")
+	}
+
+	// snapshot the equity of every account after the market closes
+	if err := s.Handler().SnapshotEquity(ctx, "30 14 * * 1-5"); err != nil {
+		s.Logger().Error().Err(err).Msg("SnapshotEquity error")
+	}
+
 	// start gRPC server
 	cfg := config.GetCurrentConfig()
 	addr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.GrpcPort)
@@ -408,6 +472,31 @@ func (s *server) startGRPCGateway(ctx context.Context, addr string) {
 		return
 	}
 
+	// streaming quotes and report downloads are served through a gRPC client
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		s.Logger().Error().Err(err).Msg("cannot create quote stream client")
+
+		return
+	}
+	defer conn.Close()
+
+	client := pb.NewJarvisV1Client(conn)
+
+	err = mux.HandlePath("GET", "/v1/quotes/stream", s.quoteEventStream(client))
+	if err != nil {
+		s.Logger().Error().Err(err).Msg("cannot handle /v1/quotes/stream path")
+
+		return
+	}
+
+	err = mux.HandlePath("GET", "/v1/reports/annual/{year}/export", s.annualReportExport(client))
+	if err != nil {
+		s.Logger().Error().Err(err).Msg("cannot handle /v1/reports/annual/{year}/export path")
+
+		return
+	}
+
 	httpMux := http.NewServeMux()
 	// merge grpc gateway endpoint handling
 	httpMux.Handle("/", mux)
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	pb "github.com/samwang0723/jarvis/internal/app/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const sseKeepAlive = 30 * time.Second
+
+// quoteEventStream bridges the SubscribeQuotes gRPC stream to server-sent
+// events. Browsers' EventSource cannot set headers, so the bearer token is
+// also accepted from the access_token query parameter.
+//
+//nolint:nolintlint, cyclop
+func (s *server) quoteEventStream(client pb.JarvisV1Client) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx := authorizedContext(r)
+
+		var stockIDs []string
+		for _, v := range r.URL.Query()["stockIDs"] {
+			stockIDs = append(stockIDs, strings.Split(v, ",")...)
+		}
+
+		stream, err := client.SubscribeQuotes(ctx, &pb.SubscribeQuotesRequest{StockIDs: stockIDs})
+		if err == nil {
+			// resolves once the subscription is accepted or rejected
+			_, err = stream.Header()
+		}
+
+		if err != nil {
+			st := status.Convert(err)
+			http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+
+			return
+		}
+
+		rc := http.NewResponseController(w)
+		// the stream outlives the server write timeout
+		if err = rc.SetWriteDeadline(time.Time{}); err != nil {
+			s.Logger().Warn().Err(err).Msg("cannot clear write deadline for quote stream")
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		_ = rc.Flush()
+
+		quotes := make(chan *pb.Quote)
+		errs := make(chan error, 1)
+
+		go func() {
+			defer close(quotes)
+
+			for {
+				quote, err := stream.Recv()
+				if err != nil {
+					errs <- err
+
+					return
+				}
+
+				select {
+				case quotes <- quote:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		ticker := time.NewTicker(sseKeepAlive)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_, err = io.WriteString(w, ": keep-alive\n\n")
+			case quote, ok := <-quotes:
+				if !ok {
+					if err := <-errs; !errors.Is(err, io.EOF) && status.Code(err) != codes.Canceled {
+						fmt.Fprintf(w, "event: error\ndata: %s\n\n", status.Convert(err).Message())
+						_ = rc.Flush()
+					}
+
+					return
+				}
+
+				data, merr := protojson.Marshal(quote)
+				if merr != nil {
+					s.Logger().Error().Err(merr).Msg("cannot marshal quote")
+
+					continue
+				}
+
+				_, err = fmt.Fprintf(w, "event: quote\ndata: %s\n\n", data)
+			}
+
+			if err != nil {
+				return
+			}
+
+			_ = rc.Flush()
+		}
+	}
+}
+
+// authorizedContext forwards the bearer token of the request to the gRPC
+// server, falling back to the access_token query parameter.
+func authorizedContext(r *http.Request) context.Context {
+	token := r.Header.Get("Authorization")
+	if token == "" {
+		if t := r.URL.Query().Get("access_token"); t != "" {
+			token = "Bearer " + t
+		}
+	}
+
+	ctx := r.Context()
+	if token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", token)
+	}
+
+	return ctx
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/notifier"
+)
+
+func (s *serviceImpl) CreateAlertRule(ctx context.Context, obj *domain.AlertRule) error {
+	if err := obj.Validate(); err != nil {
+		return err
+	}
+
+	obj.UserID = s.currentUserID
+
+	return s.dal.CreateAlertRule(ctx, obj)
+}
+
+func (s *serviceImpl) ListAlertRules(ctx context.Context) ([]*domain.AlertRule, error) {
+	return s.dal.ListAlertRules(ctx, s.currentUserID)
+}
+
+// UpdateAlertRule replaces the rule definition and re-arms it for today.
+func (s *serviceImpl) UpdateAlertRule(ctx context.Context, obj *domain.AlertRule) error {
+	if err := obj.Validate(); err != nil {
+		return err
+	}
+
+	obj.UserID = s.currentUserID
+
+	return s.dal.UpdateAlertRule(ctx, obj)
+}
+
+func (s *serviceImpl) DeleteAlertRule(ctx context.Context, id string) error {
+	ruleID, err := uuid.FromString(id)
+	if err != nil {
+		return err
+	}
+
+	return s.dal.DeleteAlertRule(ctx, s.currentUserID, ruleID)
+}
+
+// evaluateAlerts fires the rules of the quoted stock that have not fired on
+// the exchange date yet. A rule is only marked once its notification is
+// delivered, so a failed delivery is retried with the next quote.
+func (s *serviceImpl) evaluateAlerts(ctx context.Context, quote *domain.Realtime) error {
+	rules, err := s.dal.ListPendingAlertRules(ctx, quote.StockID, quote.Date)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		var orders []*domain.Order
+		if rule.Condition == domain.AlertProfitablePrice {
+			orders, err = s.openPositions(ctx, rule.UserID, rule.StockID)
+			if err != nil {
+				s.logger.Error().Err(err).Msgf("failed to load open orders of alert: %s", rule.ID.ID)
+				continue
+			}
+		}
+
+		text, fired := rule.Evaluate(quote, orders)
+		if !fired {
+			continue
+		}
+
+		n, ok := s.notifiers[rule.Channel]
+		if !ok {
+			s.logger.Warn().Msgf("no notifier for alert channel: %s", rule.Channel)
+			continue
+		}
+
+		msg := &notifier.Message{
+			Subject: fmt.Sprintf("[jarvis] %s %s alert", quote.StockID, quote.Name),
+			Body:    fmt.Sprintf("%s at %s %s", text, quote.Date, quote.ParseTime),
+		}
+		if err = n.Notify(ctx, rule.Target, msg); err != nil {
+			s.logger.Error().Err(err).Msgf("failed to notify alert: %s", rule.ID.ID)
+			continue
+		}
+
+		if err = s.dal.MarkAlertRuleTriggered(ctx, rule.ID.ID, quote.Date); err != nil {
+			s.logger.Error().Err(err).Msgf("failed to mark alert triggered: %s", rule.ID.ID)
+		}
+	}
+
+	return nil
+}
+
+// openPositions returns the orders still holding long or short quantity.
+func (s *serviceImpl) openPositions(
+	ctx context.Context,
+	userID uuid.UUID,
+	stockID string,
+) ([]*domain.Order, error) {
+	longs, err := s.dal.ListOpenOrders(ctx, userID, stockID, domain.OrderTypeSell)
+	if err != nil {
+		return nil, err
+	}
+
+	shorts, err := s.dal.ListOpenOrders(ctx, userID, stockID, domain.OrderTypeBuy)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(longs, shorts...), nil
+}
@@ -2,19 +2,22 @@ package services
 
 import (
 	"context"
-	"math"
+	"slices"
 	"sort"
 	"sync"
 
 	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/indicator"
 	"github.com/samwang0723/jarvis/internal/helper"
 )
 
 const (
 	minDailyVolume           = 3000000
 	minWeeklyVolume          = 1000000
+	minConcentrationDays     = 4
 	highestRangePercent      = 0.04
 	dailyHighestRangePercent = 0.96
+	closeToHighestToday      = 0.985
 	yesterday                = 1
 	yesterdayAfterClosed     = 2
 	priceMA8                 = 8
@@ -30,17 +33,32 @@ const (
 func (s *serviceImpl) executeAnalysisEngine(
 	ctx context.Context,
 	objs []*domain.Selection,
+	strategy *ScreeningStrategy,
 	strict bool,
+	adjusted bool,
 	opts ...string,
 ) ([]*domain.Selection, error) {
 	selectionMap, stockIDs := mapWithIDs(objs)
-	pList, tList, highestPriceMap, err := s.aggregateStockStat(ctx, stockIDs, objs, opts...)
+	pList, tList, highestPriceMap, err := s.aggregateStockStat(
+		ctx,
+		stockIDs,
+		objs,
+		strategy,
+		adjusted,
+		opts...,
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	analysisMap := mapMAToConcentration(pList, tList, len(stockIDs), opts...)
-	output := filterByCoreLogic(selectionMap, highestPriceMap, analysisMap, strict, opts...)
+	output := filterByCoreLogic(
+		selectionMap,
+		highestPriceMap,
+		analysisMap,
+		strategy,
+		strict,
+	)
 	sort.Slice(output, func(i, j int) bool {
 		return output[i].StockID < output[j].StockID
 	})
@@ -62,8 +80,16 @@ func (s *serviceImpl) aggregateStockStat(
 	ctx context.Context,
 	stockIDs []string,
 	objs []*domain.Selection,
+	strategy *ScreeningStrategy,
+	adjusted bool,
 	opts ...string,
 ) ([]*domain.DailyClose, []*domain.ThreePrimary, map[string]float32, error) {
+	withHighest := strategy.Requires(IndicatorHighestPrice)
+	// the adjusted highest price is derived from the price history
+	withHistory := strategy.Requires(IndicatorPriceMA) || strategy.Requires(IndicatorVolumeMV) ||
+		(withHighest && adjusted)
+	withThreePrimary := strategy.Requires(IndicatorThreePrimary)
+
 	var wg sync.WaitGroup
 	wg.Add(3)
 
@@ -75,6 +101,10 @@ func (s *serviceImpl) aggregateStockStat(
 
 	go func() {
 		defer wg.Done()
+		if !withHistory {
+			return
+		}
+
 		var err error
 		pList, err = s.dal.RetrieveDailyCloseHistory(ctx, stockIDs, opts...)
 		if err != nil {
@@ -84,6 +114,10 @@ func (s *serviceImpl) aggregateStockStat(
 
 	go func() {
 		defer wg.Done()
+		if !withThreePrimary {
+			return
+		}
+
 		var err error
 		tList, err = s.dal.RetrieveThreePrimaryHistory(ctx, stockIDs, opts...)
 		if err != nil {
@@ -93,7 +127,8 @@ func (s *serviceImpl) aggregateStockStat(
 
 	go func() {
 		defer wg.Done()
-		if len(objs) > 0 {
+		// adjusted highest price is derived from the back-adjusted history
+		if withHighest && !adjusted && len(objs) > 0 {
 			var err error
 			highestPriceMap, err = s.dal.GetHighestPrice(
 				ctx,
@@ -117,9 +152,38 @@ func (s *serviceImpl) aggregateStockStat(
 		}
 	}
 
+	if adjusted && len(objs) > 0 {
+		var err error
+		date := objs[0].ExchangeDate
+		pList, err = s.backAdjust(ctx, pList, date)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		if withHighest {
+			highestPriceMap = highestPriceBefore(pList, helper.RewindDate(date, rewindWeek))
+		}
+	}
+
 	return pList, tList, highestPriceMap, nil
 }
 
+// highestPriceBefore mirrors GetHighestPrice on an in-memory series.
+func highestPriceBefore(pList []*domain.DailyClose, endDate string) map[string]float32 {
+	highestPriceMap := make(map[string]float32)
+	for _, p := range pList {
+		if endDate != "" && p.ExchangeDate >= endDate {
+			continue
+		}
+
+		if p.High > highestPriceMap[p.StockID] {
+			highestPriceMap[p.StockID] = p.High
+		}
+	}
+
+	return highestPriceMap
+}
+
 func mapMAToConcentration(
 	pList []*domain.DailyClose,
 	tList []*domain.ThreePrimary,
@@ -167,6 +231,16 @@ func mapMAToConcentration(
 		}
 	}
 
+	// fulfill technical indicators, series are ordered by exchange date descending
+	seriesMap := make(map[string][]*domain.DailyClose, size)
+	for _, p := range pList {
+		seriesMap[p.StockID] = append(seriesMap[p.StockID], p)
+	}
+	for stockID, series := range seriesMap {
+		slices.Reverse(series)
+		indicator.Apply(analysisMap[stockID], series)
+	}
+
 	// fulfill concentration data
 	currentStockID := ""
 	currentIdx = 0
@@ -184,6 +258,15 @@ func mapMAToConcentration(
 		currentTrustSum += t.TrustTradeShares
 		currentForeignSum += t.ForeignTradeShares
 
+		// stocks without a price history are only analyzed when it is not loaded
+		if _, ok := analysisMap[currentStockID]; !ok {
+			if len(pList) > 0 {
+				continue
+			}
+
+			analysisMap[currentStockID] = &domain.Analysis{}
+		}
+
 		if currentIdx == threePrimarySumCount {
 			analysisMap[currentStockID].Trust = currentTrustSum
 			analysisMap[currentStockID].Foreign = currentForeignSum
@@ -197,41 +280,30 @@ func filterByCoreLogic(
 	source map[string]*domain.Selection,
 	highestPriceMap map[string]float32,
 	analysisMap map[string]*domain.Analysis,
+	strategy *ScreeningStrategy,
 	strict bool,
-	opts ...string,
 ) []*domain.Selection {
 	output := []*domain.Selection{}
+	// without a price history the moving averages are unknown
+	withHistory := strategy.Requires(IndicatorPriceMA) || strategy.Requires(IndicatorVolumeMV)
+
+	for k, ref := range source {
+		v, ok := analysisMap[k]
+		if !ok {
+			if withHistory {
+				continue
+			}
 
-	for k, v := range analysisMap {
-		ref := source[k]
-		selected := false
-
-		// if today's realtime value and not within max high range, skip
-		if len(opts) > 0 && float64(ref.Close/ref.High) < dailyHighestRangePercent {
-			continue
-		}
-
-		// checking half-year high is closed enough
-		// checking volume is above weekly volume (3000)
-		// checking MA8, MA21, MA55 is below today's close
-		if math.Abs(1.0-float64(ref.Close/highestPriceMap[ref.StockID])) <= highestRangePercent &&
-			v.MV5 >= minWeeklyVolume &&
-			ref.Close > v.MA8 &&
-			ref.Close > v.MA21 &&
-			ref.Close > v.MA55 {
-			selected = true
+			v = &domain.Analysis{}
 		}
 
-		selectedStrict := false
-		if strict &&
-			v.MV5 > v.MV13 &&
-			v.MV13 > v.MV34 &&
-			v.MA8 > v.MA21 &&
-			v.MA21 > v.MA55 {
-			selectedStrict = true
+		input := &StrategyInput{
+			Selection: ref,
+			Analysis:  v,
+			Highest:   highestPriceMap[ref.StockID],
 		}
-
-		if (selected && !strict) || (selected && selectedStrict) {
+		if strategy.Match(input, strict) {
+			ref.Analysis = v
 			ref.Trust10 = int(v.Trust)
 			ref.Foreign10 = int(v.Foreign)
 			ref.QuoteChange = helper.RoundDecimalTwo(
@@ -0,0 +1,308 @@
+package services
+
+import (
+	"context"
+	"sort"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+)
+
+const (
+	defaultBacktestHoldingDays = 5
+	defaultBacktestQuantity    = 1
+	defaultBacktestCapital     = 1000000
+	percentage                 = 100
+)
+
+type backtestPosition struct {
+	stockID    string
+	entryDate  string
+	entryPrice float32
+	lastClose  float32
+	heldDays   int32
+}
+
+// RunBacktest replays the historical selection strategy day by day. Every
+// pick enters at the selection day's close and is evaluated from the next
+// exchange day on: stop-loss first, then take-profit, then the holding period.
+// Picks are only entered while the cash left covers their cost and fee, so
+// overlapping positions never use more than the initial capital.
+//
+//nolint:nolintlint, cyclop
+func (s *serviceImpl) RunBacktest(
+	ctx context.Context,
+	req *dto.RunBacktestRequest,
+) (*domain.BacktestReport, error) {
+	if req.StartDate == "" || req.EndDate == "" || req.StartDate > req.EndDate {
+		return nil, errInvalidBacktestRange
+	}
+
+	strategy, err := s.strategies.Get(req.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := backtestConfig(req)
+
+	broker, err := s.dal.GetUserBrokerProfile(ctx, s.currentUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	dates, err := s.dal.ListExchangeDates(ctx, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	picks, stockIDs, err := s.replaySelections(
+		ctx,
+		dates,
+		strategy.Name,
+		req.Strict,
+		req.Adjusted,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	bars, err := s.backtestBars(ctx, stockIDs, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.BacktestReport{
+		StartDate:      req.StartDate,
+		EndDate:        req.EndDate,
+		Strategy:       strategy.Name,
+		InitialCapital: cfg.InitialCapital,
+		Trades:         []*domain.BacktestTrade{},
+		EquityCurve:    make([]*domain.EquityPoint, 0, len(dates)),
+	}
+
+	positions := make(map[string]*backtestPosition)
+	shares := float32(cfg.Quantity) * domain.BoardLotShares
+	cash := cfg.InitialCapital
+
+	for _, date := range dates {
+		for _, id := range sortedPositionIDs(positions) {
+			pos := positions[id]
+			bar, ok := bars[id][date]
+			if !ok {
+				// suspended or no trading on that day
+				continue
+			}
+
+			pos.heldDays++
+			pos.lastClose = bar.Close
+
+			price, reason, exit := backtestExit(cfg, pos, bar)
+			if !exit {
+				continue
+			}
+
+			trade := closeBacktestPosition(pos, date, price, reason, cfg.Quantity, broker)
+			revenue := price * shares
+			cash += revenue - broker.NetFee(revenue, domain.LotTypeBoard) - trade.Tax
+			report.Trades = append(report.Trades, trade)
+			delete(positions, id)
+		}
+
+		for _, pick := range picks[date] {
+			if _, held := positions[pick.StockID]; held {
+				continue
+			}
+
+			cost := pick.Close * shares
+			cost += broker.NetFee(cost, domain.LotTypeBoard)
+			if cost > cash {
+				// not enough cash left to open the position
+				continue
+			}
+			cash -= cost
+
+			positions[pick.StockID] = &backtestPosition{
+				stockID:    pick.StockID,
+				entryDate:  date,
+				entryPrice: pick.Close,
+				lastClose:  pick.Close,
+			}
+		}
+
+		equity := cash
+		for _, pos := range positions {
+			equity += pos.lastClose * shares
+		}
+
+		report.EquityCurve = append(report.EquityCurve, &domain.EquityPoint{
+			Date:   date,
+			Equity: equity,
+		})
+	}
+
+	if len(dates) > 0 {
+		last := dates[len(dates)-1]
+		for _, id := range sortedPositionIDs(positions) {
+			pos := positions[id]
+			report.Trades = append(report.Trades,
+				closeBacktestPosition(pos, last, pos.lastClose, domain.ExitReasonEnd, cfg.Quantity, broker))
+		}
+	}
+
+	report.Summarize()
+
+	return report, nil
+}
+
+func backtestConfig(req *dto.RunBacktestRequest) *dto.RunBacktestRequest {
+	cfg := *req
+	if cfg.HoldingDays <= 0 {
+		cfg.HoldingDays = defaultBacktestHoldingDays
+	}
+
+	if cfg.Quantity == 0 {
+		cfg.Quantity = defaultBacktestQuantity
+	}
+
+	if cfg.InitialCapital <= 0 {
+		cfg.InitialCapital = defaultBacktestCapital
+	}
+
+	return &cfg
+}
+
+// replaySelections collects the picks of every exchange date and the set of
+// stocks that need price history.
+func (s *serviceImpl) replaySelections(
+	ctx context.Context,
+	dates []string,
+	strategy string,
+	strict bool,
+	adjusted bool,
+) (map[string][]*domain.Selection, []string, error) {
+	historical := &HistoricalSelectionStrategy{service: s}
+	picks := make(map[string][]*domain.Selection, len(dates))
+	stocks := make(map[string]struct{})
+
+	for _, date := range dates {
+		objs, err := historical.ListSelections(ctx, &dto.ListSelectionRequest{
+			Date:     date,
+			Strict:   strict,
+			Strategy: strategy,
+			Adjusted: adjusted,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		picks[date] = objs
+		for _, obj := range objs {
+			stocks[obj.StockID] = struct{}{}
+		}
+	}
+
+	stockIDs := make([]string, 0, len(stocks))
+	for id := range stocks {
+		stockIDs = append(stockIDs, id)
+	}
+	sort.Strings(stockIDs)
+
+	return picks, stockIDs, nil
+}
+
+func (s *serviceImpl) backtestBars(
+	ctx context.Context,
+	stockIDs []string,
+	startDate, endDate string,
+) (map[string]map[string]*domain.DailyClose, error) {
+	bars := make(map[string]map[string]*domain.DailyClose, len(stockIDs))
+	if len(stockIDs) == 0 {
+		return bars, nil
+	}
+
+	closes, err := s.dal.RetrieveDailyCloseBetween(ctx, stockIDs, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range closes {
+		if _, ok := bars[c.StockID]; !ok {
+			bars[c.StockID] = make(map[string]*domain.DailyClose)
+		}
+		bars[c.StockID][c.ExchangeDate] = c
+	}
+
+	return bars, nil
+}
+
+// backtestExit decides whether a position leaves the market on the given bar.
+// Gaps through the stop or target are filled at the open price.
+func backtestExit(
+	cfg *dto.RunBacktestRequest,
+	pos *backtestPosition,
+	bar *domain.DailyClose,
+) (float32, string, bool) {
+	if cfg.StopLoss > 0 {
+		stop := pos.entryPrice * (1 - cfg.StopLoss/percentage)
+		if bar.Low <= stop {
+			return min(bar.Open, stop), domain.ExitReasonStopLoss, true
+		}
+	}
+
+	if cfg.TakeProfit > 0 {
+		target := pos.entryPrice * (1 + cfg.TakeProfit/percentage)
+		if bar.High >= target {
+			return max(bar.Open, target), domain.ExitReasonTakeProfit, true
+		}
+	}
+
+	if pos.heldDays >= cfg.HoldingDays {
+		return bar.Close, domain.ExitReasonHolding, true
+	}
+
+	return 0, "", false
+}
+
+func closeBacktestPosition(
+	pos *backtestPosition,
+	exitDate string,
+	exitPrice float32,
+	reason string,
+	quantity uint64,
+	broker *domain.BrokerProfile,
+) *domain.BacktestTrade {
+	shares := float32(quantity) * domain.BoardLotShares
+	cost := pos.entryPrice * shares
+	revenue := exitPrice * shares
+	fee := broker.NetFee(cost, domain.LotTypeBoard) + broker.NetFee(revenue, domain.LotTypeBoard)
+	tax := broker.Tax(pos.stockID, revenue, domain.LotTypeBoard, false)
+	profitLoss := revenue - cost - fee - tax
+
+	trade := &domain.BacktestTrade{
+		StockID:    pos.stockID,
+		EntryDate:  pos.entryDate,
+		ExitDate:   exitDate,
+		ExitReason: reason,
+		EntryPrice: pos.entryPrice,
+		ExitPrice:  exitPrice,
+		Fee:        fee,
+		Tax:        tax,
+		ProfitLoss: profitLoss,
+		Quantity:   quantity,
+	}
+	if cost > 0 {
+		trade.Return = profitLoss / cost * percentage
+	}
+
+	return trade
+}
+
+func sortedPositionIDs(positions map[string]*backtestPosition) []string {
+	ids := make([]string, 0, len(positions))
+	for id := range positions {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
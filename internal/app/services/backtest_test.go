@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/rs/zerolog"
+	adapter "github.com/samwang0723/jarvis/internal/app/adapter/mocks"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBacktestExit(t *testing.T) {
+	t.Parallel()
+
+	cfg := &dto.RunBacktestRequest{StopLoss: 10, TakeProfit: 20, HoldingDays: 5}
+
+	tests := []struct {
+		bar      *domain.DailyClose
+		name     string
+		reason   string
+		heldDays int32
+		price    float32
+		exit     bool
+	}{
+		{
+			name:   "stop-loss hit intraday",
+			bar:    &domain.DailyClose{Open: 95, High: 96, Low: 88, Close: 92},
+			reason: domain.ExitReasonStopLoss,
+			price:  90,
+			exit:   true,
+		},
+		{
+			name:   "gap down through the stop fills at the open",
+			bar:    &domain.DailyClose{Open: 85, High: 87, Low: 84, Close: 86},
+			reason: domain.ExitReasonStopLoss,
+			price:  85,
+			exit:   true,
+		},
+		{
+			name:   "take-profit hit intraday",
+			bar:    &domain.DailyClose{Open: 115, High: 122, Low: 114, Close: 118},
+			reason: domain.ExitReasonTakeProfit,
+			price:  120,
+			exit:   true,
+		},
+		{
+			name:   "gap up through the target fills at the open",
+			bar:    &domain.DailyClose{Open: 125, High: 128, Low: 123, Close: 126},
+			reason: domain.ExitReasonTakeProfit,
+			price:  125,
+			exit:   true,
+		},
+		{
+			name:   "stop-loss wins over take-profit on the same bar",
+			bar:    &domain.DailyClose{Open: 100, High: 125, Low: 85, Close: 100},
+			reason: domain.ExitReasonStopLoss,
+			price:  90,
+			exit:   true,
+		},
+		{
+			name:     "holding period reached",
+			bar:      &domain.DailyClose{Open: 101, High: 103, Low: 99, Close: 102},
+			heldDays: 5,
+			reason:   domain.ExitReasonHolding,
+			price:    102,
+			exit:     true,
+		},
+		{
+			name:     "still held",
+			bar:      &domain.DailyClose{Open: 101, High: 103, Low: 99, Close: 102},
+			heldDays: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pos := &backtestPosition{stockID: "2330", entryPrice: 100, heldDays: tt.heldDays}
+			price, reason, exit := backtestExit(cfg, pos, tt.bar)
+			assert.Equal(t, tt.exit, exit)
+			assert.Equal(t, tt.reason, reason)
+			assert.InDelta(t, tt.price, price, 0.001)
+		})
+	}
+}
+
+func TestRunBacktest(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := zerolog.Nop()
+	dal := adapter.NewMockAdapter(ctrl)
+	s := &serviceImpl{
+		dal:    dal,
+		logger: &logger,
+		strategies: NewStrategyRegistry(&ScreeningStrategy{
+			Name:       "everything",
+			Predicates: []Predicate{func(*StrategyInput) bool { return true }},
+		}),
+	}
+
+	dates := []string{"20240311", "20240312", "20240313", "20240314", "20240315"}
+	picks := map[string][]*domain.Selection{
+		// the capital only covers 1101, 2330 is skipped
+		"20240311": {
+			{StockID: "1101", ExchangeDate: "20240311", Close: 100},
+			{StockID: "2330", ExchangeDate: "20240311", Close: 50},
+		},
+		// 2330 is entered with the cash 1101 released on the same day
+		"20240313": {
+			{StockID: "2330", ExchangeDate: "20240313", Close: 50},
+		},
+	}
+	bars := []*domain.DailyClose{
+		{StockID: "1101", ExchangeDate: "20240312", Open: 102, High: 112, Low: 101, Close: 110},
+		// gap down through the stop at 90
+		{StockID: "1101", ExchangeDate: "20240313", Open: 85, High: 86, Low: 80, Close: 82},
+		{StockID: "2330", ExchangeDate: "20240314", Open: 51, High: 53, Low: 50, Close: 52},
+		{StockID: "2330", ExchangeDate: "20240315", Open: 53, High: 56, Low: 52, Close: 55},
+	}
+
+	// a broker without fees and tax keeps the figures round
+	dal.EXPECT().GetUserBrokerProfile(gomock.Any(), gomock.Any()).Return(&domain.BrokerProfile{}, nil)
+	dal.EXPECT().ListExchangeDates(gomock.Any(), "20240311", "20240315").Return(dates, nil)
+	dal.EXPECT().ListSelections(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, date string, _ *domain.SelectionCandidates) ([]*domain.Selection, error) {
+			return picks[date], nil
+		}).Times(len(dates))
+	dal.EXPECT().RetrieveDailyCloseBetween(gomock.Any(), []string{"1101", "2330"}, "20240311", "20240315").
+		Return(bars, nil)
+
+	report, err := s.RunBacktest(context.Background(), &dto.RunBacktestRequest{
+		StartDate:      "20240311",
+		EndDate:        "20240315",
+		Strategy:       "everything",
+		StopLoss:       10,
+		TakeProfit:     20,
+		HoldingDays:    2,
+		InitialCapital: 120000,
+	})
+	assert.NoError(t, err)
+
+	assert.Len(t, report.Trades, 2)
+	assert.Equal(t, "1101", report.Trades[0].StockID)
+	assert.Equal(t, domain.ExitReasonStopLoss, report.Trades[0].ExitReason)
+	assert.Equal(t, "20240313", report.Trades[0].ExitDate)
+	assert.InDelta(t, float32(85), report.Trades[0].ExitPrice, 0.001)
+	assert.InDelta(t, float32(-15000), report.Trades[0].ProfitLoss, 0.01)
+
+	assert.Equal(t, "2330", report.Trades[1].StockID)
+	assert.Equal(t, "20240313", report.Trades[1].EntryDate)
+	assert.Equal(t, domain.ExitReasonHolding, report.Trades[1].ExitReason)
+	assert.InDelta(t, float32(55), report.Trades[1].ExitPrice, 0.001)
+	assert.InDelta(t, float32(5000), report.Trades[1].ProfitLoss, 0.01)
+
+	want := []float32{120000, 130000, 105000, 107000, 110000}
+	assert.Len(t, report.EquityCurve, len(want))
+	for idx, equity := range want {
+		assert.Equal(t, dates[idx], report.EquityCurve[idx].Date)
+		assert.InDelta(t, equity, report.EquityCurve[idx].Equity, 0.01, dates[idx])
+	}
+	assert.InDelta(t, float32(110000), report.FinalEquity, 0.01)
+}
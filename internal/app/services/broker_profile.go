@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+)
+
+func (s *serviceImpl) CreateBrokerProfile(ctx context.Context, obj *domain.BrokerProfile) error {
+	if err := obj.Validate(); err != nil {
+		return err
+	}
+
+	obj.UserID = s.currentUserID
+
+	return s.dal.CreateBrokerProfile(ctx, obj)
+}
+
+// ListBrokerProfiles returns the default schedule followed by the shared
+// profiles and the ones of the user.
+func (s *serviceImpl) ListBrokerProfiles(ctx context.Context) ([]*domain.BrokerProfile, error) {
+	profiles, err := s.dal.ListBrokerProfiles(ctx, s.currentUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]*domain.BrokerProfile{domain.DefaultBrokerProfile()}, profiles...), nil
+}
+
+// GetBrokerProfile returns the profile the user trades with.
+func (s *serviceImpl) GetBrokerProfile(ctx context.Context) (*domain.BrokerProfile, error) {
+	return s.dal.GetUserBrokerProfile(ctx, s.currentUserID)
+}
+
+// SelectBrokerProfile switches the user to a shared profile or one of their
+// own, the nil id switches back to the default schedule.
+func (s *serviceImpl) SelectBrokerProfile(ctx context.Context, id uuid.UUID) error {
+	if id != uuid.Nil {
+		profile, err := s.dal.GetBrokerProfile(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if profile.UserID != uuid.Nil && profile.UserID != s.currentUserID {
+			return errBrokerProfileNotFound
+		}
+	}
+
+	return s.dal.UpdateUserBrokerProfile(ctx, s.currentUserID, id)
+}
+
+// SettleFeeRebates credits the monthly fee rebates of the trades booked before
+// the current month.
+func (s *serviceImpl) SettleFeeRebates(ctx context.Context) error {
+	now := time.Now()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+	return s.dal.SettleFeeRebates(ctx, monthStart)
+}
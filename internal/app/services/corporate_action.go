@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+)
+
+var errCannotCastCorporateAction = errors.New("cannot cast interface to *domain.CorporateAction")
+
+func (s *serviceImpl) BatchUpsertCorporateActions(ctx context.Context, objs *[]any) error {
+	actions := []*domain.CorporateAction{}
+	for _, v := range *objs {
+		if val, ok := v.(*domain.CorporateAction); ok {
+			actions = append(actions, val)
+		} else {
+			return errCannotCastCorporateAction
+		}
+	}
+
+	return s.dal.BatchUpsertCorporateActions(ctx, actions)
+}
+
+// backAdjust anchors the bars on endDate, only corporate actions after the
+// earliest bar and up to endDate take effect.
+func (s *serviceImpl) backAdjust(
+	ctx context.Context,
+	bars []*domain.DailyClose,
+	endDate string,
+) ([]*domain.DailyClose, error) {
+	if len(bars) == 0 {
+		return bars, nil
+	}
+
+	stocks := make(map[string]struct{})
+	startDate := bars[0].ExchangeDate
+	for _, bar := range bars {
+		stocks[bar.StockID] = struct{}{}
+		startDate = min(startDate, bar.ExchangeDate)
+		endDate = max(endDate, bar.ExchangeDate)
+	}
+
+	stockIDs := make([]string, 0, len(stocks))
+	for id := range stocks {
+		stockIDs = append(stockIDs, id)
+	}
+
+	actions, err := s.dal.ListCorporateActions(ctx, stockIDs, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.BackAdjust(bars, actions), nil
+}
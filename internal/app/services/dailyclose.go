@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"slices"
 
 	"github.com/samwang0723/jarvis/internal/app/domain"
 	"github.com/samwang0723/jarvis/internal/app/dto"
@@ -27,6 +28,17 @@ func (s *serviceImpl) BatchUpsertDailyClose(ctx context.Context, objs *[]any) er
 		return err
 	}
 
+	// fill the pending orders the intraday quotes missed
+	bars := make([]*domain.PriceBar, 0, len(dailyCloses))
+	for _, v := range dailyCloses {
+		bars = append(bars, domain.NewPriceBarFromDailyClose(v))
+	}
+
+	err = s.simulatePendingOrders(ctx, bars)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to simulate pending orders")
+	}
+
 	return nil
 }
 
@@ -34,6 +46,11 @@ func (s *serviceImpl) ListDailyClose(
 	ctx context.Context,
 	req *dto.ListDailyCloseRequest,
 ) ([]*domain.DailyClose, int64, error) {
+	timeframe, err := domain.ParseTimeframe(req.Timeframe)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	param := &domain.ListDailyCloseParams{
 		Limit:     req.Limit,
 		Offset:    req.Offset,
@@ -44,11 +61,32 @@ func (s *serviceImpl) ListDailyClose(
 		param.EndDate = *req.SearchParams.End
 	}
 
+	if timeframe != domain.TimeframeDaily {
+		// pull enough daily bars to build the requested page of resampled bars,
+		// one extra bar absorbs months with more exchange days than average
+		param.Offset = 0
+		param.Limit = (req.Offset + req.Limit + 1) * int32(timeframe.TradingDays())
+	}
+
 	objs, err := s.dal.ListDailyClose(ctx, param)
 	if err != nil {
 		return nil, 0, err
 	}
 
+	if req.Adjusted {
+		objs, err = s.backAdjust(ctx, objs, param.EndDate)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if timeframe != domain.TimeframeDaily {
+		objs, err = resampleDailyClose(objs, timeframe, len(objs) == int(param.Limit), req.Offset)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
 	length := len(objs)
 	capacity := int(req.Limit)
 	if capacity > length {
@@ -58,6 +96,32 @@ func (s *serviceImpl) ListDailyClose(
 	return objs[:capacity], int64(length), nil
 }
 
+// resampleDailyClose converts daily bars into the timeframe ordered by exchange
+// date descending like the daily listing. When the daily history was truncated
+// the oldest bar may be incomplete and is dropped.
+func resampleDailyClose(
+	objs []*domain.DailyClose,
+	timeframe domain.Timeframe,
+	truncated bool,
+	offset int32,
+) ([]*domain.DailyClose, error) {
+	bars, err := domain.Resample(objs, timeframe)
+	if err != nil {
+		return nil, err
+	}
+
+	if truncated && len(bars) > 0 {
+		bars = bars[1:]
+	}
+	slices.Reverse(bars)
+
+	if int(offset) >= len(bars) {
+		return []*domain.DailyClose{}, nil
+	}
+
+	return bars[offset:], nil
+}
+
 func (s *serviceImpl) HasDailyClose(ctx context.Context, date string) bool {
 	has, _ := s.dal.HasDailyClose(ctx, date)
 	return has
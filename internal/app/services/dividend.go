@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/helper"
+)
+
+// DistributeCorporateActions pays the corporate actions due by today to the
+// positions on the register of their record date. An action is marked distributed once every
+// position received its entitlement, the failed ones are retried by the next
+// run.
+func (s *serviceImpl) DistributeCorporateActions(ctx context.Context) error {
+	actions, err := s.dal.ListDueCorporateActions(ctx, helper.Today())
+	if err != nil {
+		return err
+	}
+
+	for _, action := range actions {
+		if err := s.distributeCorporateAction(ctx, action); err != nil {
+			s.logger.Error().Err(err).Msgf("failed to distribute corporate action: %s", action.ID.ID)
+
+			continue
+		}
+
+		if err := s.dal.MarkCorporateActionDistributed(ctx, action.ID.ID); err != nil {
+			s.logger.Error().Err(err).Msgf("failed to mark corporate action distributed: %s", action.ID.ID)
+		}
+	}
+
+	return nil
+}
+
+func (s *serviceImpl) distributeCorporateAction(ctx context.Context, action *domain.CorporateAction) error {
+	orders, err := s.dal.ListEntitledOrders(ctx, action.StockID, action.CutoffDate())
+	if err != nil {
+		return err
+	}
+
+	if err = s.fillLotMatches(ctx, orders); err != nil {
+		return err
+	}
+
+	var failed error
+	for _, order := range orders {
+		entitlement := action.Entitle(order)
+		if entitlement == nil {
+			continue
+		}
+
+		if err := s.distributeEntitlement(ctx, order, entitlement); err != nil {
+			s.logger.Error().Err(err).Str("order", order.ID.String()).Msg("failed to distribute entitlement")
+			failed = err
+		}
+	}
+
+	return failed
+}
+
+func (s *serviceImpl) distributeEntitlement(
+	ctx context.Context,
+	order *domain.Order,
+	entitlement *domain.Entitlement,
+) error {
+	if err := order.Distribute(entitlement); err != nil {
+		return err
+	}
+
+	transactions, err := entitlementTransactions(entitlement)
+	if err != nil {
+		return err
+	}
+
+	return s.dal.DistributeEntitlement(ctx, entitlement, order, transactions)
+}
+
+// entitlementTransactions chains the cash flows of the entitlement, the
+// credits come before the debits they may fund.
+func entitlementTransactions(entitlement *domain.Entitlement) ([]*domain.Transaction, error) {
+	entries := []struct {
+		orderType string
+		credit    float32
+		debit     float32
+		book      bool
+	}{
+		{domain.OrderTypeDividend, entitlement.CashDividend, 0, entitlement.CashDividend > 0},
+		{domain.OrderTypeStockDividend, entitlement.CashInLieu, 0, entitlement.StockShares > 0 || entitlement.CashInLieu > 0},
+		{domain.OrderTypeDividendPremium, 0, entitlement.Premium, entitlement.Premium > 0},
+	}
+
+	transactions := make([]*domain.Transaction, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.book {
+			continue
+		}
+
+		transaction, err := domain.NewTransaction(
+			entitlement.UserID,
+			entry.orderType,
+			entry.credit,
+			entry.debit,
+			entitlement.OrderID,
+		)
+		if err != nil {
+			return transactions, err
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
+
+func (s *serviceImpl) ListEntitlements(ctx context.Context) ([]*domain.Entitlement, error) {
+	return s.dal.ListEntitlements(ctx, s.currentUserID)
+}
+
+// SubscribeRights books the rights the entitlement offers to the position,
+// the subscription is paid from the balance of the user.
+func (s *serviceImpl) SubscribeRights(ctx context.Context, entitlementID uuid.UUID) error {
+	entitlement, err := s.dal.GetEntitlement(ctx, entitlementID, s.currentUserID)
+	if err != nil {
+		return err
+	}
+
+	if !entitlement.CanSubscribeRights() {
+		return errRightsNotOffered
+	}
+
+	order, err := s.dal.GetOrder(ctx, entitlement.OrderID)
+	if err != nil {
+		return err
+	}
+
+	order.Broker, err = s.dal.GetUserBrokerProfile(ctx, s.currentUserID)
+	if err != nil {
+		return err
+	}
+
+	if err := order.SubscribeRights(entitlement); err != nil {
+		return err
+	}
+
+	transaction, err := domain.NewTransaction(
+		entitlement.UserID,
+		domain.OrderTypeRightsSubscription,
+		0,
+		entitlement.RightsCost,
+		entitlement.OrderID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.dal.SubscribeRights(ctx, entitlement, order, []*domain.Transaction{transaction})
+}
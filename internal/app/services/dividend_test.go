@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/rs/zerolog"
+	adapter "github.com/samwang0723/jarvis/internal/app/adapter/mocks"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistributeCorporateActions_RightsOffered(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := zerolog.Nop()
+	dal := adapter.NewMockAdapter(ctrl)
+	s := &serviceImpl{dal: dal, logger: &logger}
+
+	order, err := domain.NewOrder(uuid.Must(uuid.NewV4()), domain.OrderTypeBuy, domain.LotTypeBoard,
+		domain.TradeTypeCash, "2330", "20240102", 100, 2000, nil)
+	assert.NoError(t, err)
+	created := len(order.GetChanges())
+
+	action := &domain.CorporateAction{
+		ID:          domain.ID{ID: uuid.Must(uuid.NewV4())},
+		StockID:     "2330",
+		ExDate:      "20240613",
+		RecordDate:  "20240619",
+		PaymentDate: "20240710",
+		RightsRatio: 0.1,
+		RightsPrice: 50,
+	}
+
+	dal.EXPECT().ListDueCorporateActions(gomock.Any(), gomock.Any()).Return([]*domain.CorporateAction{action}, nil)
+	dal.EXPECT().ListEntitledOrders(gomock.Any(), "2330", "20240613").Return([]*domain.Order{order}, nil)
+	dal.EXPECT().ListLotMatches(gomock.Any(), gomock.Any()).Return(nil, nil)
+	dal.EXPECT().MarkCorporateActionDistributed(gomock.Any(), action.ID.ID).Return(nil)
+
+	// the rights are recorded as offered, neither booked nor paid
+	dal.EXPECT().DistributeEntitlement(gomock.Any(), gomock.Any(), order, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entitlement *domain.Entitlement, order *domain.Order,
+			transactions []*domain.Transaction,
+		) error {
+			assert.Equal(t, uint64(200), entitlement.RightsShares)
+			assert.True(t, entitlement.CanSubscribeRights())
+			assert.Empty(t, transactions)
+			assert.Len(t, order.GetChanges(), created)
+			assert.Equal(t, uint64(2000), order.BuyQuantity)
+
+			return nil
+		})
+
+	assert.NoError(t, s.DistributeCorporateActions(context.Background()))
+}
+
+func TestSubscribeRights(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.Must(uuid.NewV4())
+
+	tests := []struct {
+		want        error
+		entitlement *domain.Entitlement
+		name        string
+	}{
+		{
+			name:        "rights offered",
+			entitlement: &domain.Entitlement{RightsShares: 200, RightsCost: 10000},
+		},
+		{
+			name:        "rights subscribed already",
+			entitlement: &domain.Entitlement{RightsShares: 200, RightsCost: 10000, RightsSubscribed: true},
+			want:        errRightsNotOffered,
+		},
+		{
+			name:        "no rights offered",
+			entitlement: &domain.Entitlement{CashDividend: 2000},
+			want:        errRightsNotOffered,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			logger := zerolog.Nop()
+			dal := adapter.NewMockAdapter(ctrl)
+			s := &serviceImpl{dal: dal, logger: &logger, currentUserID: userID}
+
+			order, err := domain.NewOrder(userID, domain.OrderTypeBuy, domain.LotTypeBoard, domain.TradeTypeCash,
+				"2330", "20240102", 100, 2000, nil)
+			assert.NoError(t, err)
+
+			tt.entitlement.ID = uuid.Must(uuid.NewV4())
+			tt.entitlement.OrderID = order.ID
+			tt.entitlement.UserID = userID
+			dal.EXPECT().GetEntitlement(gomock.Any(), tt.entitlement.ID, userID).Return(tt.entitlement, nil)
+
+			if tt.want == nil {
+				dal.EXPECT().GetOrder(gomock.Any(), order.ID).Return(order, nil)
+				dal.EXPECT().GetUserBrokerProfile(gomock.Any(), userID).Return(domain.DefaultBrokerProfile(), nil)
+				dal.EXPECT().SubscribeRights(gomock.Any(), tt.entitlement, order, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *domain.Entitlement, order *domain.Order,
+						transactions []*domain.Transaction,
+					) error {
+						assert.Equal(t, uint64(2200), order.BuyQuantity)
+						assert.Len(t, transactions, 1)
+						assert.Equal(t, domain.OrderTypeRightsSubscription, transactions[0].OrderType)
+						assert.InDelta(t, 10000, transactions[0].DebitAmount, 0.01)
+
+						return nil
+					})
+			}
+
+			err = s.SubscribeRights(context.Background(), tt.entitlement.ID)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
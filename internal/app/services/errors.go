@@ -7,4 +7,17 @@ var (
 	errUnableToChainTransactions = errors.New("unable to create chain transactions")
 	errUserNotFound              = errors.New("user not found")
 	errUserPasswordNotMatch      = errors.New("user login credential not match")
+	errInvalidStrategy           = errors.New("invalid selection strategy")
+	errStrategyAlreadyExists     = errors.New("selection strategy already exists")
+	errStrategyNotFound          = errors.New("selection strategy not found")
+	errInvalidBacktestRange      = errors.New("invalid backtest date range")
+	errQuoteStreamUnavailable    = errors.New("realtime quote stream requires redis")
+	errQuoteNotTraded            = errors.New("quote not traded yet")
+	errUnsupportedQuoteKey       = errors.New("unsupported quote key")
+	errInvalidPosition           = errors.New("take profit requires an open position of the stock")
+	errOrderNotFound             = errors.New("order not found")
+	errInvalidLot                = errors.New("invalid lot selection")
+	errBrokerProfileNotFound     = errors.New("broker profile not found")
+	errInsufficientLots          = errors.New("selected lots cannot cover the quantity")
+	errRightsNotOffered          = errors.New("entitlement offers no rights to subscribe")
 )
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+	"github.com/samwang0723/jarvis/internal/helper"
+)
+
+// recordIntradayTick keeps the crawled snapshot so the session path survives
+// the overwrite of the realtime cache.
+func (s *serviceImpl) recordIntradayTick(ctx context.Context, realtime *domain.Realtime) error {
+	if realtime.Close == 0.0 || realtime.ParseTime == "" {
+		return nil
+	}
+
+	return s.dal.CreateIntradayTick(ctx, domain.NewIntradayTick(realtime))
+}
+
+func (s *serviceImpl) ListIntradayBars(
+	ctx context.Context,
+	req *dto.ListIntradayBarsRequest,
+) ([]*domain.IntradayBar, error) {
+	interval, err := domain.ParseBarInterval(req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	date := req.Date
+	if date == "" {
+		date = helper.Today()
+	}
+
+	ticks, err := s.dal.ListIntradayTicks(ctx, req.StockID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.BuildMinuteBars(ticks, interval)
+}
@@ -116,6 +116,8 @@ func (s *serviceImpl) ListeningKafkaInput(ctx context.Context) {
 						err = s.BatchUpsertStocks(ctx, obj.values)
 					case ikafka.ThreePrimaryV1:
 						err = s.BatchUpsertThreePrimary(ctx, obj.values)
+					case ikafka.CorporateActionsV1:
+						err = s.BatchUpsertCorporateActions(ctx, obj.values)
 					}
 
 					if err != nil {
@@ -158,6 +160,11 @@ func unmarshalMessageTodomain(msg ikafka.ReceivedMessage) (any, error) {
 		err = json.Unmarshal(msg.Message, &obj)
 		obj.ID.ID = uuid.Must(uuid.NewV4())
 		output = &obj
+	case ikafka.CorporateActionsV1:
+		var obj domain.CorporateAction
+		err = json.Unmarshal(msg.Message, &obj)
+		obj.ID.ID = uuid.Must(uuid.NewV4())
+		output = &obj
 	}
 
 	return output, err
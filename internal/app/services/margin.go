@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/notifier"
+)
+
+const marginWarningKey = "margin_warnings"
+
+// evaluateMargins warns the owners of the margin and short positions of the
+// quoted stock once their maintenance ratio drops close to a margin call. A
+// position is warned once a day, a failed delivery is retried with the next
+// quote.
+func (s *serviceImpl) evaluateMargins(ctx context.Context, quote *domain.Realtime) error {
+	orders, err := s.dal.ListOpenMarginedOrders(ctx, quote.StockID)
+	if err != nil {
+		return err
+	}
+
+	for _, order := range orders {
+		order.CalculateMargin(quote.Close, quote.Date)
+		if !order.MarginWarning() {
+			continue
+		}
+
+		redisKey := fmt.Sprintf("%s:%s:%s", marginWarningKey, quote.Date, order.ID)
+		if warned, err := s.cache.Get(ctx, redisKey); err == nil && warned != "" {
+			continue
+		}
+
+		level := "close to a margin call"
+		if order.MarginCalled() {
+			level = "under the margin call level"
+		}
+
+		s.logger.Warn().Str("order", order.ID.String()).Float32("ratio", order.MaintenanceRatio).
+			Msgf("%s %s position is %s", order.StockID, order.TradeType, level)
+
+		n, ok := s.notifiers[domain.AlertChannelEmail]
+		if !ok {
+			continue
+		}
+
+		user, err := s.dal.GetUserByID(ctx, order.UserID)
+		if err != nil {
+			s.logger.Error().Err(err).Msgf("failed to load owner of margined order: %s", order.ID)
+			continue
+		}
+
+		msg := &notifier.Message{
+			Subject: fmt.Sprintf("[jarvis] %s %s margin warning", quote.StockID, quote.Name),
+			Body: fmt.Sprintf("%s position of %d shares is %s, maintenance ratio %.2f%% at %.2f on %s %s",
+				order.TradeType, order.OpenQuantity(), level, order.MaintenanceRatio*percentage,
+				quote.Close, quote.Date, quote.ParseTime),
+		}
+		if err = n.Notify(ctx, user.Email, msg); err != nil {
+			s.logger.Error().Err(err).Msgf("failed to notify margin warning: %s", order.ID)
+			continue
+		}
+
+		if err = s.cache.Set(ctx, redisKey, quote.ParseTime, defaultRealtimeCacheExpire); err != nil {
+			s.logger.Error().Err(err).Msgf("failed to mark margin warning: %s", order.ID)
+		}
+	}
+
+	return nil
+}
@@ -54,6 +54,34 @@ func (mr *MockIServiceMockRecorder) AddJob(ctx, spec, job interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddJob", reflect.TypeOf((*MockIService)(nil).AddJob), ctx, spec, job)
 }
 
+// AmendOrder mocks base method.
+func (m *MockIService) AmendOrder(ctx context.Context, id uuid.UUID, req *dto.AmendOrderRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AmendOrder", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AmendOrder indicates an expected call of AmendOrder.
+func (mr *MockIServiceMockRecorder) AmendOrder(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AmendOrder", reflect.TypeOf((*MockIService)(nil).AmendOrder), ctx, id, req)
+}
+
+// BatchUpsertCorporateActions mocks base method.
+func (m *MockIService) BatchUpsertCorporateActions(ctx context.Context, objs *[]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpsertCorporateActions", ctx, objs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchUpsertCorporateActions indicates an expected call of BatchUpsertCorporateActions.
+func (mr *MockIServiceMockRecorder) BatchUpsertCorporateActions(ctx, objs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpsertCorporateActions", reflect.TypeOf((*MockIService)(nil).BatchUpsertCorporateActions), ctx, objs)
+}
+
 // BatchUpsertDailyClose mocks base method.
 func (m *MockIService) BatchUpsertDailyClose(ctx context.Context, objs *[]any) error {
 	m.ctrl.T.Helper()
@@ -124,6 +152,20 @@ func (mr *MockIServiceMockRecorder) BatchUpsertThreePrimary(ctx, objs interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpsertThreePrimary", reflect.TypeOf((*MockIService)(nil).BatchUpsertThreePrimary), ctx, objs)
 }
 
+// CancelOrder mocks base method.
+func (m *MockIService) CancelOrder(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrder", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelOrder indicates an expected call of CancelOrder.
+func (mr *MockIServiceMockRecorder) CancelOrder(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrder", reflect.TypeOf((*MockIService)(nil).CancelOrder), ctx, id)
+}
+
 // CrawlingRealTimePrice mocks base method.
 func (m *MockIService) CrawlingRealTimePrice(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -138,6 +180,49 @@ func (mr *MockIServiceMockRecorder) CrawlingRealTimePrice(ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CrawlingRealTimePrice", reflect.TypeOf((*MockIService)(nil).CrawlingRealTimePrice), ctx)
 }
 
+// CreateAlertRule mocks base method.
+func (m *MockIService) CreateAlertRule(ctx context.Context, obj *domain.AlertRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlertRule", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAlertRule indicates an expected call of CreateAlertRule.
+func (mr *MockIServiceMockRecorder) CreateAlertRule(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertRule", reflect.TypeOf((*MockIService)(nil).CreateAlertRule), ctx, obj)
+}
+
+// CreateBrokerProfile mocks base method.
+func (m *MockIService) CreateBrokerProfile(ctx context.Context, obj *domain.BrokerProfile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBrokerProfile", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBrokerProfile indicates an expected call of CreateBrokerProfile.
+func (mr *MockIServiceMockRecorder) CreateBrokerProfile(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBrokerProfile", reflect.TypeOf((*MockIService)(nil).CreateBrokerProfile), ctx, obj)
+}
+
+// CreateJournalEntry mocks base method.
+func (m *MockIService) CreateJournalEntry(ctx context.Context, orderID uuid.UUID, req *dto.CreateJournalEntryRequest) (*domain.JournalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJournalEntry", ctx, orderID, req)
+	ret0, _ := ret[0].(*domain.JournalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJournalEntry indicates an expected call of CreateJournalEntry.
+func (mr *MockIServiceMockRecorder) CreateJournalEntry(ctx, orderID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJournalEntry", reflect.TypeOf((*MockIService)(nil).CreateJournalEntry), ctx, orderID, req)
+}
+
 // CreateOrder mocks base method.
 func (m *MockIService) CreateOrder(ctx context.Context, req *dto.CreateOrderRequest) error {
 	m.ctrl.T.Helper()
@@ -152,6 +237,20 @@ func (mr *MockIServiceMockRecorder) CreateOrder(ctx, req interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockIService)(nil).CreateOrder), ctx, req)
 }
 
+// CreateScreen mocks base method.
+func (m *MockIService) CreateScreen(ctx context.Context, obj *domain.Screen) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScreen", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateScreen indicates an expected call of CreateScreen.
+func (mr *MockIServiceMockRecorder) CreateScreen(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScreen", reflect.TypeOf((*MockIService)(nil).CreateScreen), ctx, obj)
+}
+
 // CreateTransaction mocks base method.
 func (m *MockIService) CreateTransaction(ctx context.Context, orderType string, creditAmount, debitAmount float32) error {
 	m.ctrl.T.Helper()
@@ -194,6 +293,20 @@ func (mr *MockIServiceMockRecorder) CronjobPresetRealtimeMonitoringKeys(ctx inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CronjobPresetRealtimeMonitoringKeys", reflect.TypeOf((*MockIService)(nil).CronjobPresetRealtimeMonitoringKeys), ctx)
 }
 
+// DeleteAlertRule mocks base method.
+func (m *MockIService) DeleteAlertRule(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertRule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlertRule indicates an expected call of DeleteAlertRule.
+func (mr *MockIServiceMockRecorder) DeleteAlertRule(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertRule", reflect.TypeOf((*MockIService)(nil).DeleteAlertRule), ctx, id)
+}
+
 // DeletePickedStockByID mocks base method.
 func (m *MockIService) DeletePickedStockByID(ctx context.Context, stockID string) error {
 	m.ctrl.T.Helper()
@@ -222,6 +335,35 @@ func (mr *MockIServiceMockRecorder) DeleteUser(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockIService)(nil).DeleteUser), ctx)
 }
 
+// DistributeCorporateActions mocks base method.
+func (m *MockIService) DistributeCorporateActions(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DistributeCorporateActions", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeCorporateActions indicates an expected call of DistributeCorporateActions.
+func (mr *MockIServiceMockRecorder) DistributeCorporateActions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeCorporateActions", reflect.TypeOf((*MockIService)(nil).DistributeCorporateActions), ctx)
+}
+
+// GetAnnualReport mocks base method.
+func (m *MockIService) GetAnnualReport(ctx context.Context, req *dto.GetAnnualReportRequest) (*domain.AnnualReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnnualReport", ctx, req)
+	ret0, _ := ret[0].(*domain.AnnualReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnnualReport indicates an expected call of GetAnnualReport.
+func (mr *MockIServiceMockRecorder) GetAnnualReport(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnualReport", reflect.TypeOf((*MockIService)(nil).GetAnnualReport), ctx, req)
+}
+
 // GetBalance mocks base method.
 func (m *MockIService) GetBalance(ctx context.Context) (*domain.BalanceView, error) {
 	m.ctrl.T.Helper()
@@ -237,6 +379,51 @@ func (mr *MockIServiceMockRecorder) GetBalance(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockIService)(nil).GetBalance), ctx)
 }
 
+// GetBrokerProfile mocks base method.
+func (m *MockIService) GetBrokerProfile(ctx context.Context) (*domain.BrokerProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrokerProfile", ctx)
+	ret0, _ := ret[0].(*domain.BrokerProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBrokerProfile indicates an expected call of GetBrokerProfile.
+func (mr *MockIServiceMockRecorder) GetBrokerProfile(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrokerProfile", reflect.TypeOf((*MockIService)(nil).GetBrokerProfile), ctx)
+}
+
+// GetPerformance mocks base method.
+func (m *MockIService) GetPerformance(ctx context.Context, req *dto.GetPerformanceRequest) (*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPerformance", ctx, req)
+	ret0, _ := ret[0].(*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPerformance indicates an expected call of GetPerformance.
+func (mr *MockIServiceMockRecorder) GetPerformance(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPerformance", reflect.TypeOf((*MockIService)(nil).GetPerformance), ctx, req)
+}
+
+// GetPortfolio mocks base method.
+func (m *MockIService) GetPortfolio(ctx context.Context) (*domain.Portfolio, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPortfolio", ctx)
+	ret0, _ := ret[0].(*domain.Portfolio)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPortfolio indicates an expected call of GetPortfolio.
+func (mr *MockIServiceMockRecorder) GetPortfolio(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPortfolio", reflect.TypeOf((*MockIService)(nil).GetPortfolio), ctx)
+}
+
 // GetStakeConcentration mocks base method.
 func (m *MockIService) GetStakeConcentration(ctx context.Context, req *dto.GetStakeConcentrationRequest) (*domain.StakeConcentration, error) {
 	m.ctrl.T.Helper()
@@ -252,6 +439,21 @@ func (mr *MockIServiceMockRecorder) GetStakeConcentration(ctx, req interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakeConcentration", reflect.TypeOf((*MockIService)(nil).GetStakeConcentration), ctx, req)
 }
 
+// GetTradeStats mocks base method.
+func (m *MockIService) GetTradeStats(ctx context.Context, req *dto.GetTradeStatsRequest) ([]*domain.TradeStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTradeStats", ctx, req)
+	ret0, _ := ret[0].([]*domain.TradeStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTradeStats indicates an expected call of GetTradeStats.
+func (mr *MockIServiceMockRecorder) GetTradeStats(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTradeStats", reflect.TypeOf((*MockIService)(nil).GetTradeStats), ctx, req)
+}
+
 // GetUserByEmail mocks base method.
 func (m *MockIService) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -311,6 +513,51 @@ func (mr *MockIServiceMockRecorder) HasDailyClose(ctx, date interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDailyClose", reflect.TypeOf((*MockIService)(nil).HasDailyClose), ctx, date)
 }
 
+// ImportOrders mocks base method.
+func (m *MockIService) ImportOrders(ctx context.Context, req *dto.ImportOrdersRequest) ([]*domain.TradeConfirmation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportOrders", ctx, req)
+	ret0, _ := ret[0].([]*domain.TradeConfirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportOrders indicates an expected call of ImportOrders.
+func (mr *MockIServiceMockRecorder) ImportOrders(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportOrders", reflect.TypeOf((*MockIService)(nil).ImportOrders), ctx, req)
+}
+
+// ListAlertRules mocks base method.
+func (m *MockIService) ListAlertRules(ctx context.Context) ([]*domain.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertRules", ctx)
+	ret0, _ := ret[0].([]*domain.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertRules indicates an expected call of ListAlertRules.
+func (mr *MockIServiceMockRecorder) ListAlertRules(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertRules", reflect.TypeOf((*MockIService)(nil).ListAlertRules), ctx)
+}
+
+// ListBrokerProfiles mocks base method.
+func (m *MockIService) ListBrokerProfiles(ctx context.Context) ([]*domain.BrokerProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBrokerProfiles", ctx)
+	ret0, _ := ret[0].([]*domain.BrokerProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBrokerProfiles indicates an expected call of ListBrokerProfiles.
+func (mr *MockIServiceMockRecorder) ListBrokerProfiles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrokerProfiles", reflect.TypeOf((*MockIService)(nil).ListBrokerProfiles), ctx)
+}
+
 // ListCategories mocks base method.
 func (m *MockIService) ListCategories(ctx context.Context) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -342,6 +589,51 @@ func (mr *MockIServiceMockRecorder) ListDailyClose(ctx, req interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDailyClose", reflect.TypeOf((*MockIService)(nil).ListDailyClose), ctx, req)
 }
 
+// ListEntitlements mocks base method.
+func (m *MockIService) ListEntitlements(ctx context.Context) ([]*domain.Entitlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntitlements", ctx)
+	ret0, _ := ret[0].([]*domain.Entitlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntitlements indicates an expected call of ListEntitlements.
+func (mr *MockIServiceMockRecorder) ListEntitlements(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntitlements", reflect.TypeOf((*MockIService)(nil).ListEntitlements), ctx)
+}
+
+// ListIntradayBars mocks base method.
+func (m *MockIService) ListIntradayBars(ctx context.Context, req *dto.ListIntradayBarsRequest) ([]*domain.IntradayBar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIntradayBars", ctx, req)
+	ret0, _ := ret[0].([]*domain.IntradayBar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIntradayBars indicates an expected call of ListIntradayBars.
+func (mr *MockIServiceMockRecorder) ListIntradayBars(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIntradayBars", reflect.TypeOf((*MockIService)(nil).ListIntradayBars), ctx, req)
+}
+
+// ListJournalEntries mocks base method.
+func (m *MockIService) ListJournalEntries(ctx context.Context, orderID uuid.UUID) ([]*domain.JournalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJournalEntries", ctx, orderID)
+	ret0, _ := ret[0].([]*domain.JournalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJournalEntries indicates an expected call of ListJournalEntries.
+func (mr *MockIServiceMockRecorder) ListJournalEntries(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJournalEntries", reflect.TypeOf((*MockIService)(nil).ListJournalEntries), ctx, orderID)
+}
+
 // ListOrders mocks base method.
 func (m *MockIService) ListOrders(ctx context.Context, req *dto.ListOrderRequest) ([]*domain.Order, int64, error) {
 	m.ctrl.T.Helper()
@@ -373,6 +665,21 @@ func (mr *MockIServiceMockRecorder) ListPickedStock(ctx interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPickedStock", reflect.TypeOf((*MockIService)(nil).ListPickedStock), ctx)
 }
 
+// ListScreens mocks base method.
+func (m *MockIService) ListScreens(ctx context.Context) ([]*domain.Screen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScreens", ctx)
+	ret0, _ := ret[0].([]*domain.Screen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScreens indicates an expected call of ListScreens.
+func (mr *MockIServiceMockRecorder) ListScreens(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScreens", reflect.TypeOf((*MockIService)(nil).ListScreens), ctx)
+}
+
 // ListSelections mocks base method.
 func (m *MockIService) ListSelections(ctx context.Context, req *dto.ListSelectionRequest) ([]*domain.Selection, error) {
 	m.ctrl.T.Helper()
@@ -448,6 +755,20 @@ func (mr *MockIServiceMockRecorder) ListeningKafkaInput(ctx interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListeningKafkaInput", reflect.TypeOf((*MockIService)(nil).ListeningKafkaInput), ctx)
 }
 
+// ListeningRealtimeQuotes mocks base method.
+func (m *MockIService) ListeningRealtimeQuotes(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListeningRealtimeQuotes", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListeningRealtimeQuotes indicates an expected call of ListeningRealtimeQuotes.
+func (mr *MockIServiceMockRecorder) ListeningRealtimeQuotes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListeningRealtimeQuotes", reflect.TypeOf((*MockIService)(nil).ListeningRealtimeQuotes), ctx)
+}
+
 // Login mocks base method.
 func (m *MockIService) Login(ctx context.Context, email, password string) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -491,6 +812,78 @@ func (mr *MockIServiceMockRecorder) ObtainLock(ctx, key, expire interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObtainLock", reflect.TypeOf((*MockIService)(nil).ObtainLock), ctx, key, expire)
 }
 
+// RunBacktest mocks base method.
+func (m *MockIService) RunBacktest(ctx context.Context, req *dto.RunBacktestRequest) (*domain.BacktestReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunBacktest", ctx, req)
+	ret0, _ := ret[0].(*domain.BacktestReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunBacktest indicates an expected call of RunBacktest.
+func (mr *MockIServiceMockRecorder) RunBacktest(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunBacktest", reflect.TypeOf((*MockIService)(nil).RunBacktest), ctx, req)
+}
+
+// RunScreen mocks base method.
+func (m *MockIService) RunScreen(ctx context.Context, req *dto.RunScreenRequest) ([]*domain.Selection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunScreen", ctx, req)
+	ret0, _ := ret[0].([]*domain.Selection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunScreen indicates an expected call of RunScreen.
+func (mr *MockIServiceMockRecorder) RunScreen(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunScreen", reflect.TypeOf((*MockIService)(nil).RunScreen), ctx, req)
+}
+
+// SelectBrokerProfile mocks base method.
+func (m *MockIService) SelectBrokerProfile(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectBrokerProfile", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SelectBrokerProfile indicates an expected call of SelectBrokerProfile.
+func (mr *MockIServiceMockRecorder) SelectBrokerProfile(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectBrokerProfile", reflect.TypeOf((*MockIService)(nil).SelectBrokerProfile), ctx, id)
+}
+
+// SettleFeeRebates mocks base method.
+func (m *MockIService) SettleFeeRebates(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleFeeRebates", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SettleFeeRebates indicates an expected call of SettleFeeRebates.
+func (mr *MockIServiceMockRecorder) SettleFeeRebates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleFeeRebates", reflect.TypeOf((*MockIService)(nil).SettleFeeRebates), ctx)
+}
+
+// SnapshotEquity mocks base method.
+func (m *MockIService) SnapshotEquity(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotEquity", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SnapshotEquity indicates an expected call of SnapshotEquity.
+func (mr *MockIServiceMockRecorder) SnapshotEquity(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotEquity", reflect.TypeOf((*MockIService)(nil).SnapshotEquity), ctx)
+}
+
 // StartCron mocks base method.
 func (m *MockIService) StartCron() {
 	m.ctrl.T.Helper()
@@ -543,6 +936,49 @@ func (mr *MockIServiceMockRecorder) StopRedis() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopRedis", reflect.TypeOf((*MockIService)(nil).StopRedis))
 }
 
+// SubscribeQuotes mocks base method.
+func (m *MockIService) SubscribeQuotes(ctx context.Context, stockIDs []string) (<-chan *domain.Realtime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeQuotes", ctx, stockIDs)
+	ret0, _ := ret[0].(<-chan *domain.Realtime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeQuotes indicates an expected call of SubscribeQuotes.
+func (mr *MockIServiceMockRecorder) SubscribeQuotes(ctx, stockIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeQuotes", reflect.TypeOf((*MockIService)(nil).SubscribeQuotes), ctx, stockIDs)
+}
+
+// SubscribeRights mocks base method.
+func (m *MockIService) SubscribeRights(ctx context.Context, entitlementID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeRights", ctx, entitlementID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeRights indicates an expected call of SubscribeRights.
+func (mr *MockIServiceMockRecorder) SubscribeRights(ctx, entitlementID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeRights", reflect.TypeOf((*MockIService)(nil).SubscribeRights), ctx, entitlementID)
+}
+
+// UpdateAlertRule mocks base method.
+func (m *MockIService) UpdateAlertRule(ctx context.Context, obj *domain.AlertRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlertRule", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAlertRule indicates an expected call of UpdateAlertRule.
+func (mr *MockIServiceMockRecorder) UpdateAlertRule(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlertRule", reflect.TypeOf((*MockIService)(nil).UpdateAlertRule), ctx, obj)
+}
+
 // UpdateUser mocks base method.
 func (m *MockIService) UpdateUser(ctx context.Context, obj *domain.User) error {
 	m.ctrl.T.Helper()
@@ -22,6 +22,7 @@ import (
 	"github.com/samwang0723/jarvis/internal/cache"
 	"github.com/samwang0723/jarvis/internal/cronjob"
 	"github.com/samwang0723/jarvis/internal/kafka"
+	"github.com/samwang0723/jarvis/internal/notifier"
 )
 
 type Option func(o *serviceImpl)
@@ -81,3 +82,30 @@ func WithProxy(client *http.Client) Option {
 		i.proxyClient = client
 	}
 }
+
+// WithStrategies registers additional screening strategies on top of the
+// built-in ones, strategies failing validation are skipped.
+func WithStrategies(strategies ...*ScreeningStrategy) Option {
+	return func(i *serviceImpl) {
+		for _, s := range strategies {
+			if err := i.strategies.Register(s); err != nil && i.logger != nil {
+				i.logger.Error().Err(err).Msg("register selection strategy")
+			}
+		}
+	}
+}
+
+// WithQuoteProvider replaces the exchange quote providers used by the
+// realtime monitoring, e.g. with a replay of recorded quotes.
+func WithQuoteProvider(provider QuoteProvider) Option {
+	return func(i *serviceImpl) {
+		i.quoteProvider = provider
+	}
+}
+
+// WithNotifier delivers the alerts of a channel, e.g. domain.AlertChannelEmail.
+func WithNotifier(channel string, n notifier.Notifier) Option {
+	return func(i *serviceImpl) {
+		i.notifiers[channel] = n
+	}
+}
@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"math"
+	"slices"
 
 	"github.com/gofrs/uuid/v5"
 	config "github.com/samwang0723/jarvis/configs"
@@ -10,14 +12,6 @@ import (
 	"github.com/samwang0723/jarvis/internal/helper"
 )
 
-const (
-	taiwanStockQuantity = 1000
-	dayTradeTaxRate     = 0.5
-	taxRate             = 0.003
-	feeRate             = 0.001425
-	brokerFeeDiscount   = 0.25
-)
-
 type processedOrder struct {
 	order            *domain.Order
 	exchangeQuantity uint64
@@ -71,8 +65,18 @@ func (s *serviceImpl) ListOrders(
 		return nil, 0, err
 	}
 
+	if err = s.fillLotMatches(ctx, objs); err != nil {
+		return nil, 0, err
+	}
+
+	broker, err := s.dal.GetUserBrokerProfile(ctx, s.currentUserID)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	// calculate settled profit loss
 	for _, order := range objs {
+		order.Broker = broker
 		for _, stock := range stocks {
 			if stock.ID == order.StockID {
 				order.StockName = stock.Name
@@ -88,6 +92,7 @@ func (s *serviceImpl) ListOrders(
 		for _, order := range objs {
 			if order.StockID == price.StockID && order.Status != "closed" {
 				order.CalculateUnrealizedProfitLoss(price.Price)
+				order.CalculateMargin(price.Price, helper.Today())
 			}
 		}
 	}
@@ -100,6 +105,34 @@ func (s *serviceImpl) ListOrders(
 	return objs, int64(len(objs)), nil
 }
 
+// fillLotMatches attaches the lot ledger to the positions so realized profit
+// loss follows the matched lots.
+func (s *serviceImpl) fillLotMatches(ctx context.Context, objs []*domain.Order) error {
+	orders := make(map[uuid.UUID]*domain.Order, len(objs))
+	for _, order := range objs {
+		if order.IsPosition() {
+			orders[order.ID] = order
+		}
+	}
+
+	if len(orders) == 0 {
+		return nil
+	}
+
+	matches, err := s.dal.ListLotMatches(ctx, helper.Keys(orders))
+	if err != nil {
+		return err
+	}
+
+	for _, match := range matches {
+		if order, ok := orders[match.OrderID]; ok {
+			order.LotMatches = append(order.LotMatches, match)
+		}
+	}
+
+	return nil
+}
+
 func (s *serviceImpl) fillRealtimePrice(ctx context.Context, objs []*domain.Order) {
 	realtimeList := s.fetchRealtimePrice(ctx)
 	for _, order := range objs {
@@ -107,48 +140,135 @@ func (s *serviceImpl) fillRealtimePrice(ctx context.Context, objs []*domain.Orde
 		realtime, ok := realtimeList[order.StockID]
 		if ok {
 			order.CalculateUnrealizedProfitLoss(realtime.Close)
+			order.CalculateMargin(realtime.Close, realtime.Date)
 		}
 	}
 }
 
 func (s *serviceImpl) CreateOrder(ctx context.Context, req *dto.CreateOrderRequest) error {
+	// positions are booked in shares whatever the lot type was requested in
+	quantity, err := domain.ShareQuantity(req.LotType, req.Quantity)
+	if err != nil {
+		return err
+	}
+
+	shareReq := *req
+	shareReq.Quantity = quantity
+	if shareReq.LotType == "" {
+		shareReq.LotType = domain.LotTypeBoard
+	}
+	if shareReq.TradeType == "" {
+		shareReq.TradeType = domain.TradeTypeCash
+	}
+
+	if req.Kind != "" && req.Kind != domain.OrderKindMarket {
+		return s.placePendingOrder(ctx, &shareReq)
+	}
+
+	saveOrders, processedTrans, err := s.bookOrder(ctx, s.currentUserID, &shareReq, uuid.Nil)
+	if err != nil {
+		return err
+	}
+
+	return s.dal.CreateOrder(ctx, saveOrders, processedTrans)
+}
+
+// bookOrder closes the open positions of the user in the order of the lot
+// method, the preferred position first, and opens a new position with the
+// quantity left.
+func (s *serviceImpl) bookOrder(
+	ctx context.Context,
+	userID uuid.UUID,
+	req *dto.CreateOrderRequest,
+	preferredPositionID uuid.UUID,
+) (saveOrders []*domain.Order, processedTrans []*domain.Transaction, err error) {
 	// check remaining open buy or sell order has quantity left to fulfill based on order type
-	remainingOrders, err := s.dal.ListOpenOrders(ctx, s.currentUserID, req.StockID, req.OrderType)
+	remainingOrders, err := s.dal.ListOpenOrders(ctx, userID, req.StockID, req.OrderType)
 	if err != nil {
 		s.logger.Error().Err(err).Msg("failed to list open orders")
 
-		return err
+		return nil, nil, err
+	}
+
+	// margin and short positions are only closed by trades of the same type
+	remainingOrders = slices.DeleteFunc(remainingOrders, func(order *domain.Order) bool {
+		return order.TradeType != req.TradeType
+	})
+
+	broker, err := s.dal.GetUserBrokerProfile(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lotIDs := make([]uuid.UUID, 0, len(req.LotIDs))
+	for _, lotID := range req.LotIDs {
+		id, err := uuid.FromString(lotID)
+		if err != nil {
+			return nil, nil, errInvalidLot
+		}
+		lotIDs = append(lotIDs, id)
 	}
 
+	remainingOrders, err = domain.ArrangeLots(req.LotMethod, remainingOrders, lotIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idx := slices.IndexFunc(remainingOrders, func(order *domain.Order) bool {
+		return order.ID == preferredPositionID
+	})
+	if idx > 0 {
+		preferred := remainingOrders[idx]
+		remainingOrders = append([]*domain.Order{preferred}, slices.Delete(remainingOrders, idx, idx+1)...)
+	}
+
+	averageCost := domain.AverageCost(remainingOrders)
 	processedOrders := []*processedOrder{}
 	pendingQuantity := req.Quantity
 	for _, order := range remainingOrders {
-		merged, left := s.mergeOrderQuantity(order, req, pendingQuantity)
+		if pendingQuantity == 0 {
+			break
+		}
+
+		order.Broker = broker
+		costPrice := order.OpeningPrice()
+		if req.LotMethod == domain.LotMethodAverage {
+			costPrice = averageCost
+		}
+
+		merged, err := s.matchLot(order, req, pendingQuantity, costPrice)
+		if err != nil {
+			return nil, nil, err
+		}
 		processedOrders = append(processedOrders, &processedOrder{
 			order:            order,
 			exchangeQuantity: merged,
 		})
-		pendingQuantity = left
+		pendingQuantity -= merged
+	}
 
-		if pendingQuantity == 0 {
-			break
-		}
+	// the chosen lots must cover the whole trade
+	if pendingQuantity > 0 && req.LotMethod == domain.LotMethodSpecific {
+		return nil, nil, errInsufficientLots
 	}
 
 	// cannot fulfill based on existing open orders
 	if pendingQuantity > 0 {
 		order, err := domain.NewOrder(
-			s.currentUserID,
+			userID,
 			req.OrderType,
+			req.LotType,
+			req.TradeType,
 			req.StockID,
 			req.ExchangeDate,
 			req.TradePrice,
 			pendingQuantity,
+			broker,
 		)
 		if err != nil {
 			s.logger.Error().Err(err).Msg("failed to create order")
 
-			return err
+			return nil, nil, err
 		}
 		processedOrders = append(processedOrders, &processedOrder{
 			order:            order,
@@ -156,15 +276,14 @@ func (s *serviceImpl) CreateOrder(ctx context.Context, req *dto.CreateOrderReque
 		})
 	}
 
-	saveOrders := []*domain.Order{}
-	processedTrans := []*domain.Transaction{}
 	for _, po := range processedOrders {
 		order := po.order
 		dayTrade := order.BuyExchangeDate == order.SellExchangeDate
 		partialCloseOrClose := (order.BuyQuantity > 0 && order.SellQuantity > 0)
 		transactions, err := s.chainTransactions(
-			order.ID,
-			order.UserID,
+			order,
+			broker,
+			req.LotType,
 			req.TradePrice,
 			po.exchangeQuantity,
 			req.OrderType,
@@ -172,185 +291,319 @@ func (s *serviceImpl) CreateOrder(ctx context.Context, req *dto.CreateOrderReque
 			dayTrade,
 		)
 		if err != nil {
-			return errUnableToChainTransactions
+			return nil, nil, errUnableToChainTransactions
 		}
 		processedTrans = append(processedTrans, transactions...)
 		saveOrders = append(saveOrders, order)
 	}
 
-	return s.dal.CreateOrder(ctx, saveOrders, processedTrans)
+	return saveOrders, processedTrans, nil
+}
+
+const cancelledByUserReason = "cancelled by user"
+
+// CancelOrder withdraws a pending order or voids a booked position along with
+// its transactions.
+func (s *serviceImpl) CancelOrder(ctx context.Context, id uuid.UUID) error {
+	order, err := s.userOrder(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := order.Cancel(cancelledByUserReason); err != nil {
+		return err
+	}
+
+	return s.dal.CancelOrder(ctx, order)
+}
+
+// AmendOrder corrects one side of a booked position and rebooks the
+// transactions of the position.
+func (s *serviceImpl) AmendOrder(ctx context.Context, id uuid.UUID, req *dto.AmendOrderRequest) error {
+	order, err := s.userOrder(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	quantity, err := domain.ShareQuantity(req.LotType, req.Quantity)
+	if err != nil {
+		return err
+	}
+
+	order.Broker, err = s.dal.GetUserBrokerProfile(ctx, order.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := order.Amend(req.OrderType, req.ExchangeDate, req.TradePrice, quantity); err != nil {
+		return err
+	}
+
+	transactions, err := s.positionTransactions(order)
+	if err != nil {
+		return errUnableToChainTransactions
+	}
+
+	return s.dal.AmendOrder(ctx, order, transactions)
 }
 
-func (s *serviceImpl) mergeOrderQuantity(
+// closedPositions lists the positions of the user closed between the dates
+// with their realized profit loss, an empty date leaves the range open.
+func (s *serviceImpl) closedPositions(ctx context.Context, startDate, endDate string) ([]*domain.Order, error) {
+	orders, err := s.dal.ListOrders(ctx, &domain.ListOrdersParams{
+		UserID: s.currentUserID,
+		Status: "closed",
+		Limit:  math.MaxInt32,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orders = slices.DeleteFunc(orders, func(order *domain.Order) bool {
+		closing := order.ClosingExchangeDate()
+
+		return (startDate != "" && closing < startDate) || (endDate != "" && closing > endDate)
+	})
+
+	if err = s.fillLotMatches(ctx, orders); err != nil {
+		return nil, err
+	}
+
+	broker, err := s.dal.GetUserBrokerProfile(ctx, s.currentUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, order := range orders {
+		order.Broker = broker
+		order.CalculateProfitLoss()
+	}
+
+	return orders, nil
+}
+
+func (s *serviceImpl) userOrder(ctx context.Context, id uuid.UUID) (*domain.Order, error) {
+	order, err := s.dal.GetOrder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.UserID != s.currentUserID {
+		return nil, errOrderNotFound
+	}
+
+	return order, nil
+}
+
+// positionTransactions chains the transactions booking both sides of the
+// position, only the closing side is taxed.
+func (s *serviceImpl) positionTransactions(order *domain.Order) ([]*domain.Transaction, error) {
+	opening, closing := domain.OrderTypeBuy, domain.OrderTypeSell
+	openPrice, openQuantity := order.BuyPrice, order.BuyQuantity
+	closePrice, closeQuantity := order.SellPrice, order.SellQuantity
+	if order.OpeningSide() == domain.OrderTypeSell {
+		opening, closing = closing, opening
+		openPrice, openQuantity, closePrice, closeQuantity = closePrice, closeQuantity, openPrice, openQuantity
+	}
+
+	dayTrade := order.BuyExchangeDate == order.SellExchangeDate
+	transactions, err := s.chainTransactions(
+		order,
+		order.Broker,
+		order.LotType,
+		openPrice,
+		openQuantity,
+		opening,
+		false,
+		dayTrade,
+	)
+	if err != nil || closeQuantity == 0 {
+		return transactions, err
+	}
+
+	closed, err := s.chainTransactions(
+		order,
+		order.Broker,
+		order.LotType,
+		closePrice,
+		closeQuantity,
+		closing,
+		true,
+		dayTrade,
+	)
+
+	return append(transactions, closed...), err
+}
+
+// matchLot closes as much of the lot as the trade has left and returns the
+// quantity it closed.
+func (s *serviceImpl) matchLot(
 	order *domain.Order,
 	req *dto.CreateOrderRequest,
 	pendingQuantity uint64,
-) (mergedQuantity, leftQuantity uint64) {
-	// if buy = 4, sell = 0, quantity = 2, orderType = sell, then sell = 2
-	// if buy = 4, sell = 3, quantity = 2, orderType = sell, then sell = 4, left = 1
-	leftQuantity = pendingQuantity
-	eventQuantity := uint64(0)
-	price := float32(0.0)
-
-	switch req.OrderType {
-	case domain.OrderTypeBuy:
-		eventQuantity = order.BuyQuantity
-		gap := order.SellQuantity - order.BuyQuantity
-		if gap >= leftQuantity {
-			price = (order.BuyPrice*float32(order.BuyQuantity) + req.TradePrice*float32(req.Quantity)) / float32(
-				order.BuyQuantity+req.Quantity,
-			)
-			mergedQuantity = leftQuantity
-			leftQuantity = 0
-		} else {
-			price = (order.BuyPrice*float32(order.BuyQuantity) + req.TradePrice*float32(gap)) / float32(order.BuyQuantity+gap)
-			mergedQuantity = gap
-			leftQuantity -= gap
-		}
-		eventQuantity += mergedQuantity
-	case domain.OrderTypeSell:
-		eventQuantity = order.SellQuantity
-		gap := order.BuyQuantity - order.SellQuantity
-		if gap >= leftQuantity {
-			price = (order.SellPrice*float32(order.SellQuantity) + req.TradePrice*float32(req.Quantity)) / float32(
-				order.SellQuantity+req.Quantity,
-			)
-			mergedQuantity = leftQuantity
-			leftQuantity = 0
-		} else {
-			price = (order.SellPrice*float32(order.SellQuantity) + req.TradePrice*float32(gap)) / float32(order.SellQuantity+gap)
-			mergedQuantity = gap
-			leftQuantity -= gap
-		}
-		eventQuantity += mergedQuantity
+	costPrice float32,
+) (uint64, error) {
+	method := req.LotMethod
+	if method == "" {
+		method = domain.LotMethodFIFO
 	}
 
-	err := order.Change(
-		req.OrderType,
-		req.StockID,
-		req.ExchangeDate,
-		price,
-		eventQuantity,
-	)
+	merged := min(order.OpenQuantity(), pendingQuantity)
+	err := order.MatchLot(req.OrderType, req.ExchangeDate, req.TradePrice, merged, costPrice, method)
 	if err != nil {
-		s.logger.Error().Err(err).Msg("failed to change order")
+		s.logger.Error().Err(err).Msg("failed to match lot")
+
+		return 0, err
 	}
 
-	return mergedQuantity, leftQuantity
+	return merged, nil
 }
 
 func (s *serviceImpl) chainTransactions(
-	orderID uuid.UUID,
-	userID uuid.UUID,
+	order *domain.Order,
+	broker *domain.BrokerProfile,
+	lotType string,
 	price float32,
 	quantity uint64,
 	orderType string,
 	partialCloseOrClose bool,
 	dayTrade bool,
 ) (chainedTransactions []*domain.Transaction, err error) {
+	amount := price * float32(quantity)
 	debitAmount, creditAmount := float32(0.0), float32(0.0)
 	switch orderType {
 	case domain.OrderTypeBuy:
-		debitAmount = price * float32(quantity) * taiwanStockQuantity
+		debitAmount = amount
 	case domain.OrderTypeSell:
-		creditAmount = price * float32(quantity) * taiwanStockQuantity
+		creditAmount = amount
 	}
 
 	transaction, err := domain.NewTransaction(
-		userID,
+		order.UserID,
 		orderType,
 		creditAmount,
 		debitAmount,
-		orderID,
+		order.ID,
 	)
 	if err != nil {
 		return chainedTransactions, err
 	}
 
-	chainedTransactions = append(chainedTransactions, transaction)
-
-	tax, err := s.genTaxTransaction(
-		orderID,
-		userID,
-		price,
-		quantity,
-		orderType,
-		partialCloseOrClose,
-		dayTrade,
-	)
+	// the funds of the broker are credited before the trade debits the balance
+	financing, settlement, err := marginTransactions(order, price, quantity, orderType)
 	if err != nil {
 		return chainedTransactions, err
-	} else if tax != nil {
+	}
+
+	chainedTransactions = append(chainedTransactions, financing...)
+	chainedTransactions = append(chainedTransactions, transaction)
+	chainedTransactions = append(chainedTransactions, settlement...)
+
+	// only charge tax on partial order close or complete order close
+	if partialCloseOrClose {
+		tax, err := domain.NewTransaction(
+			order.UserID,
+			domain.OrderTypeTax,
+			0,
+			broker.Tax(order.StockID, amount, lotType, dayTrade),
+			order.ID,
+		)
+		if err != nil {
+			return chainedTransactions, err
+		}
 		chainedTransactions = append(chainedTransactions, tax)
 	}
 
-	fee, err := s.genFeeTransaction(orderID, userID, price, quantity, orderType)
+	fee, err := domain.NewTransaction(
+		order.UserID,
+		domain.OrderTypeFee,
+		0,
+		broker.Fee(amount, lotType),
+		order.ID,
+	)
 	if err != nil {
 		return chainedTransactions, err
-	} else if fee != nil {
-		chainedTransactions = append(chainedTransactions, fee)
+	}
+	chainedTransactions = append(chainedTransactions, fee)
+
+	// monthly rebate brokers credit the discount back once the month is over
+	if rebateAmount := broker.Rebate(amount, lotType); rebateAmount > 0 {
+		rebate, err := domain.NewTransaction(
+			order.UserID,
+			domain.OrderTypeRebate,
+			rebateAmount,
+			0,
+			order.ID,
+		)
+		if err != nil {
+			return chainedTransactions, err
+		}
+		chainedTransactions = append(chainedTransactions, rebate)
 	}
 
 	return chainedTransactions, nil
 }
 
-func (s *serviceImpl) genTaxTransaction(
-	orderID uuid.UUID,
-	userID uuid.UUID,
+// marginTransactions chains the broker financing of a margined trade, the
+// financing is credited before the trade and the settlement is booked after
+// it. Closing trades settle at the opening price of the position.
+func marginTransactions(
+	order *domain.Order,
 	price float32,
 	quantity uint64,
 	orderType string,
-	partialCloseOrClose bool,
-	dayTrade bool,
-) (*domain.Transaction, error) {
-	// only charge tax on partial order close or complete order close
-	if partialCloseOrClose {
-		debitAmount := float32(0.0)
-		if orderType == domain.OrderTypeBuy {
-			debitAmount = price * float32(quantity) * taiwanStockQuantity * taxRate
-		} else if orderType == domain.OrderTypeSell {
-			debitAmount = price * float32(quantity) * taiwanStockQuantity * taxRate
-		}
-		if dayTrade {
-			debitAmount *= dayTradeTaxRate
-		}
+) (financing, settlement []*domain.Transaction, err error) {
+	type entry struct {
+		orderType string
+		amount    float32
+		credit    bool
+	}
 
-		output, err := domain.NewTransaction(
-			userID,
-			domain.OrderTypeTax,
-			0,
-			debitAmount,
-			orderID,
+	var before, after []entry
+	switch {
+	case order.TradeType == domain.TradeTypeMargin && orderType == domain.OrderTypeBuy:
+		before = append(before, entry{domain.OrderTypeMarginLoan, domain.MarginLoan(price, quantity), true})
+	case order.TradeType == domain.TradeTypeMargin && orderType == domain.OrderTypeSell:
+		after = append(after,
+			entry{domain.OrderTypeMarginRepay, domain.MarginLoan(order.BuyPrice, quantity), false},
+			entry{domain.OrderTypeMarginInterest, order.MarginInterest(quantity, order.SellExchangeDate), false},
 		)
-
-		return output, err
+	case order.TradeType == domain.TradeTypeShort && orderType == domain.OrderTypeSell:
+		after = append(after,
+			entry{domain.OrderTypeShortCollateral, domain.ShortCollateral(price, quantity), false},
+			entry{domain.OrderTypeShortBorrowFee, domain.ShortBorrowFee(price, quantity), false},
+		)
+	case order.TradeType == domain.TradeTypeShort && orderType == domain.OrderTypeBuy:
+		before = append(before, entry{domain.OrderTypeShortRelease, domain.ShortCollateral(order.SellPrice, quantity), true})
 	}
 
-	//nolint:nilnil // this is a special case
-	return nil, nil
-}
+	newTransactions := func(entries []entry) ([]*domain.Transaction, error) {
+		transactions := make([]*domain.Transaction, 0, len(entries))
+		for _, e := range entries {
+			if e.amount <= 0 {
+				continue
+			}
 
-func (s *serviceImpl) genFeeTransaction(
-	orderID uuid.UUID,
-	userID uuid.UUID,
-	price float32,
-	quantity uint64,
-	orderType string,
-) (*domain.Transaction, error) {
-	debitAmount := float32(0.0)
-	if orderType == domain.OrderTypeBuy {
-		debitAmount = price * float32(quantity) * taiwanStockQuantity * feeRate * brokerFeeDiscount
-	} else if orderType == domain.OrderTypeSell {
-		debitAmount = price * float32(quantity) * taiwanStockQuantity * feeRate * brokerFeeDiscount
+			creditAmount, debitAmount := float32(0), e.amount
+			if e.credit {
+				creditAmount, debitAmount = e.amount, 0
+			}
+
+			transaction, err := domain.NewTransaction(order.UserID, e.orderType, creditAmount, debitAmount, order.ID)
+			if err != nil {
+				return transactions, err
+			}
+			transactions = append(transactions, transaction)
+		}
+
+		return transactions, nil
 	}
 
-	output, err := domain.NewTransaction(
-		userID,
-		domain.OrderTypeFee,
-		0,
-		debitAmount,
-		orderID,
-	)
+	if financing, err = newTransactions(before); err != nil {
+		return nil, nil, err
+	}
+
+	settlement, err = newTransactions(after)
 
-	return output, err
+	return financing, settlement, err
 }
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"math"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+)
+
+// ImportOrders reads the trade confirmations of a broker statement and books
+// the fills not booked yet through CreateOrder in the order they were
+// traded. A dry run only reports the status of every fill. The import stops
+// at the first fill failing to book, the fills booked before it are kept and
+// skipped as duplicates by the next import, which only books the rest of a
+// fill booked in part.
+func (s *serviceImpl) ImportOrders(
+	ctx context.Context,
+	req *dto.ImportOrdersRequest,
+) ([]*domain.TradeConfirmation, error) {
+	parser, err := domain.NewStatementParser(req.Broker)
+	if err != nil {
+		return nil, err
+	}
+
+	trades, err := parser.Parse(bytes.NewReader(req.Content))
+	if err != nil {
+		return nil, err
+	}
+
+	domain.SortTradeConfirmations(trades)
+
+	stockIDs := []string{}
+	seen := make(map[string]bool)
+	for _, trade := range trades {
+		if trade.Status == domain.ImportStatusNew && !seen[trade.StockID] {
+			seen[trade.StockID] = true
+			stockIDs = append(stockIDs, trade.StockID)
+		}
+	}
+
+	if len(stockIDs) > 0 {
+		orders, err := s.dal.ListOrders(ctx, &domain.ListOrdersParams{
+			UserID:   s.currentUserID,
+			StockIDs: stockIDs,
+			Limit:    math.MaxInt32,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err = s.fillLotMatches(ctx, orders); err != nil {
+			return nil, err
+		}
+
+		domain.DedupeTradeConfirmations(trades, orders)
+	}
+
+	if req.DryRun {
+		return trades, nil
+	}
+
+	for _, trade := range trades {
+		if trade.Status != domain.ImportStatusNew {
+			continue
+		}
+
+		if err := s.importTrade(ctx, trade); err != nil {
+			trade.Status = domain.ImportStatusFailed
+			trade.Err = err
+
+			break
+		}
+		trade.Status = domain.ImportStatusCreated
+	}
+
+	return trades, nil
+}
+
+// importTrade books the fill as board lots, the shares left over a board lot
+// are booked as an odd lot. The board lots are booked first, so a fill booked
+// in part by an earlier import only has its odd lot left.
+func (s *serviceImpl) importTrade(ctx context.Context, trade *domain.TradeConfirmation) error {
+	quantity := trade.Quantity - trade.Booked
+	lots := quantity / domain.BoardLotShares
+	oddShares := quantity % domain.BoardLotShares
+
+	req := &dto.CreateOrderRequest{
+		OrderType:    trade.OrderType,
+		StockID:      trade.StockID,
+		ExchangeDate: trade.ExchangeDate,
+		TradeType:    trade.TradeType,
+		TradePrice:   trade.Price,
+	}
+
+	if lots > 0 {
+		boardReq := *req
+		boardReq.LotType = domain.LotTypeBoard
+		boardReq.Quantity = lots
+		if err := s.CreateOrder(ctx, &boardReq); err != nil {
+			return err
+		}
+	}
+
+	if oddShares > 0 {
+		oddReq := *req
+		oddReq.LotType = domain.LotTypeOddLot
+		oddReq.Quantity = oddShares
+		if err := s.CreateOrder(ctx, &oddReq); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/rs/zerolog"
+	adapter "github.com/samwang0723/jarvis/internal/app/adapter/mocks"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportOrders_PartiallyBooked(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := zerolog.Nop()
+	userID := uuid.Must(uuid.NewV4())
+	dal := adapter.NewMockAdapter(ctrl)
+	s := &serviceImpl{dal: dal, logger: &logger, currentUserID: userID}
+
+	// the board lot of the fill was booked, the odd lot failed
+	board, err := domain.NewOrder(userID, domain.OrderTypeBuy, domain.LotTypeBoard, domain.TradeTypeCash,
+		"2330", "20240102", 590.5, 1000, nil)
+	assert.NoError(t, err)
+
+	dal.EXPECT().ListOrders(gomock.Any(), gomock.Any()).Return([]*domain.Order{board}, nil)
+	dal.EXPECT().ListLotMatches(gomock.Any(), gomock.Any()).Return(nil, nil)
+	dal.EXPECT().ListOpenOrders(gomock.Any(), userID, "2330", domain.OrderTypeBuy).Return(nil, nil)
+	dal.EXPECT().GetUserBrokerProfile(gomock.Any(), userID).Return(domain.DefaultBrokerProfile(), nil)
+
+	// only the odd lot is booked again
+	var booked []*domain.Order
+	dal.EXPECT().CreateOrder(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, orders []*domain.Order, _ []*domain.Transaction) error {
+			booked = orders
+
+			return nil
+		})
+
+	trades, err := s.ImportOrders(context.Background(), &dto.ImportOrdersRequest{
+		Broker:  "generic",
+		Content: []byte("date,stock_id,side,price,quantity\n20240102,2330,buy,590.5,1500\n"),
+	})
+	assert.NoError(t, err)
+
+	assert.Len(t, trades, 1)
+	assert.Equal(t, domain.ImportStatusCreated, trades[0].Status)
+	assert.Equal(t, uint64(1000), trades[0].Booked)
+
+	assert.Len(t, booked, 1)
+	assert.Equal(t, domain.LotTypeOddLot, booked[0].LotType)
+	assert.Equal(t, uint64(500), booked[0].BuyQuantity)
+}
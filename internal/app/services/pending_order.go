@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+	"github.com/samwang0723/jarvis/internal/helper"
+)
+
+const positionClosedReason = "position closed"
+
+func (s *serviceImpl) placePendingOrder(ctx context.Context, req *dto.CreateOrderRequest) error {
+	exchangeDate := req.ExchangeDate
+	if exchangeDate == "" {
+		exchangeDate = helper.Today()
+	}
+
+	quantity := req.Quantity
+	positionID := uuid.Nil
+	tradeType := req.TradeType
+	if req.Kind == domain.OrderKindTakeProfit {
+		position, err := s.takeProfitPosition(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		openQuantity := position.OpenQuantity()
+		if quantity == 0 {
+			quantity = openQuantity
+		}
+
+		if quantity > openQuantity {
+			return errInvalidPosition
+		}
+		positionID = position.ID
+		tradeType = position.TradeType
+	}
+
+	order, err := domain.NewPendingOrder(
+		s.currentUserID,
+		req.Kind,
+		req.OrderType,
+		req.LotType,
+		tradeType,
+		req.StockID,
+		exchangeDate,
+		quantity,
+		req.LimitPrice,
+		req.StopPrice,
+		positionID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.dal.CreateOrder(ctx, []*domain.Order{order}, nil)
+}
+
+// takeProfitPosition loads the open position a take-profit order closes.
+func (s *serviceImpl) takeProfitPosition(
+	ctx context.Context,
+	req *dto.CreateOrderRequest,
+) (*domain.Order, error) {
+	id, err := uuid.FromString(req.PositionID)
+	if err != nil {
+		return nil, errInvalidPosition
+	}
+
+	position, err := s.dal.GetOrder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if position.UserID != s.currentUserID || position.StockID != req.StockID ||
+		!position.IsOpen() || position.ClosingSide() != req.OrderType {
+		return nil, errInvalidPosition
+	}
+
+	return position, nil
+}
+
+// simulatePendingOrders matches the pending orders of every stock quoted by the
+// bars, filled orders are booked as positions of their owners.
+func (s *serviceImpl) simulatePendingOrders(ctx context.Context, bars []*domain.PriceBar) error {
+	stockBars := make(map[string]*domain.PriceBar, len(bars))
+	for _, bar := range bars {
+		stockBars[bar.StockID] = bar
+	}
+
+	orders, err := s.dal.ListPendingOrders(ctx, helper.Keys(stockBars))
+	if err != nil {
+		return err
+	}
+
+	for _, order := range orders {
+		if err := s.matchPendingOrder(ctx, order, stockBars[order.StockID]); err != nil {
+			s.logger.Error().Err(err).Str("order", order.ID.String()).Msg("failed to match pending order")
+		}
+	}
+
+	return nil
+}
+
+func (s *serviceImpl) matchPendingOrder(
+	ctx context.Context,
+	order *domain.Order,
+	bar *domain.PriceBar,
+) error {
+	quantity := order.Quantity
+	if order.Kind == domain.OrderKindTakeProfit {
+		position, err := s.dal.GetOrder(ctx, order.PositionID)
+		if err != nil {
+			return err
+		}
+
+		if !position.IsOpen() {
+			if err := order.Cancel(positionClosedReason); err != nil {
+				return err
+			}
+
+			return s.dal.CreateOrder(ctx, []*domain.Order{order}, nil)
+		}
+
+		// the position may have been partially closed by other orders since
+		quantity = min(quantity, position.OpenQuantity())
+	}
+
+	price, triggered, filled := order.Match(bar)
+	if triggered {
+		if err := order.Trigger(bar.ExchangeDate, price); err != nil {
+			return err
+		}
+	}
+
+	if !filled {
+		if triggered {
+			return s.dal.CreateOrder(ctx, []*domain.Order{order}, nil)
+		}
+
+		return nil
+	}
+
+	if err := order.Fill(bar.ExchangeDate, price, quantity); err != nil {
+		return err
+	}
+
+	saveOrders, transactions, err := s.bookOrder(ctx, order.UserID, &dto.CreateOrderRequest{
+		OrderType:    order.Side,
+		LotType:      order.LotType,
+		TradeType:    order.TradeType,
+		StockID:      order.StockID,
+		ExchangeDate: bar.ExchangeDate,
+		TradePrice:   price,
+		Quantity:     quantity,
+	}, order.PositionID)
+	if err != nil {
+		return err
+	}
+
+	return s.dal.CreateOrder(ctx, append(saveOrders, order), transactions)
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+	"github.com/samwang0723/jarvis/internal/helper"
+)
+
+const snapshotUserBatch = 100
+
+// SnapshotEquity records the cash, the market value and the equity of every
+// user at the close of today along with the TAIEX close. A user failing the
+// snapshot is skipped so the others are still recorded.
+func (s *serviceImpl) SnapshotEquity(ctx context.Context) error {
+	exchangeDate := helper.Today()
+
+	var benchmarkClose float32
+	if quote, err := s.quoteProvider.Quote(ctx, domain.BenchmarkQuoteKey); err != nil {
+		s.logger.Warn().Err(err).Msg("failed to fetch benchmark quote")
+	} else {
+		benchmarkClose = quote.Close
+	}
+
+	for offset := int32(0); ; offset += snapshotUserBatch {
+		users, err := s.dal.ListUsers(ctx, snapshotUserBatch, offset)
+		if err != nil {
+			return err
+		}
+
+		for _, user := range users {
+			if err := s.snapshotEquity(ctx, user, exchangeDate, benchmarkClose); err != nil {
+				s.logger.Error().Err(err).Msgf("failed to snapshot equity: %s", user.ID.ID)
+			}
+		}
+
+		if len(users) < snapshotUserBatch {
+			return nil
+		}
+	}
+}
+
+func (s *serviceImpl) snapshotEquity(
+	ctx context.Context,
+	user *domain.User,
+	exchangeDate string,
+	benchmarkClose float32,
+) error {
+	portfolio, err := s.portfolio(ctx, user.ID.ID)
+	if err != nil {
+		return err
+	}
+
+	netDeposits, err := s.dal.GetNetDeposits(ctx, user.ID.ID)
+	if err != nil {
+		return err
+	}
+
+	snapshot := domain.NewEquitySnapshot(exchangeDate, portfolio, netDeposits, benchmarkClose)
+
+	return s.dal.UpsertEquitySnapshot(ctx, snapshot)
+}
+
+func (s *serviceImpl) GetPerformance(
+	ctx context.Context,
+	req *dto.GetPerformanceRequest,
+) (*domain.Performance, error) {
+	snapshots, err := s.dal.ListEquitySnapshots(ctx, s.currentUserID, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewPerformance(snapshots), nil
+}
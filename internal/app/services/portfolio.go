@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	config "github.com/samwang0723/jarvis/configs"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+	"github.com/samwang0723/jarvis/internal/helper"
+)
+
+// GetPortfolio consolidates the open positions of the user per stock, priced
+// like ListOrders with the latest close overridden by the realtime quotes.
+func (s *serviceImpl) GetPortfolio(ctx context.Context) (*domain.Portfolio, error) {
+	return s.portfolio(ctx, s.currentUserID)
+}
+
+func (s *serviceImpl) portfolio(ctx context.Context, userID uuid.UUID) (*domain.Portfolio, error) {
+	orders, err := s.dal.ListOpenPositions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := s.dal.GetBalanceView(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(orders) == 0 {
+		return domain.NewPortfolio(userID, orders, balance), nil
+	}
+
+	m := helper.SliceToMap(orders, func(order *domain.Order) string {
+		return order.StockID
+	})
+	stockIDs := helper.Keys(m)
+
+	prices, err := s.dal.ListLatestPrice(ctx, stockIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	stocks, _, err := s.ListStock(ctx, &dto.ListStockRequest{
+		SearchParams: &dto.ListStockSearchParams{
+			StockIDs: &stockIDs,
+			Country:  "TW",
+		},
+		Offset: 0,
+		Limit:  int32(len(stockIDs)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string, len(stocks))
+	for _, stock := range stocks {
+		names[stock.ID] = stock.Name
+	}
+
+	latest := make(map[string]float32, len(prices))
+	for _, price := range prices {
+		latest[price.StockID] = price.Price
+	}
+
+	for _, order := range orders {
+		order.StockName = names[order.StockID]
+		if price, ok := latest[order.StockID]; ok {
+			order.CurrentPrice = price
+		}
+	}
+
+	// skip redis fetch if no redis in cluster
+	if config.GetCurrentConfig().RedisCache.Master != "" {
+		s.fillRealtimePrice(ctx, orders)
+	}
+
+	return domain.NewPortfolio(userID, orders, balance), nil
+}
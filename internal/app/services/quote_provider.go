@@ -0,0 +1,167 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+)
+
+const (
+	misQuoteURI = "https://mis.twse.com.tw/stock/api/getStockInfo.jsp?ex_ch=%s"
+	marketTWSE  = "tse"
+	marketTPEx  = "otc"
+
+	replayMaxLineSize = 1024 * 1024
+)
+
+// QuoteProvider fetches the latest quote of a monitoring key, formatted as
+// <market>_<stockID>.tw. errQuoteNotTraded is returned before the first trade
+// of the session.
+type QuoteProvider interface {
+	Quote(ctx context.Context, key string) (*domain.Realtime, error)
+}
+
+// misQuoteProvider reads the exchange MIS endpoint, which publishes both TWSE
+// listed (tse) and TPEx OTC (otc) quotes under their own channel prefix.
+type misQuoteProvider struct {
+	client *http.Client
+	uri    string
+}
+
+func NewMisQuoteProvider(client *http.Client) QuoteProvider {
+	return &misQuoteProvider{client: client, uri: misQuoteURI}
+}
+
+func (p *misQuoteProvider) Quote(ctx context.Context, key string) (*domain.Realtime, error) {
+	if market := quoteMarket(key); market != marketTWSE && market != marketTPEx {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedQuoteKey, key)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(p.uri, key), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header = http.Header{
+		"Content-Type": []string{"text/csv;charset=ms950"},
+		"Connection":   []string{"close"},
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("response status code is not 2xx: %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseMisQuote(data)
+}
+
+// parseMisQuote reads a payload of the MIS endpoint as it was received.
+func parseMisQuote(data []byte) (*domain.Realtime, error) {
+	raw := strings.ReplaceAll(string(data), "\n", "")
+	raw = strings.ReplaceAll(raw, "\\\"", "\"")
+
+	quote, err := domain.ParseMisRealtime([]byte(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	if quote.Close == 0.0 {
+		return nil, errQuoteNotTraded
+	}
+
+	return quote, nil
+}
+
+// replayQuoteProvider feeds recorded MIS payloads, one response of the
+// endpoint per line, through the parser of the live quotes so the realtime
+// path runs offline and after hours. Each call for a stock returns its next
+// recorded quote and keeps the last one once the recording is exhausted.
+type replayQuoteProvider struct {
+	payloads map[string][][]byte
+	cursor   map[string]int
+	mu       sync.Mutex
+}
+
+func NewReplayQuoteProvider(path string) (QuoteProvider, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	p := &replayQuoteProvider{
+		payloads: make(map[string][][]byte),
+		cursor:   make(map[string]int),
+	}
+
+	scanner := bufio.NewScanner(file)
+	// a payload carries the whole quote board of the stock
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), replayMaxLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		// the stock is read from the payload, quotes not traded yet included
+		quote, err := domain.ParseMisRealtime([]byte(text))
+		if err != nil {
+			return nil, fmt.Errorf("replay line %d: %w", line, err)
+		}
+
+		p.payloads[quote.StockID] = append(p.payloads[quote.StockID], []byte(text))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (p *replayQuoteProvider) Quote(_ context.Context, key string) (*domain.Realtime, error) {
+	stockID := quoteStockID(key)
+
+	p.mu.Lock()
+	payloads := p.payloads[stockID]
+	if len(payloads) == 0 {
+		p.mu.Unlock()
+
+		return nil, errQuoteNotTraded
+	}
+
+	idx := min(p.cursor[stockID], len(payloads)-1)
+	p.cursor[stockID] = idx + 1
+	p.mu.Unlock()
+
+	return parseMisQuote(payloads[idx])
+}
+
+func quoteMarket(key string) string {
+	market, _, _ := strings.Cut(key, "_")
+
+	return market
+}
+
+func quoteStockID(key string) string {
+	_, symbol, _ := strings.Cut(key, "_")
+	stockID, _, _ := strings.Cut(symbol, ".")
+
+	return stockID
+}
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// misPayload is a response of the MIS endpoint for the stock, the price is
+// "-" before the first trade.
+func misPayload(stockID, price, parseTime string) string {
+	return `{"msgArray":[{"c":"` + stockID + `","d":"20240315","o":"780.0000","h":"785.0000",` +
+		`"l":"779.0000","z":"` + price + `","y":"778.0000","v":"1200","t":"` + parseTime + `"}],"rtcode":"0000"}`
+}
+
+func TestReplayQuoteProvider(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "quotes.jsonl")
+	recording := strings.Join([]string{
+		misPayload("2330", "780.0000", "09:00:05"),
+		``,
+		misPayload("6488", "-", "09:00:05"),
+		misPayload("2330", "782.0000", "09:00:10"),
+		misPayload("6488", "512.0000", "09:00:10"),
+	}, "\n")
+	assert.NoError(t, os.WriteFile(path, []byte(recording), 0o600))
+
+	provider, err := NewReplayQuoteProvider(path)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	tests := []struct {
+		want  error
+		name  string
+		key   string
+		close float32
+	}{
+		{name: "first recorded quote", key: "tse_2330.tw", close: 780},
+		{name: "recorded before the first trade", key: "otc_6488.tw", want: errQuoteNotTraded},
+		{name: "next recorded quote", key: "tse_2330.tw", close: 782},
+		{name: "other stock keeps its own cursor", key: "otc_6488.tw", close: 512},
+		{name: "exhausted recording keeps the last quote", key: "tse_2330.tw", close: 782},
+		{name: "stock not recorded", key: "tse_1101.tw", want: errQuoteNotTraded},
+	}
+
+	// the cursor moves on every call, so the cases run in order
+	for _, tt := range tests {
+		quote, err := provider.Quote(ctx, tt.key)
+		if tt.want != nil {
+			assert.ErrorIs(t, err, tt.want, tt.name)
+
+			continue
+		}
+
+		assert.NoError(t, err, tt.name)
+		assert.Equal(t, tt.close, quote.Close, tt.name)
+		assert.Equal(t, float32(778), quote.PreviousClose, tt.name)
+		assert.Equal(t, uint64(1200), quote.Volume, tt.name)
+	}
+}
+
+func TestNewReplayQuoteProvider_Errors(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewReplayQuoteProvider(filepath.Join(t.TempDir(), "missing.jsonl"))
+	assert.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "quotes.jsonl")
+	assert.NoError(t, os.WriteFile(path, []byte(misPayload("2330", "780.0000", "09:00:05")+"\n"+`{"msgArray":[`), 0o600))
+
+	_, err = NewReplayQuoteProvider(path)
+	assert.ErrorContains(t, err, "replay line 2")
+
+	// the recording holds the payloads of the endpoint, not parsed quotes
+	assert.NoError(t, os.WriteFile(path, []byte(`{"stockID":"2330","close":780}`), 0o600))
+
+	_, err = NewReplayQuoteProvider(path)
+	assert.ErrorContains(t, err, "replay line 1")
+}
+
+func TestMisQuoteProvider(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		stockID := quoteStockID(r.URL.Query().Get("ex_ch"))
+		price := "782.0000"
+		if stockID == "6488" {
+			// not traded yet in the session
+			price = "-"
+		}
+		_, _ = w.Write([]byte(misPayload(stockID, price, "09:00:10")))
+	}))
+	// the parallel subtests outlive the test function
+	t.Cleanup(server.Close)
+
+	provider := &misQuoteProvider{client: server.Client(), uri: server.URL + "?ex_ch=%s"}
+
+	tests := []struct {
+		want    error
+		name    string
+		key     string
+		stockID string
+		close   float32
+	}{
+		{name: "listed quote", key: "tse_2330.tw", stockID: "2330", close: 782},
+		{name: "otc quote", key: "otc_3293.tw", stockID: "3293", close: 782},
+		{name: "otc quote before the first trade", key: "otc_6488.tw", want: errQuoteNotTraded},
+		{name: "unknown market", key: "nyse_tsm.us", want: errUnsupportedQuoteKey},
+		{name: "no market prefix", key: "2330", want: errUnsupportedQuoteKey},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			quote, err := provider.Quote(context.Background(), tt.key)
+			if tt.want != nil {
+				assert.ErrorIs(t, err, tt.want)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.close, quote.Close)
+			assert.Equal(t, tt.stockID, quote.StockID)
+		})
+	}
+}
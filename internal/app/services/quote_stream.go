@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"sync"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+)
+
+const (
+	realtimeQuoteChannel  = "real_time_quotes"
+	quoteSubscriberBuffer = 64
+)
+
+type quoteSubscriber struct {
+	stockIDs map[string]struct{}
+	ch       chan *domain.Realtime
+}
+
+func (q *quoteSubscriber) wants(stockID string) bool {
+	if len(q.stockIDs) == 0 {
+		return true
+	}
+
+	_, ok := q.stockIDs[stockID]
+
+	return ok
+}
+
+// quoteHub fans out realtime quotes to the streams subscribed on this instance.
+type quoteHub struct {
+	subscribers map[uint64]*quoteSubscriber
+	nextID      uint64
+	mu          sync.RWMutex
+}
+
+func newQuoteHub() *quoteHub {
+	return &quoteHub{
+		subscribers: make(map[uint64]*quoteSubscriber),
+	}
+}
+
+func (h *quoteHub) subscribe(stockIDs []string) (uint64, <-chan *domain.Realtime) {
+	sub := &quoteSubscriber{
+		stockIDs: make(map[string]struct{}, len(stockIDs)),
+		ch:       make(chan *domain.Realtime, quoteSubscriberBuffer),
+	}
+	for _, id := range stockIDs {
+		sub.stockIDs[id] = struct{}{}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.nextID++
+	h.subscribers[h.nextID] = sub
+
+	return h.nextID, sub.ch
+}
+
+func (h *quoteHub) unsubscribe(id uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if sub, ok := h.subscribers[id]; ok {
+		delete(h.subscribers, id)
+		close(sub.ch)
+	}
+}
+
+// publish never blocks the crawler, quotes are dropped for subscribers whose
+// buffer is full.
+func (h *quoteHub) publish(quote *domain.Realtime) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, sub := range h.subscribers {
+		if !sub.wants(quote.StockID) {
+			continue
+		}
+
+		select {
+		case sub.ch <- quote:
+		default:
+		}
+	}
+}
+
+// SubscribeQuotes streams the realtime quotes of the stocks until the context
+// is done, an empty list subscribes to every monitored stock.
+func (s *serviceImpl) SubscribeQuotes(
+	ctx context.Context,
+	stockIDs []string,
+) (<-chan *domain.Realtime, error) {
+	if s.cache == nil {
+		return nil, errQuoteStreamUnavailable
+	}
+
+	id, quotes := s.quotes.subscribe(stockIDs)
+	go func() {
+		<-ctx.Done()
+		s.quotes.unsubscribe(id)
+	}()
+
+	return quotes, nil
+}
+
+// ListeningRealtimeQuotes relays the quotes published by whichever instance
+// holds the crawler lock to the local subscribers.
+func (s *serviceImpl) ListeningRealtimeQuotes(ctx context.Context) error {
+	messages, err := s.cache.Subscribe(ctx, realtimeQuoteChannel)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		s.logger.Info().Str("component", "quote_stream").Msg("goroutine starting")
+		defer s.logger.Info().Str("component", "quote_stream").Msg("goroutine exited")
+
+		for raw := range messages {
+			quote := &domain.Realtime{}
+			if err := json.Unmarshal([]byte(raw), quote); err != nil || quote.Close == 0.0 {
+				s.logger.Error().Err(err).Str("component", "quote_stream").Msg("unmarshal realtime error")
+				continue
+			}
+
+			s.quotes.publish(quote)
+		}
+	}()
+
+	return nil
+}
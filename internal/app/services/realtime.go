@@ -2,10 +2,8 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"io"
-	"net/http"
-	"strings"
 	"time"
 
 	"github.com/samwang0723/jarvis/internal/app/domain"
@@ -14,7 +12,6 @@ import (
 )
 
 const (
-	realTimePriceURI           = "https://mis.twse.com.tw/stock/api/getStockInfo.jsp?ex_ch=%s"
 	realTimeMonitoringKey      = "real_time_monitoring_keys"
 	defaultCacheExpire         = 7 * 24 * time.Hour
 	defaultRealtimeCacheExpire = 24 * time.Hour
@@ -47,48 +44,51 @@ func (t *RealTimeMonitoringTemplate) Execute(ctx context.Context) error {
 }
 
 func (t *RealTimeMonitoringTemplate) processKey(ctx context.Context, key string) {
-	uri := fmt.Sprintf(realTimePriceURI, key)
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	quote, err := t.service.quoteProvider.Quote(ctx, key)
 	if err != nil {
-		t.service.logger.Error().Err(err).Msg("failed to create request")
+		if !errors.Is(err, errQuoteNotTraded) {
+			t.service.logger.Error().Err(err).Msgf("failed to fetch quote: %s", key)
+		}
 		return
 	}
 
-	req.Header = http.Header{
-		"Content-Type": []string{"text/csv;charset=ms950"},
-		"Connection":   []string{"close"},
-	}
-	resp, err := t.service.proxyClient.Do(req)
+	data, err := json.Marshal(quote)
 	if err != nil {
-		t.service.logger.Error().Err(err).Msgf("failed to do request: %s", key)
+		t.service.logger.Error().Err(err).Msgf("failed to marshal quote: %s", key)
 		return
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		t.service.logger.Warn().
-			Msgf("response status code is not 2xx: %d, key: %s", resp.StatusCode, key)
+	rawStr := string(data)
+	redisKey := fmt.Sprintf("%s:%s:temp:%s", realTimeMonitoringKey, helper.Today(), key)
+	err = t.service.cache.Set(ctx, redisKey, rawStr, defaultRealtimeCacheExpire)
+	if err != nil {
 		return
 	}
 
-	data, err := io.ReadAll(resp.Body)
+	// notify the quote streams of every instance
+	err = t.service.cache.Publish(ctx, realtimeQuoteChannel, rawStr)
 	if err != nil {
-		t.service.logger.Error().Err(err).Msgf("failed to read response body: %s", key)
-		return
+		t.service.logger.Warn().Err(err).Msgf("failed to publish realtime quote: %s", key)
 	}
 
-	raw := string(data)
-	rawStr := strings.ReplaceAll(raw, "\n", "")
-	rawStr = strings.ReplaceAll(rawStr, "\\\"", "\"")
-	if strings.Contains(rawStr, `"z":"-"`) {
-		return
+	err = t.service.recordIntradayTick(ctx, quote)
+	if err != nil {
+		t.service.logger.Error().Err(err).Msgf("failed to record intraday tick: %s", key)
 	}
 
-	redisKey := fmt.Sprintf("%s:%s:temp:%s", realTimeMonitoringKey, helper.Today(), key)
-	err = t.service.cache.Set(ctx, redisKey, rawStr, defaultRealtimeCacheExpire)
+	err = t.service.evaluateAlerts(ctx, quote)
 	if err != nil {
-		return
+		t.service.logger.Error().Err(err).Msgf("failed to evaluate alerts: %s", key)
+	}
+
+	err = t.service.evaluateMargins(ctx, quote)
+	if err != nil {
+		t.service.logger.Error().Err(err).Msgf("failed to evaluate margins: %s", key)
+	}
+
+	err = t.service.simulatePendingOrders(ctx, []*domain.PriceBar{domain.NewPriceBarFromRealtime(quote)})
+	if err != nil {
+		t.service.logger.Error().Err(err).Msgf("failed to simulate pending orders: %s", key)
 	}
 }
 
@@ -121,6 +121,11 @@ func (s *serviceImpl) ListRealTimeSelections(
 	ctx context.Context,
 	req *dto.ListSelectionRequest,
 ) ([]*domain.Selection, error) {
+	strategy, err := s.strategies.Get(req.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
 	statSnapshot, err := s.latestStockStatSnapshot(ctx)
 	if err != nil {
 		s.logger.Error().Err(err).Msg("get latest stock stat snapshot failed")
@@ -134,8 +139,15 @@ func (s *serviceImpl) ListRealTimeSelections(
 	}
 
 	realtimeList := s.parseRealtimeData(redisRes)
-	selections := s.mergeRealtimeToSelection(realtimeList, statSnapshot)
-	objs, err := s.executeAnalysisEngine(ctx, selections, req.Strict, req.Date)
+	selections := s.mergeRealtimeToSelection(realtimeList, statSnapshot, &strategy.Candidates)
+	objs, err := s.executeAnalysisEngine(
+		ctx,
+		selections,
+		strategy,
+		req.Strict,
+		req.Adjusted,
+		req.Date,
+	)
 	if err != nil {
 		s.logger.Error().Err(err).Msg("advanced filtering failed")
 		return nil, err
@@ -151,7 +163,7 @@ func (s *serviceImpl) parseRealtimeData(redisRes []string) (realtimeList []domai
 		}
 
 		realtime := &domain.Realtime{}
-		if err := realtime.UnmarshalJSON([]byte(raw)); err != nil || realtime.Close == 0.0 {
+		if err := json.Unmarshal([]byte(raw), realtime); err != nil || realtime.Close == 0.0 {
 			s.logger.Error().Err(err).Msg("unmarshal realtime error")
 			continue
 		}
@@ -162,14 +174,16 @@ func (s *serviceImpl) parseRealtimeData(redisRes []string) (realtimeList []domai
 	return realtimeList
 }
 
+// mergeRealtimeToSelection turns the live quotes into the selections of the
+// session, keeping the candidates of the strategy.
 func (s *serviceImpl) mergeRealtimeToSelection(
 	realtimeList []domain.Realtime,
 	chips map[string]*domain.Selection,
+	candidates *domain.SelectionCandidates,
 ) (selections []*domain.Selection) {
 	for _, realtime := range realtimeList {
 		history := chips[realtime.StockID]
-		if history == nil || (realtime.Close/realtime.High) <= closeToHighestToday ||
-			realtime.Volume < realtimeVolume {
+		if history == nil || !realtimeCandidate(&realtime, candidates) {
 			continue
 		}
 
@@ -201,3 +215,13 @@ func (s *serviceImpl) mergeRealtimeToSelection(
 
 	return selections
 }
+
+// realtimeCandidate applies the thresholds of the candidates to the session so
+// far, the quote volume is in board lots.
+func realtimeCandidate(realtime *domain.Realtime, candidates *domain.SelectionCandidates) bool {
+	if candidates.MinCloseToHigh > 0 && realtime.Close/realtime.High <= candidates.MinCloseToHigh {
+		return false
+	}
+
+	return realtime.Volume*domain.BoardLotShares >= uint64(candidates.MinTradeShares)
+}
@@ -107,7 +107,7 @@ func (s *serviceImpl) fetchRealtimePrice(ctx context.Context) map[string]*domain
 			}
 
 			realtime := &domain.Realtime{}
-			e := realtime.UnmarshalJSON([]byte(raw))
+			e := json.Unmarshal([]byte(raw), realtime)
 			if e != nil || realtime.Close == 0.0 {
 				s.logger.Error().Err(e).Msgf("unmarshal realtime error: %s", raw)
 
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+)
+
+// GetAnnualReport totals the fees, the taxes and the realized profit loss of
+// the user by month of the year.
+func (s *serviceImpl) GetAnnualReport(
+	ctx context.Context,
+	req *dto.GetAnnualReportRequest,
+) (*domain.AnnualReport, error) {
+	start := time.Date(int(req.Year), time.January, 1, 0, 0, 0, 0, time.Local)
+	transactions, err := s.dal.ListUserTransactions(ctx, s.currentUserID, start, start.AddDate(1, 0, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := s.closedPositions(ctx, fmt.Sprintf("%04d0101", req.Year), fmt.Sprintf("%04d1231", req.Year))
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewAnnualReport(int(req.Year), transactions, orders)
+}
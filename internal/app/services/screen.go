@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+	"github.com/samwang0723/jarvis/internal/app/screener"
+)
+
+func (s *serviceImpl) CreateScreen(ctx context.Context, obj *domain.Screen) error {
+	if _, err := screener.Compile(obj.Expression); err != nil {
+		return err
+	}
+
+	obj.UserID = s.currentUserID
+
+	return s.dal.CreateScreen(ctx, obj)
+}
+
+func (s *serviceImpl) ListScreens(ctx context.Context) ([]*domain.Screen, error) {
+	return s.dal.ListScreens(ctx, s.currentUserID)
+}
+
+func (s *serviceImpl) RunScreen(
+	ctx context.Context,
+	req *dto.RunScreenRequest,
+) ([]*domain.Selection, error) {
+	screenID, err := uuid.FromString(req.ScreenID)
+	if err != nil {
+		return nil, err
+	}
+
+	screen, err := s.dal.GetScreenByID(ctx, s.currentUserID, screenID)
+	if err != nil {
+		return nil, err
+	}
+
+	expr, err := screener.Compile(screen.Expression)
+	if err != nil {
+		return nil, err
+	}
+
+	date := req.Date
+	if date == "" {
+		date = s.dal.GetStakeConcentrationLatestDataPoint(ctx)
+	}
+
+	// a screen runs over the whole market of the day
+	selections, err := s.dal.ListSelections(ctx, date, &domain.SelectionCandidates{})
+	if err != nil {
+		s.logger.Error().Err(err).Msg("run screen data record retrieval")
+		return nil, err
+	}
+
+	return s.executeAnalysisEngine(
+		ctx,
+		selections,
+		screenStrategy(screen, expr),
+		false,
+		req.Adjusted,
+		date,
+	)
+}
+
+// screenStrategy wraps a compiled screen so the analysis engine can evaluate
+// it like any registered strategy.
+func screenStrategy(screen *domain.Screen, expr *screener.Expression) *ScreeningStrategy {
+	inputs := []Indicator{IndicatorPriceMA, IndicatorVolumeMV, IndicatorThreePrimary}
+	if expr.Uses(screener.FieldHighest) {
+		inputs = append(inputs, IndicatorHighestPrice)
+	}
+
+	return &ScreeningStrategy{
+		Name:   screen.Name,
+		Inputs: inputs,
+		Predicates: []Predicate{
+			func(in *StrategyInput) bool {
+				return expr.Match(&screener.SelectionEnv{
+					Selection: in.Selection,
+					Analysis:  in.Analysis,
+					Highest:   in.Highest,
+				})
+			},
+		},
+	}
+}
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/rs/zerolog"
+	adapter "github.com/samwang0723/jarvis/internal/app/adapter/mocks"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunScreen(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := zerolog.Nop()
+	dal := adapter.NewMockAdapter(ctrl)
+	s := &serviceImpl{dal: dal, logger: &logger, currentUserID: uuid.Must(uuid.NewV4())}
+
+	screenID := uuid.Must(uuid.NewV4())
+	dal.EXPECT().GetScreenByID(gomock.Any(), s.currentUserID, screenID).
+		Return(&domain.Screen{Name: "cheap", Expression: "close < 20"}, nil)
+	// a quiet stock the strategy prefilters would leave out
+	dal.EXPECT().ListSelections(gomock.Any(), "20240315", &domain.SelectionCandidates{}).
+		Return([]*domain.Selection{
+			{StockID: "1101", ExchangeDate: "20240315", Close: 15, Volume: 12},
+			{StockID: "2330", ExchangeDate: "20240315", Close: 600, Volume: 30000},
+		}, nil)
+	dal.EXPECT().RetrieveDailyCloseHistory(gomock.Any(), gomock.Any(), "20240315").
+		Return([]*domain.DailyClose{
+			{StockID: "1101", ExchangeDate: "20240315", Close: 15},
+			{StockID: "2330", ExchangeDate: "20240315", Close: 600},
+		}, nil)
+	dal.EXPECT().RetrieveThreePrimaryHistory(gomock.Any(), gomock.Any(), "20240315").Return(nil, nil)
+
+	selections, err := s.RunScreen(context.Background(), &dto.RunScreenRequest{
+		ScreenID: screenID.String(),
+		Date:     "20240315",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, selections, 1)
+	assert.Equal(t, "1101", selections[0].StockID)
+}
@@ -9,11 +9,6 @@ import (
 	"github.com/samwang0723/jarvis/internal/helper"
 )
 
-const (
-	closeToHighestToday = 0.985
-	realtimeVolume      = 3000
-)
-
 // Define the SelectionStrategy interface
 type SelectionStrategy interface {
 	ListSelections(ctx context.Context, req *dto.ListSelectionRequest) ([]*domain.Selection, error)
@@ -45,13 +40,25 @@ func (h *HistoricalSelectionStrategy) ListSelections(
 	ctx context.Context,
 	req *dto.ListSelectionRequest,
 ) ([]*domain.Selection, error) {
-	selections, err := h.service.dal.ListSelections(ctx, req.Date, req.Strict)
+	strategy, err := h.service.strategies.Get(req.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	selections, err := h.service.dal.ListSelections(ctx, req.Date, &strategy.Candidates)
 	if err != nil {
 		h.service.logger.Error().Err(err).Msg("list selections data record retrieval")
 		return nil, err
 	}
 
-	objs, err := h.service.executeAnalysisEngine(ctx, selections, req.Strict, req.Date)
+	objs, err := h.service.executeAnalysisEngine(
+		ctx,
+		selections,
+		strategy,
+		req.Strict,
+		req.Adjusted,
+		req.Date,
+	)
 	if err != nil {
 		h.service.logger.Error().Err(err).Msg("list selections advanced filtering")
 		return nil, err
@@ -29,6 +29,7 @@ import (
 	"github.com/samwang0723/jarvis/internal/cache"
 	"github.com/samwang0723/jarvis/internal/cronjob"
 	"github.com/samwang0723/jarvis/internal/kafka/ikafka"
+	"github.com/samwang0723/jarvis/internal/notifier"
 )
 
 //go:generate mockgen -source=services.go -destination=mocks/services.go -package=services
@@ -53,6 +54,7 @@ type IService interface {
 		req *dto.GetStakeConcentrationRequest,
 	) (*domain.StakeConcentration, error)
 	BatchUpsertStakeConcentration(ctx context.Context, objs *[]any) error
+	BatchUpsertCorporateActions(ctx context.Context, objs *[]any) error
 	ListeningKafkaInput(ctx context.Context)
 	StopKafka() error
 	StopRedis() error
@@ -61,6 +63,24 @@ type IService interface {
 	AddJob(ctx context.Context, spec string, job func()) error
 	CronjobPresetRealtimeMonitoringKeys(ctx context.Context) error
 	CrawlingRealTimePrice(ctx context.Context) error
+	ListeningRealtimeQuotes(ctx context.Context) error
+	SubscribeQuotes(ctx context.Context, stockIDs []string) (<-chan *domain.Realtime, error)
+	ListIntradayBars(
+		ctx context.Context,
+		req *dto.ListIntradayBarsRequest,
+	) ([]*domain.IntradayBar, error)
+	CreateAlertRule(ctx context.Context, obj *domain.AlertRule) error
+	ListAlertRules(ctx context.Context) ([]*domain.AlertRule, error)
+	UpdateAlertRule(ctx context.Context, obj *domain.AlertRule) error
+	DeleteAlertRule(ctx context.Context, id string) error
+	CreateBrokerProfile(ctx context.Context, obj *domain.BrokerProfile) error
+	ListBrokerProfiles(ctx context.Context) ([]*domain.BrokerProfile, error)
+	GetBrokerProfile(ctx context.Context) (*domain.BrokerProfile, error)
+	SelectBrokerProfile(ctx context.Context, id uuid.UUID) error
+	SettleFeeRebates(ctx context.Context) error
+	DistributeCorporateActions(ctx context.Context) error
+	ListEntitlements(ctx context.Context) ([]*domain.Entitlement, error)
+	SubscribeRights(ctx context.Context, entitlementID uuid.UUID) error
 	BatchUpsertPickedStocks(ctx context.Context, objs []*domain.PickedStock) error
 	DeletePickedStockByID(ctx context.Context, stockID string) error
 	ListPickedStock(ctx context.Context) ([]*domain.Selection, error)
@@ -78,16 +98,34 @@ type IService interface {
 	GetUserByPhone(ctx context.Context, phone string) (obj *domain.User, err error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (obj *domain.User, err error)
 	GetBalance(ctx context.Context) (obj *domain.BalanceView, err error)
+	GetPortfolio(ctx context.Context) (*domain.Portfolio, error)
+	SnapshotEquity(ctx context.Context) error
+	GetPerformance(ctx context.Context, req *dto.GetPerformanceRequest) (*domain.Performance, error)
+	GetAnnualReport(ctx context.Context, req *dto.GetAnnualReportRequest) (*domain.AnnualReport, error)
 	CreateTransaction(
 		ctx context.Context,
 		orderType string,
 		creditAmount, debitAmount float32,
 	) error
 	CreateOrder(ctx context.Context, req *dto.CreateOrderRequest) error
+	CancelOrder(ctx context.Context, id uuid.UUID) error
+	AmendOrder(ctx context.Context, id uuid.UUID, req *dto.AmendOrderRequest) error
+	ImportOrders(ctx context.Context, req *dto.ImportOrdersRequest) ([]*domain.TradeConfirmation, error)
+	CreateJournalEntry(
+		ctx context.Context,
+		orderID uuid.UUID,
+		req *dto.CreateJournalEntryRequest,
+	) (*domain.JournalEntry, error)
+	ListJournalEntries(ctx context.Context, orderID uuid.UUID) ([]*domain.JournalEntry, error)
+	GetTradeStats(ctx context.Context, req *dto.GetTradeStatsRequest) ([]*domain.TradeStats, error)
 	ListOrders(
 		ctx context.Context,
 		req *dto.ListOrderRequest,
 	) (objs []*domain.Order, totalCount int64, err error)
+	CreateScreen(ctx context.Context, obj *domain.Screen) error
+	ListScreens(ctx context.Context) ([]*domain.Screen, error)
+	RunScreen(ctx context.Context, req *dto.RunScreenRequest) ([]*domain.Selection, error)
+	RunBacktest(ctx context.Context, req *dto.RunBacktestRequest) (*domain.BacktestReport, error)
 	WithUserID(ctx context.Context) IService
 }
 
@@ -102,12 +140,22 @@ type serviceImpl struct {
 	cronjob       cronjob.Cronjob
 	logger        *zerolog.Logger
 	proxyClient   *http.Client
+	quoteProvider QuoteProvider
+	notifiers     map[string]notifier.Notifier
+	strategies    *StrategyRegistry
+	quotes        *quoteHub
 	currentUserID uuid.UUID
 }
 
 //nolint:gosec // skip tls verification
 func New(opts ...Option) IService {
-	impl := &serviceImpl{}
+	impl := &serviceImpl{
+		strategies: NewStrategyRegistry(defaultStrategies()...),
+		quotes:     newQuoteHub(),
+		notifiers: map[string]notifier.Notifier{
+			domain.AlertChannelWebhook: notifier.NewWebhook(nil),
+		},
+	}
 	for _, opt := range opts {
 		opt(impl)
 	}
@@ -121,6 +169,10 @@ func New(opts ...Option) IService {
 		}
 	}
 
+	if impl.quoteProvider == nil {
+		impl.quoteProvider = NewMisQuoteProvider(impl.proxyClient)
+	}
+
 	return impl
 }
 
@@ -0,0 +1,287 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/samwang0723/jarvis/internal/app/domain"
+)
+
+const (
+	StrategyBreakout      = "breakout"
+	StrategyPullbackToMA  = "pullback-to-MA21"
+	StrategyVolumeDryUp   = "volume-dry-up"
+	defaultStrategy       = StrategyBreakout
+	pullbackRangePercent  = 0.02
+	volumeDryUpMultiplier = 0.5
+)
+
+// Indicator declares which analysis input a screening strategy relies on.
+type Indicator string
+
+const (
+	IndicatorPriceMA      Indicator = "price_ma"
+	IndicatorVolumeMV     Indicator = "volume_mv"
+	IndicatorHighestPrice Indicator = "highest_price"
+	IndicatorThreePrimary Indicator = "three_primary"
+)
+
+// StrategyInput bundles the values a predicate is evaluated against.
+type StrategyInput struct {
+	Selection *domain.Selection
+	Analysis  *domain.Analysis
+	Highest   float32
+}
+
+// Predicate is a single pass/fail rule of a screening strategy.
+type Predicate func(in *StrategyInput) bool
+
+// ScreeningStrategy is a named set of rules applied by the analysis engine.
+// Predicates must all pass for a stock to be selected, StrictPredicates are
+// additionally checked when the request asks for strict mode. Only the
+// Inputs are loaded for the predicates.
+type ScreeningStrategy struct {
+	Name   string
+	Inputs []Indicator
+	// Candidates are the stocks of the day the predicates run on.
+	Candidates       domain.SelectionCandidates
+	Predicates       []Predicate
+	StrictPredicates []Predicate
+}
+
+func (s *ScreeningStrategy) Requires(indicator Indicator) bool {
+	for _, i := range s.Inputs {
+		if i == indicator {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *ScreeningStrategy) Match(in *StrategyInput, strict bool) bool {
+	for _, p := range s.Predicates {
+		if !p(in) {
+			return false
+		}
+	}
+
+	if !strict {
+		return true
+	}
+
+	for _, p := range s.StrictPredicates {
+		if !p(in) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// StrategyRegistry keeps the screening strategies addressable by name.
+type StrategyRegistry struct {
+	strategies map[string]*ScreeningStrategy
+	mu         sync.RWMutex
+}
+
+func NewStrategyRegistry(strategies ...*ScreeningStrategy) *StrategyRegistry {
+	r := &StrategyRegistry{
+		strategies: make(map[string]*ScreeningStrategy),
+	}
+	for _, s := range strategies {
+		r.strategies[s.Name] = s
+	}
+
+	return r
+}
+
+func (r *StrategyRegistry) Register(strategy *ScreeningStrategy) error {
+	if strategy == nil || strategy.Name == "" || len(strategy.Predicates) == 0 {
+		return errInvalidStrategy
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.strategies[strategy.Name]; ok {
+		return fmt.Errorf("%w: %s", errStrategyAlreadyExists, strategy.Name)
+	}
+	r.strategies[strategy.Name] = strategy
+
+	return nil
+}
+
+func (r *StrategyRegistry) Get(name string) (*ScreeningStrategy, error) {
+	if name == "" {
+		name = defaultStrategy
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	strategy, ok := r.strategies[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errStrategyNotFound, name)
+	}
+
+	return strategy, nil
+}
+
+func (r *StrategyRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.strategies))
+	for name := range r.strategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func defaultStrategies() []*ScreeningStrategy {
+	return []*ScreeningStrategy{
+		breakoutStrategy(),
+		pullbackToMAStrategy(),
+		volumeDryUpStrategy(),
+	}
+}
+
+// breakoutStrategy closes near the half-year high with enough weekly volume
+// and stays above all moving averages.
+func breakoutStrategy() *ScreeningStrategy {
+	return &ScreeningStrategy{
+		Name: StrategyBreakout,
+		Candidates: domain.SelectionCandidates{
+			MinConcentrationDays: minConcentrationDays,
+			MinTradeShares:       minDailyVolume,
+			MinAvgVolume:         minWeeklyVolume,
+			MinCloseToHigh:       closeToHighestToday,
+		},
+		Inputs: []Indicator{
+			IndicatorPriceMA,
+			IndicatorVolumeMV,
+			IndicatorHighestPrice,
+			IndicatorThreePrimary,
+		},
+		Predicates: []Predicate{
+			closeNearDailyHigh,
+			closeNearHighest,
+			weeklyVolumeAbove(minWeeklyVolume),
+			closeAboveMA,
+		},
+		StrictPredicates: []Predicate{
+			volumeMVAligned,
+			priceMAAligned,
+		},
+	}
+}
+
+// pullbackToMAStrategy looks for an uptrend retracing to MA21 while the
+// longer term trend is still intact.
+func pullbackToMAStrategy() *ScreeningStrategy {
+	return &ScreeningStrategy{
+		Name: StrategyPullbackToMA,
+		// a pullback retraces on quiet days, it keeps the weekly liquidity of a
+		// breakout but none of its daily volume or intraday thresholds
+		Candidates: domain.SelectionCandidates{
+			MinConcentrationDays: minConcentrationDays,
+		},
+		Inputs: []Indicator{
+			IndicatorPriceMA,
+			IndicatorVolumeMV,
+			IndicatorThreePrimary,
+		},
+		Predicates: []Predicate{
+			weeklyVolumeAbove(minWeeklyVolume),
+			func(in *StrategyInput) bool {
+				ma := in.Analysis.MA21
+				return ma > 0 &&
+					in.Selection.Close >= ma &&
+					in.Selection.Close <= ma*(1+pullbackRangePercent)
+			},
+			func(in *StrategyInput) bool {
+				return in.Analysis.MA21 > in.Analysis.MA55
+			},
+		},
+		StrictPredicates: []Predicate{
+			func(in *StrategyInput) bool {
+				return in.Analysis.MA8 > in.Analysis.MA21
+			},
+			func(in *StrategyInput) bool {
+				return in.Analysis.MV5 < in.Analysis.MV13
+			},
+		},
+	}
+}
+
+// volumeDryUpStrategy finds stocks holding above their averages while the
+// weekly volume contracts to half of the 34 days average.
+func volumeDryUpStrategy() *ScreeningStrategy {
+	return &ScreeningStrategy{
+		Name: StrategyVolumeDryUp,
+		// the contracting volume is what the strategy looks for, no volume
+		// thresholds apply
+		Candidates: domain.SelectionCandidates{
+			MinConcentrationDays: minConcentrationDays,
+		},
+		Inputs: []Indicator{
+			IndicatorPriceMA,
+			IndicatorVolumeMV,
+			IndicatorHighestPrice,
+			IndicatorThreePrimary,
+		},
+		Predicates: []Predicate{
+			func(in *StrategyInput) bool {
+				return in.Analysis.MV34 > 0 &&
+					float64(in.Analysis.MV5) <= float64(in.Analysis.MV34)*volumeDryUpMultiplier
+			},
+			func(in *StrategyInput) bool {
+				return in.Selection.Close > in.Analysis.MA21 &&
+					in.Selection.Close > in.Analysis.MA55
+			},
+		},
+		StrictPredicates: []Predicate{
+			closeNearHighest,
+			func(in *StrategyInput) bool {
+				return in.Analysis.MA21 > in.Analysis.MA55
+			},
+		},
+	}
+}
+
+func closeNearDailyHigh(in *StrategyInput) bool {
+	return float64(in.Selection.Close/in.Selection.High) >= dailyHighestRangePercent
+}
+
+func closeNearHighest(in *StrategyInput) bool {
+	if in.Highest == 0 {
+		return false
+	}
+
+	return math.Abs(1.0-float64(in.Selection.Close/in.Highest)) <= highestRangePercent
+}
+
+func weeklyVolumeAbove(volume uint64) Predicate {
+	return func(in *StrategyInput) bool {
+		return in.Analysis.MV5 >= volume
+	}
+}
+
+func closeAboveMA(in *StrategyInput) bool {
+	return in.Selection.Close > in.Analysis.MA8 &&
+		in.Selection.Close > in.Analysis.MA21 &&
+		in.Selection.Close > in.Analysis.MA55
+}
+
+func volumeMVAligned(in *StrategyInput) bool {
+	return in.Analysis.MV5 > in.Analysis.MV13 && in.Analysis.MV13 > in.Analysis.MV34
+}
+
+func priceMAAligned(in *StrategyInput) bool {
+	return in.Analysis.MA8 > in.Analysis.MA21 && in.Analysis.MA21 > in.Analysis.MA55
+}
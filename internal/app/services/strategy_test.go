@@ -0,0 +1,379 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/rs/zerolog"
+	adapter "github.com/samwang0723/jarvis/internal/app/adapter/mocks"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrategyRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry := NewStrategyRegistry(defaultStrategies()...)
+
+	strategy, err := registry.Get("")
+	assert.NoError(t, err)
+	assert.Equal(t, StrategyBreakout, strategy.Name)
+
+	_, err = registry.Get("cup-and-handle")
+	assert.ErrorIs(t, err, errStrategyNotFound)
+
+	assert.Equal(t, []string{StrategyBreakout, StrategyPullbackToMA, StrategyVolumeDryUp}, registry.Names())
+
+	tests := []struct {
+		strategy *ScreeningStrategy
+		want     error
+		name     string
+	}{
+		{name: "nil strategy", strategy: nil, want: errInvalidStrategy},
+		{name: "no name", strategy: &ScreeningStrategy{Predicates: []Predicate{closeAboveMA}}, want: errInvalidStrategy},
+		{name: "no predicates", strategy: &ScreeningStrategy{Name: "empty"}, want: errInvalidStrategy},
+		{
+			name:     "name taken",
+			strategy: &ScreeningStrategy{Name: StrategyBreakout, Predicates: []Predicate{closeAboveMA}},
+			want:     errStrategyAlreadyExists,
+		},
+		{name: "registered", strategy: &ScreeningStrategy{Name: "above-ma", Predicates: []Predicate{closeAboveMA}}},
+	}
+
+	for _, tt := range tests {
+		err := registry.Register(tt.strategy)
+		if tt.want != nil {
+			assert.ErrorIs(t, err, tt.want, tt.name)
+		} else {
+			assert.NoError(t, err, tt.name)
+		}
+	}
+
+	_, err = registry.Get("above-ma")
+	assert.NoError(t, err)
+}
+
+// strategyInput prices a stock closing at 100 and lets each case adjust it.
+func strategyInput(adjust func(in *StrategyInput)) *StrategyInput {
+	in := &StrategyInput{
+		Selection: &domain.Selection{Close: 100, High: 101},
+		Analysis: &domain.Analysis{
+			MA8:  98,
+			MA21: 95,
+			MA55: 90,
+			MV5:  1200000,
+			MV13: 1100000,
+			MV34: 1000000,
+		},
+		Highest: 102,
+	}
+	if adjust != nil {
+		adjust(in)
+	}
+
+	return in
+}
+
+type strategyCase struct {
+	in     *StrategyInput
+	name   string
+	match  bool
+	strict bool
+}
+
+func testStrategy(t *testing.T, strategy *ScreeningStrategy, tests []strategyCase) {
+	t.Helper()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := strategy.Match(tt.in, false); got != tt.match {
+				t.Errorf("Match() = %v, want %v", got, tt.match)
+			}
+
+			if got := strategy.Match(tt.in, true); got != tt.strict {
+				t.Errorf("Match(strict) = %v, want %v", got, tt.strict)
+			}
+		})
+	}
+}
+
+func TestBreakoutStrategy(t *testing.T) {
+	t.Parallel()
+
+	testStrategy(t, breakoutStrategy(), []strategyCase{
+		{name: "breakout", in: strategyInput(nil), match: true, strict: true},
+		{
+			name:  "close far from the daily high",
+			in:    strategyInput(func(in *StrategyInput) { in.Selection.High = 110 }),
+			match: false, strict: false,
+		},
+		{
+			name:  "close far from the highest",
+			in:    strategyInput(func(in *StrategyInput) { in.Highest = 120 }),
+			match: false, strict: false,
+		},
+		{
+			name:  "highest unknown",
+			in:    strategyInput(func(in *StrategyInput) { in.Highest = 0 }),
+			match: false, strict: false,
+		},
+		{
+			name:  "weekly volume too thin",
+			in:    strategyInput(func(in *StrategyInput) { in.Analysis.MV5 = 900000 }),
+			match: false, strict: false,
+		},
+		{
+			name:  "close below MA21",
+			in:    strategyInput(func(in *StrategyInput) { in.Analysis.MA21 = 101 }),
+			match: false, strict: false,
+		},
+		{
+			name:  "volume averages not aligned",
+			in:    strategyInput(func(in *StrategyInput) { in.Analysis.MV13 = 1300000 }),
+			match: true, strict: false,
+		},
+		{
+			name:  "price averages not aligned",
+			in:    strategyInput(func(in *StrategyInput) { in.Analysis.MA8 = 94 }),
+			match: true, strict: false,
+		},
+	})
+}
+
+func TestPullbackToMAStrategy(t *testing.T) {
+	t.Parallel()
+
+	pullback := func(adjust func(in *StrategyInput)) *StrategyInput {
+		return strategyInput(func(in *StrategyInput) {
+			in.Analysis.MA8 = 101
+			in.Analysis.MA21 = 99
+			in.Analysis.MA55 = 95
+			in.Analysis.MV5 = 1000000
+			in.Analysis.MV13 = 1200000
+			if adjust != nil {
+				adjust(in)
+			}
+		})
+	}
+
+	testStrategy(t, pullbackToMAStrategy(), []strategyCase{
+		{name: "pullback to MA21", in: pullback(nil), match: true, strict: true},
+		{
+			name:  "close too far above MA21",
+			in:    pullback(func(in *StrategyInput) { in.Analysis.MA21 = 97 }),
+			match: false, strict: false,
+		},
+		{
+			name:  "close below MA21",
+			in:    pullback(func(in *StrategyInput) { in.Analysis.MA21 = 101 }),
+			match: false, strict: false,
+		},
+		{
+			name:  "MA21 below MA55",
+			in:    pullback(func(in *StrategyInput) { in.Analysis.MA55 = 100 }),
+			match: false, strict: false,
+		},
+		{
+			name:  "weekly volume too thin",
+			in:    pullback(func(in *StrategyInput) { in.Analysis.MV5 = 500000 }),
+			match: false, strict: false,
+		},
+		{
+			name:  "MA8 below MA21",
+			in:    pullback(func(in *StrategyInput) { in.Analysis.MA8 = 98 }),
+			match: true, strict: false,
+		},
+		{
+			name:  "volume expanding",
+			in:    pullback(func(in *StrategyInput) { in.Analysis.MV13 = 900000 }),
+			match: true, strict: false,
+		},
+	})
+}
+
+func TestVolumeDryUpStrategy(t *testing.T) {
+	t.Parallel()
+
+	dryUp := func(adjust func(in *StrategyInput)) *StrategyInput {
+		return strategyInput(func(in *StrategyInput) {
+			in.Analysis.MV5 = 900000
+			in.Analysis.MV34 = 2000000
+			if adjust != nil {
+				adjust(in)
+			}
+		})
+	}
+
+	testStrategy(t, volumeDryUpStrategy(), []strategyCase{
+		{name: "volume dried up", in: dryUp(nil), match: true, strict: true},
+		{
+			name:  "volume above half of MV34",
+			in:    dryUp(func(in *StrategyInput) { in.Analysis.MV5 = 1100000 }),
+			match: false, strict: false,
+		},
+		{
+			name:  "MV34 unknown",
+			in:    dryUp(func(in *StrategyInput) { in.Analysis.MV34 = 0 }),
+			match: false, strict: false,
+		},
+		{
+			name:  "close below MA55",
+			in:    dryUp(func(in *StrategyInput) { in.Analysis.MA55 = 101 }),
+			match: false, strict: false,
+		},
+		{
+			name:  "close far from the highest",
+			in:    dryUp(func(in *StrategyInput) { in.Highest = 120 }),
+			match: true, strict: false,
+		},
+		{
+			name:  "MA21 below MA55",
+			in:    dryUp(func(in *StrategyInput) { in.Analysis.MA55 = 96; in.Analysis.MA21 = 95 }),
+			match: true, strict: false,
+		},
+	})
+}
+
+func TestStrategiesApart(t *testing.T) {
+	t.Parallel()
+
+	// a stock closing at its high, well above MA21
+	breakout := strategyInput(nil)
+	// the same stock retraced to MA21 on a thinner week
+	pullback := strategyInput(func(in *StrategyInput) {
+		in.Selection.High = 104
+		in.Analysis.MA8 = 101
+		in.Analysis.MA21 = 99
+		in.Analysis.MV5 = 1000000
+	})
+
+	tests := []struct {
+		in       *StrategyInput
+		name     string
+		breakout bool
+		pullback bool
+	}{
+		{name: "breakout", in: breakout, breakout: true},
+		{name: "pullback", in: pullback, pullback: true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.breakout, breakoutStrategy().Match(tt.in, false), tt.name)
+		assert.Equal(t, tt.pullback, pullbackToMAStrategy().Match(tt.in, false), tt.name)
+	}
+}
+
+func TestMergeRealtimeToSelection(t *testing.T) {
+	t.Parallel()
+
+	logger := zerolog.Nop()
+	s := &serviceImpl{logger: &logger}
+
+	chips := map[string]*domain.Selection{
+		"2330": {StockID: "2330", Close: 98},
+		"2603": {StockID: "2603", Close: 50},
+		"1101": {StockID: "1101", Close: 40},
+	}
+	quotes := []domain.Realtime{
+		// closing at the high on heavy volume
+		{StockID: "2330", Close: 100, High: 100.5, Volume: 5000},
+		// retraced from the high of the session
+		{StockID: "2603", Close: 50, High: 52, Volume: 5000},
+		// quiet session
+		{StockID: "1101", Close: 40, High: 40, Volume: 800},
+		// no history
+		{StockID: "6488", Close: 500, High: 500, Volume: 5000},
+	}
+
+	tests := []struct {
+		strategy *ScreeningStrategy
+		want     []string
+	}{
+		{strategy: breakoutStrategy(), want: []string{"2330"}},
+		// the intraday thresholds of the breakout do not apply
+		{strategy: pullbackToMAStrategy(), want: []string{"2330", "2603", "1101"}},
+	}
+
+	for _, tt := range tests {
+		selections := s.mergeRealtimeToSelection(quotes, chips, &tt.strategy.Candidates)
+
+		stockIDs := make([]string, 0, len(selections))
+		for _, selection := range selections {
+			stockIDs = append(stockIDs, selection.StockID)
+		}
+		assert.Equal(t, tt.want, stockIDs, tt.strategy.Name)
+	}
+}
+
+func TestStrategyCandidates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		strategy *ScreeningStrategy
+		want     domain.SelectionCandidates
+	}{
+		{
+			strategy: breakoutStrategy(),
+			want: domain.SelectionCandidates{
+				MinConcentrationDays: minConcentrationDays,
+				MinTradeShares:       minDailyVolume,
+				MinAvgVolume:         minWeeklyVolume,
+				MinCloseToHigh:       closeToHighestToday,
+			},
+		},
+		// the strategies looking for quieter stocks are not prefiltered on volume
+		{strategy: pullbackToMAStrategy(), want: domain.SelectionCandidates{MinConcentrationDays: minConcentrationDays}},
+		{strategy: volumeDryUpStrategy(), want: domain.SelectionCandidates{MinConcentrationDays: minConcentrationDays}},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.strategy.Candidates, tt.strategy.Name)
+	}
+}
+
+func TestExecuteAnalysisEngine_Inputs(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := zerolog.Nop()
+	dal := adapter.NewMockAdapter(ctrl)
+	s := &serviceImpl{dal: dal, logger: &logger}
+
+	selections := []*domain.Selection{
+		{StockID: "2330", ExchangeDate: "20240315", Close: 100},
+		{StockID: "2317", ExchangeDate: "20240315", Close: 50},
+	}
+	everything := &ScreeningStrategy{
+		Name:       "everything",
+		Predicates: []Predicate{func(*StrategyInput) bool { return true }},
+	}
+
+	// no inputs are declared, so no history is loaded
+	output, err := s.executeAnalysisEngine(context.Background(), selections, everything, false, false, "20240315")
+	assert.NoError(t, err)
+	assert.Len(t, output, 2)
+
+	// only the price history is loaded for the moving averages
+	everything.Inputs = []Indicator{IndicatorPriceMA}
+	dal.EXPECT().RetrieveDailyCloseHistory(gomock.Any(), gomock.Any(), "20240315").
+		Return([]*domain.DailyClose{{StockID: "2330", ExchangeDate: "20240315", Close: 100}}, nil)
+
+	output, err = s.executeAnalysisEngine(context.Background(), selections, everything, false, false, "20240315")
+	assert.NoError(t, err)
+	// without a price history the stock cannot be analyzed
+	assert.Len(t, output, 1)
+	assert.Equal(t, "2330", output[0].StockID)
+
+	dal.EXPECT().RetrieveDailyCloseHistory(gomock.Any(), gomock.Any(), "20240315").
+		Return(nil, errors.New("connection refused"))
+
+	_, err = s.executeAnalysisEngine(context.Background(), selections, everything, false, false, "20240315")
+	assert.Error(t, err)
+}
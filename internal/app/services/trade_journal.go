@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/app/domain"
+	"github.com/samwang0723/jarvis/internal/app/dto"
+)
+
+// CreateJournalEntry attaches the entry to a position of the user, the setup
+// has to name a registered strategy or a screen of the user.
+func (s *serviceImpl) CreateJournalEntry(
+	ctx context.Context,
+	orderID uuid.UUID,
+	req *dto.CreateJournalEntryRequest,
+) (*domain.JournalEntry, error) {
+	if _, err := s.userOrder(ctx, orderID); err != nil {
+		return nil, err
+	}
+
+	if req.Strategy != "" {
+		if _, err := s.strategies.Get(req.Strategy); err != nil {
+			return nil, err
+		}
+	}
+
+	screenID := uuid.Nil
+	if req.ScreenID != "" {
+		id, err := uuid.FromString(req.ScreenID)
+		if err != nil {
+			return nil, err
+		}
+
+		screen, err := s.dal.GetScreenByID(ctx, s.currentUserID, id)
+		if err != nil {
+			return nil, err
+		}
+		screenID = screen.ID.ID
+	}
+
+	entry, err := domain.NewJournalEntry(s.currentUserID, orderID, req.Notes, req.Tags, req.Strategy, screenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.dal.CreateJournalEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (s *serviceImpl) ListJournalEntries(ctx context.Context, orderID uuid.UUID) ([]*domain.JournalEntry, error) {
+	return s.dal.ListOrderJournalEntries(ctx, s.currentUserID, orderID)
+}
+
+// GetTradeStats breaks the positions closed between the dates down by the
+// tags or the setup of their journal entries.
+func (s *serviceImpl) GetTradeStats(
+	ctx context.Context,
+	req *dto.GetTradeStatsRequest,
+) ([]*domain.TradeStats, error) {
+	orders, err := s.closedPositions(ctx, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.dal.ListUserJournalEntries(ctx, s.currentUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	screens, err := s.dal.ListScreens(ctx, s.currentUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	screenNames := make(map[uuid.UUID]string, len(screens))
+	for _, screen := range screens {
+		screenNames[screen.ID.ID] = screen.Name
+	}
+
+	groupBy := req.GroupBy
+	if groupBy == "" {
+		groupBy = domain.TradeStatsByTag
+	}
+
+	return domain.NewTradeStats(groupBy, orders, entries, screenNames)
+}
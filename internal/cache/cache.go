@@ -39,6 +39,8 @@ type Redis interface {
 	Get(ctx context.Context, key string) (string, error)
 	MGet(ctx context.Context, keys ...string) ([]string, error)
 	ObtainLock(ctx context.Context, key string, expire time.Duration) *redislock.Lock
+	Publish(ctx context.Context, channel, message string) error
+	Subscribe(ctx context.Context, channel string) (<-chan string, error)
 	Close() error
 }
 
@@ -170,6 +172,53 @@ func (r *redisImpl) ObtainLock(
 	return lock
 }
 
+func (r *redisImpl) Publish(ctx context.Context, channel, message string) error {
+	if err := r.instance.Publish(ctx, channel, message).Err(); err != nil {
+		return xerrors.Errorf("cache Publish failed, channel=%s; err=%w;", channel, err)
+	}
+
+	return nil
+}
+
+// Subscribe delivers the payloads published on the channel until the context
+// is canceled, the returned channel is closed afterwards.
+func (r *redisImpl) Subscribe(ctx context.Context, channel string) (<-chan string, error) {
+	pubsub := r.instance.Subscribe(ctx, channel)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+
+		return nil, xerrors.Errorf("cache Subscribe failed, channel=%s; err=%w;", channel, err)
+	}
+
+	output := make(chan string)
+	go func() {
+		defer close(output)
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				select {
+				case output <- msg.Payload:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	r.cfg.Logger.Info().Msgf("cache Subscribe succeed, channel=%s;", channel)
+
+	return output, nil
+}
+
 func (r *redisImpl) Close() error {
 	if err := r.instance.Close(); err != nil {
 		return xerrors.Errorf("cache Close failed, err=%w;", err)
@@ -15,6 +15,7 @@ package cache
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"os"
 	"testing"
@@ -188,3 +189,70 @@ func TestSMembers(t *testing.T) {
 		})
 	}
 }
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		err     error
+		channel string
+		message string
+	}
+
+	tests := []struct {
+		args    args
+		name    string
+		wantErr bool
+	}{
+		{
+			name: "Redis Publish successfully",
+			args: args{
+				channel: "test",
+				message: "message",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Redis Publish failed",
+			args: args{
+				channel: "test",
+				message: "message",
+				err:     errors.New("publish failed"),
+			},
+			wantErr: true,
+		},
+	}
+
+	logger := log.With().Str("test", "redis").Logger()
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.TODO()
+
+			client, mock := redismock.NewClientMock()
+
+			impl := &redisImpl{
+				instance: client,
+				cfg: Config{
+					Logger: &logger,
+				},
+			}
+
+			expect := mock.ExpectPublish(tt.args.channel, tt.args.message)
+			if tt.args.err != nil {
+				expect.SetErr(tt.args.err)
+			} else {
+				expect.SetVal(1)
+			}
+
+			err := impl.Publish(ctx, tt.args.channel, tt.args.message)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Publish() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
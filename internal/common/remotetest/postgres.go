@@ -132,6 +132,28 @@ func (pg *PostgresContainer) createDB() error {
 }
 
 func (pg *PostgresContainer) RunMigrations() error {
+	return pg.migrate(func(migrator *migrate.Migrate) error {
+		if err := migrator.Up(); err != nil {
+			return fmt.Errorf("migrate up error: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// MigrateTo migrates the database up or down to the migration version, e.g.
+// to record data in the schema an older migration left behind.
+func (pg *PostgresContainer) MigrateTo(version uint) error {
+	return pg.migrate(func(migrator *migrate.Migrate) error {
+		if err := migrator.Migrate(version); err != nil {
+			return fmt.Errorf("migrate to %d error: %w", version, err)
+		}
+
+		return nil
+	})
+}
+
+func (pg *PostgresContainer) migrate(fn func(migrator *migrate.Migrate) error) error {
 	driver, err := iofs.New(database.MigrationFiles, "migrations")
 	if err != nil {
 		return fmt.Errorf("iofs error: %w", err)
@@ -148,12 +170,7 @@ func (pg *PostgresContainer) RunMigrations() error {
 
 	defer migrator.Close()
 
-	err = migrator.Up()
-	if err != nil {
-		return fmt.Errorf("migrate up error: %w", err)
-	}
-
-	return nil
+	return fn(migrator)
 }
 
 func (pg *PostgresContainer) Purge() error {
@@ -180,6 +197,21 @@ func (pg *PostgresContainer) Purge() error {
 func SetupPostgresClient(t *testing.T, runMigration bool) *pgxpool.Pool {
 	t.Helper()
 
+	server, client := SetupPostgresServer(t)
+
+	if runMigration {
+		if err := server.RunMigrations(); err != nil {
+			t.Fatalf("failed to run migrations: %v", err)
+		}
+	}
+
+	return client
+}
+
+// SetupPostgresServer creates an empty database, the server migrates it.
+func SetupPostgresServer(t *testing.T) (*PostgresContainer, *pgxpool.Pool) {
+	t.Helper()
+
 	server, err := CreatePostgres()
 	if err != nil {
 		t.Fatalf("failed to create postgres server: %v", err)
@@ -198,11 +230,5 @@ func SetupPostgresClient(t *testing.T, runMigration bool) *pgxpool.Pool {
 
 	t.Cleanup(client.Close)
 
-	if runMigration {
-		if err := server.RunMigrations(); err != nil {
-			t.Fatalf("failed to run migrations: %v", err)
-		}
-	}
-
-	return client
+	return server, client
 }
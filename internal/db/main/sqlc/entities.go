@@ -12,12 +12,35 @@ import (
 	uuid "github.com/gofrs/uuid/v5"
 )
 
+type AlertRule struct {
+	ID                uuid.UUID
+	UserID            uuid.UUID
+	StockID           string
+	Condition         string
+	Threshold         decimal.Big
+	Channel           string
+	Target            string
+	LastTriggeredDate string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         sql.NullTime
+}
+
 type BalanceEvent struct {
+	AggregateID    uuid.UUID
+	ParentID       uuid.UUID
+	EventType      string
+	Payload        []byte
+	Version        int32
+	CreatedAt      time.Time
+	GlobalPosition int64
+	TransactionID  interface{}
+}
+
+type BalanceSnapshot struct {
 	AggregateID uuid.UUID
-	ParentID    uuid.UUID
-	EventType   string
-	Payload     []byte
 	Version     int32
+	Payload     []byte
 	CreatedAt   time.Time
 }
 
@@ -31,6 +54,57 @@ type BalanceView struct {
 	UpdatedAt time.Time
 }
 
+type BrokerProfile struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	Name            string
+	RebateTiming    string
+	FeeRate         decimal.Big
+	FeeDiscount     decimal.Big
+	MinFee          decimal.Big
+	OddLotMinFee    decimal.Big
+	TaxRate         decimal.Big
+	DayTradeTaxRate decimal.Big
+	EtfTaxRate      decimal.Big
+	BondEtfTaxRate  decimal.Big
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       sql.NullTime
+}
+
+type CorporateAction struct {
+	ID            uuid.UUID
+	StockID       string
+	ExDate        string
+	CashDividend  decimal.Big
+	StockDividend decimal.Big
+	RightsRatio   decimal.Big
+	RightsPrice   decimal.Big
+	SplitRatio    decimal.Big
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     sql.NullTime
+	RecordDate    string
+	PaymentDate   string
+	DistributedAt sql.NullTime
+}
+
+type CorporateActionEntitlement struct {
+	ID                 uuid.UUID
+	CorporateActionID  uuid.UUID
+	OrderID            uuid.UUID
+	UserID             uuid.UUID
+	HeldQuantity       int64
+	StockShares        int64
+	RightsShares       int64
+	CashDividend       decimal.Big
+	CashInLieu         decimal.Big
+	Premium            decimal.Big
+	RightsCost         decimal.Big
+	CreatedAt          time.Time
+	RightsSubscribedAt sql.NullTime
+}
+
 type DailyClose struct {
 	ID           uuid.UUID
 	StockID      string
@@ -48,39 +122,163 @@ type DailyClose struct {
 	DeletedAt    sql.NullTime
 }
 
+type DailyProfitLoss struct {
+	OrderID      uuid.UUID
+	ExchangeDate string
+	UserID       uuid.UUID
+	StockID      string
+	ProfitLoss   decimal.Big
+}
+
+type EquitySnapshot struct {
+	UserID         uuid.UUID
+	ExchangeDate   string
+	Cash           decimal.Big
+	MarketValue    decimal.Big
+	Equity         decimal.Big
+	NetDeposits    decimal.Big
+	BenchmarkClose decimal.Big
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type IntradayTick struct {
+	ID           uuid.UUID
+	StockID      string
+	ExchangeDate string
+	TickTime     string
+	Open         decimal.Big
+	High         decimal.Big
+	Low          decimal.Big
+	Close        decimal.Big
+	Volume       int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    sql.NullTime
+}
+
+type JournalEntry struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	OrderID   uuid.UUID
+	Notes     string
+	Tags      []string
+	Strategy  string
+	ScreenID  uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt sql.NullTime
+}
+
+type LotMatch struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	OrderID      uuid.UUID
+	StockID      string
+	OrderType    string
+	Method       string
+	ExchangeDate string
+	Price        decimal.Big
+	CostPrice    decimal.Big
+	Quantity     int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    sql.NullTime
+}
+
 type Order struct {
-	ID               uuid.UUID
+	ID                 uuid.UUID
+	UserID             uuid.UUID
+	StockID            string
+	BuyPrice           decimal.Big
+	BuyQuantity        int64
+	BuyExchangeDate    string
+	SellPrice          decimal.Big
+	SellQuantity       int64
+	SellExchangeDate   string
+	ProfitablePrice    decimal.Big
+	Status             string
+	Version            int32
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	Kind               string
+	Side               string
+	Quantity           int64
+	LimitPrice         decimal.Big
+	StopPrice          decimal.Big
+	PositionID         uuid.UUID
+	PlacedExchangeDate string
+	FilledPrice        decimal.Big
+	FilledExchangeDate string
+	LotType            string
+	TradeType          string
+	Dividends          decimal.Big
+}
+
+type OrderEvent struct {
+	AggregateID    uuid.UUID
+	ParentID       uuid.UUID
+	EventType      string
+	Payload        []byte
+	Version        int32
+	CreatedAt      time.Time
+	GlobalPosition int64
+	TransactionID  interface{}
+}
+
+type OutboxMessage struct {
+	ID           int64
+	Topic        string
+	AggregateID  uuid.UUID
+	ParentID     uuid.UUID
+	Version      int32
+	EventType    string
+	Payload      []byte
+	CreatedAt    time.Time
+	ClaimedUntil sql.NullTime
+}
+
+type PickedStock struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	StockID   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt sql.NullTime
+}
+
+type PositionView struct {
+	OrderID          uuid.UUID
 	UserID           uuid.UUID
 	StockID          string
+	Status           string
 	BuyPrice         decimal.Big
 	BuyQuantity      int64
 	BuyExchangeDate  string
 	SellPrice        decimal.Big
 	SellQuantity     int64
 	SellExchangeDate string
-	ProfitablePrice  decimal.Big
-	Status           string
 	Version          int32
-	CreatedAt        time.Time
 	UpdatedAt        time.Time
 }
 
-type OrderEvent struct {
-	AggregateID uuid.UUID
-	ParentID    uuid.UUID
-	EventType   string
-	Payload     []byte
-	Version     int32
-	CreatedAt   time.Time
+type ProjectionCheckpoint struct {
+	Name           string
+	GlobalPosition int64
+	Retries        int32
+	LastError      sql.NullString
+	UpdatedAt      time.Time
+	TransactionID  interface{}
 }
 
-type PickedStock struct {
-	ID        uuid.UUID
-	UserID    uuid.UUID
-	StockID   string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt sql.NullTime
+type Screen struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	Expression string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeletedAt  sql.NullTime
 }
 
 type StakeConcentration struct {
@@ -139,12 +337,14 @@ type Transaction struct {
 }
 
 type TransactionEvent struct {
-	AggregateID uuid.UUID
-	ParentID    uuid.UUID
-	EventType   string
-	Payload     []byte
-	Version     int32
-	CreatedAt   time.Time
+	AggregateID    uuid.UUID
+	ParentID       uuid.UUID
+	EventType      string
+	Payload        []byte
+	Version        int32
+	CreatedAt      time.Time
+	GlobalPosition int64
+	TransactionID  interface{}
 }
 
 type User struct {
@@ -161,4 +361,5 @@ type User struct {
 	UpdatedAt        time.Time
 	SessionExpiredAt sql.NullTime
 	DeletedAt        sql.NullTime
+	BrokerProfileID  uuid.UUID
 }
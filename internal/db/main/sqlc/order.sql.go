@@ -13,7 +13,7 @@ import (
 )
 
 const GetOrder = `-- name: GetOrder :one
-SELECT id, user_id, stock_id, buy_price, buy_quantity, buy_exchange_date, sell_price, sell_quantity, sell_exchange_date, profitable_price, status, version, created_at, updated_at
+SELECT id, user_id, stock_id, buy_price, buy_quantity, buy_exchange_date, sell_price, sell_quantity, sell_exchange_date, profitable_price, status, version, created_at, updated_at, kind, side, quantity, limit_price, stop_price, position_id, placed_exchange_date, filled_price, filled_exchange_date, lot_type, trade_type, dividends
 FROM orders
 WHERE id = $1
 `
@@ -36,6 +36,18 @@ func (q *Queries) GetOrder(ctx context.Context, id uuid.UUID) (*Order, error) {
 		&i.Version,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Kind,
+		&i.Side,
+		&i.Quantity,
+		&i.LimitPrice,
+		&i.StopPrice,
+		&i.PositionID,
+		&i.PlacedExchangeDate,
+		&i.FilledPrice,
+		&i.FilledExchangeDate,
+		&i.LotType,
+		&i.TradeType,
+		&i.Dividends,
 	)
 	return &i, err
 }
@@ -80,15 +92,113 @@ func (q *Queries) ListOpenOrders(ctx context.Context, arg *ListOpenOrdersParams)
 	return items, nil
 }
 
+const ListEntitledOrders = `-- name: ListEntitledOrders :many
+SELECT id
+FROM orders
+WHERE stock_id = $1
+  AND kind IN ('', 'market')
+  AND status IN ('created', 'changed', 'closed')
+  AND buy_exchange_date <> ''
+  AND buy_exchange_date < $2::VARCHAR
+  AND (status <> 'closed' OR sell_exchange_date >= $2::VARCHAR)
+ORDER BY created_at ASC
+`
+
+type ListEntitledOrdersParams struct {
+	StockID    string
+	CutoffDate string
+}
+
+func (q *Queries) ListEntitledOrders(ctx context.Context, arg *ListEntitledOrdersParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, ListEntitledOrders, arg.StockID, arg.CutoffDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListOpenMarginedOrders = `-- name: ListOpenMarginedOrders :many
+SELECT id
+FROM orders
+WHERE stock_id = $1
+  AND trade_type <> 'cash'
+  AND status IN ('created', 'changed')
+  AND buy_quantity <> sell_quantity
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListOpenMarginedOrders(ctx context.Context, stockID string) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, ListOpenMarginedOrders, stockID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListOpenPositions = `-- name: ListOpenPositions :many
+SELECT id
+FROM orders
+WHERE user_id = $1
+  AND kind IN ('', 'market')
+  AND status IN ('created', 'changed')
+  AND buy_quantity <> sell_quantity
+ORDER BY stock_id, created_at ASC
+`
+
+func (q *Queries) ListOpenPositions(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, ListOpenPositions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const ListOrders = `-- name: ListOrders :many
-SELECT id, user_id, stock_id, buy_price, buy_quantity, buy_exchange_date, sell_price, sell_quantity, sell_exchange_date, profitable_price, status, version, created_at, updated_at
+SELECT id, user_id, stock_id, buy_price, buy_quantity, buy_exchange_date, sell_price, sell_quantity, sell_exchange_date, profitable_price, status, version, created_at, updated_at, kind, side, quantity, limit_price, stop_price, position_id, placed_exchange_date, filled_price, filled_exchange_date, lot_type, trade_type, dividends
 FROM orders
 WHERE user_id = $1
   AND (stock_id = ANY($4::text[]) OR NOT $5::bool)
   AND ($6::VARCHAR = '' OR status = $6)
   AND ($7::VARCHAR = '' 
     OR sell_exchange_date LIKE $7::VARCHAR || '%' 
-    OR buy_exchange_date LIKE $7::VARCHAR || '%')
+    OR buy_exchange_date LIKE $7::VARCHAR || '%'
+    OR placed_exchange_date LIKE $7::VARCHAR || '%')
 LIMIT $2 OFFSET $3
 `
 
@@ -134,6 +244,18 @@ func (q *Queries) ListOrders(ctx context.Context, arg *ListOrdersParams) ([]*Ord
 			&i.Version,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Kind,
+			&i.Side,
+			&i.Quantity,
+			&i.LimitPrice,
+			&i.StopPrice,
+			&i.PositionID,
+			&i.PlacedExchangeDate,
+			&i.FilledPrice,
+			&i.FilledExchangeDate,
+			&i.LotType,
+			&i.TradeType,
+			&i.Dividends,
 		); err != nil {
 			return nil, err
 		}
@@ -145,11 +267,41 @@ func (q *Queries) ListOrders(ctx context.Context, arg *ListOrdersParams) ([]*Ord
 	return items, nil
 }
 
+const ListPendingOrders = `-- name: ListPendingOrders :many
+SELECT id
+FROM orders
+WHERE stock_id = ANY($1::text[])
+  AND status IN ('pending', 'triggered')
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListPendingOrders(ctx context.Context, stockIds []string) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, ListPendingOrders, stockIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const UpsertOrder = `-- name: UpsertOrder :exec
 INSERT INTO orders (id, user_id, stock_id, buy_price, buy_quantity,
 buy_exchange_date, sell_price, sell_quantity, sell_exchange_date, profitable_price,
-status, version)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+status, version, kind, side, quantity, limit_price, stop_price, position_id,
+placed_exchange_date, filled_price, filled_exchange_date, lot_type, trade_type, dividends)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16,
+$17, $18, $19, $20, $21, $22, $23, $24)
 ON CONFLICT (id) DO UPDATE
 SET user_id = EXCLUDED.user_id, 
   stock_id = EXCLUDED.stock_id,
@@ -161,22 +313,46 @@ SET user_id = EXCLUDED.user_id,
   sell_exchange_date = EXCLUDED.sell_exchange_date,
   profitable_price = EXCLUDED.profitable_price, 
   status = EXCLUDED.status, 
-  version = EXCLUDED.version
+  version = EXCLUDED.version,
+  kind = EXCLUDED.kind,
+  side = EXCLUDED.side,
+  quantity = EXCLUDED.quantity,
+  limit_price = EXCLUDED.limit_price,
+  stop_price = EXCLUDED.stop_price,
+  position_id = EXCLUDED.position_id,
+  placed_exchange_date = EXCLUDED.placed_exchange_date,
+  filled_price = EXCLUDED.filled_price,
+  filled_exchange_date = EXCLUDED.filled_exchange_date,
+  lot_type = EXCLUDED.lot_type,
+  trade_type = EXCLUDED.trade_type,
+  dividends = EXCLUDED.dividends
 `
 
 type UpsertOrderParams struct {
-	ID               uuid.UUID
-	UserID           uuid.UUID
-	StockID          string
-	BuyPrice         decimal.Big
-	BuyQuantity      int64
-	BuyExchangeDate  string
-	SellPrice        decimal.Big
-	SellQuantity     int64
-	SellExchangeDate string
-	ProfitablePrice  decimal.Big
-	Status           string
-	Version          int32
+	ID                 uuid.UUID
+	UserID             uuid.UUID
+	StockID            string
+	BuyPrice           decimal.Big
+	BuyQuantity        int64
+	BuyExchangeDate    string
+	SellPrice          decimal.Big
+	SellQuantity       int64
+	SellExchangeDate   string
+	ProfitablePrice    decimal.Big
+	Status             string
+	Version            int32
+	Kind               string
+	Side               string
+	Quantity           int64
+	LimitPrice         decimal.Big
+	StopPrice          decimal.Big
+	PositionID         uuid.UUID
+	PlacedExchangeDate string
+	FilledPrice        decimal.Big
+	FilledExchangeDate string
+	LotType            string
+	TradeType          string
+	Dividends          decimal.Big
 }
 
 func (q *Queries) UpsertOrder(ctx context.Context, arg *UpsertOrderParams) error {
@@ -193,6 +369,18 @@ func (q *Queries) UpsertOrder(ctx context.Context, arg *UpsertOrderParams) error
 		arg.ProfitablePrice,
 		arg.Status,
 		arg.Version,
+		arg.Kind,
+		arg.Side,
+		arg.Quantity,
+		arg.LimitPrice,
+		arg.StopPrice,
+		arg.PositionID,
+		arg.PlacedExchangeDate,
+		arg.FilledPrice,
+		arg.FilledExchangeDate,
+		arg.LotType,
+		arg.TradeType,
+		arg.Dividends,
 	)
 	return err
 }
@@ -59,7 +59,7 @@ const GetEligibleStocksFromOrder = `-- name: GetEligibleStocksFromOrder :many
 SELECT o.stock_id, c.market
 FROM orders o
 LEFT JOIN stocks c ON c.id = o.stock_id 
-WHERE o.status != 'closed'
+WHERE o.status IN ('created', 'changed', 'pending', 'triggered')
 `
 
 type GetEligibleStocksFromOrderRow struct {
@@ -337,14 +337,21 @@ WHERE (
    CASE WHEN s.concentration_10 > 0 THEN 1 ELSE 0 END +
    CASE WHEN s.concentration_20 > 0 THEN 1 ELSE 0 END +
    CASE WHEN s.concentration_60 > 0 THEN 1 ELSE 0 END
-) >= 4
+) >= $2::int
 AND c.name IS NOT NULL
 AND s.exchange_date = $1
-AND d.trade_shares >= 3000000
-AND a.avg_volume >= 1000000
+AND d.trade_shares >= $3::bigint
+AND COALESCE(a.avg_volume, 0) >= $4::bigint
 ORDER BY s.stock_id
 `
 
+type ListSelectionsParams struct {
+	ExchangeDate         string
+	MinConcentrationDays int32
+	MinTradeShares       int64
+	MinAvgVolume         int64
+}
+
 type ListSelectionsRow struct {
 	StockID         string
 	Name            sql.NullString
@@ -368,8 +375,13 @@ type ListSelectionsRow struct {
 	AvgVolume       pgtype.Float8
 }
 
-func (q *Queries) ListSelections(ctx context.Context, exchangeDate string) ([]*ListSelectionsRow, error) {
-	rows, err := q.db.Query(ctx, ListSelections, exchangeDate)
+func (q *Queries) ListSelections(ctx context.Context, arg *ListSelectionsParams) ([]*ListSelectionsRow, error) {
+	rows, err := q.db.Query(ctx, ListSelections,
+		arg.ExchangeDate,
+		arg.MinConcentrationDays,
+		arg.MinTradeShares,
+		arg.MinAvgVolume,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -510,7 +522,7 @@ func (q *Queries) ListSelectionsFromPicked(ctx context.Context, stockIds []strin
 }
 
 const RetrieveDailyCloseHistory = `-- name: RetrieveDailyCloseHistory :many
-SELECT stock_id, exchange_date, close, trade_shares 
+SELECT stock_id, exchange_date, open, high, low, close, trade_shares 
 FROM daily_closes 
 WHERE exchange_date >= $1 
 AND exchange_date < $2
@@ -527,6 +539,9 @@ type RetrieveDailyCloseHistoryParams struct {
 type RetrieveDailyCloseHistoryRow struct {
 	StockID      string
 	ExchangeDate string
+	Open         decimal.Big
+	High         decimal.Big
+	Low          decimal.Big
 	Close        decimal.Big
 	TradeShares  sql.NullInt64
 }
@@ -543,6 +558,9 @@ func (q *Queries) RetrieveDailyCloseHistory(ctx context.Context, arg *RetrieveDa
 		if err := rows.Scan(
 			&i.StockID,
 			&i.ExchangeDate,
+			&i.Open,
+			&i.High,
+			&i.Low,
 			&i.Close,
 			&i.TradeShares,
 		); err != nil {
@@ -557,7 +575,7 @@ func (q *Queries) RetrieveDailyCloseHistory(ctx context.Context, arg *RetrieveDa
 }
 
 const RetrieveDailyCloseHistoryWithDate = `-- name: RetrieveDailyCloseHistoryWithDate :many
-SELECT stock_id, exchange_date, close, trade_shares 
+SELECT stock_id, exchange_date, open, high, low, close, trade_shares 
 FROM daily_closes 
 WHERE exchange_date >= $1 
 AND exchange_date <= $2 
@@ -574,6 +592,9 @@ type RetrieveDailyCloseHistoryWithDateParams struct {
 type RetrieveDailyCloseHistoryWithDateRow struct {
 	StockID      string
 	ExchangeDate string
+	Open         decimal.Big
+	High         decimal.Big
+	Low          decimal.Big
 	Close        decimal.Big
 	TradeShares  sql.NullInt64
 }
@@ -590,6 +611,9 @@ func (q *Queries) RetrieveDailyCloseHistoryWithDate(ctx context.Context, arg *Re
 		if err := rows.Scan(
 			&i.StockID,
 			&i.ExchangeDate,
+			&i.Open,
+			&i.High,
+			&i.Low,
 			&i.Close,
 			&i.TradeShares,
 		); err != nil {
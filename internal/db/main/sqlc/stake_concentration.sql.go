@@ -189,3 +189,36 @@ func (q *Queries) HasStakeConcentration(ctx context.Context, exchangeDate string
 	err := row.Scan(&exists)
 	return exists, err
 }
+
+const ListExchangeDates = `-- name: ListExchangeDates :many
+SELECT DISTINCT exchange_date
+FROM stake_concentration
+WHERE exchange_date >= $1
+AND exchange_date <= $2
+ORDER BY exchange_date
+`
+
+type ListExchangeDatesParams struct {
+	StartDate string
+	EndDate   string
+}
+
+func (q *Queries) ListExchangeDates(ctx context.Context, arg *ListExchangeDatesParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, ListExchangeDates, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var exchange_date string
+		if err := rows.Scan(&exchange_date); err != nil {
+			return nil, err
+		}
+		items = append(items, exchange_date)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
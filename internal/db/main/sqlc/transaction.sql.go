@@ -7,11 +7,27 @@ package sqlcdb
 
 import (
 	"context"
+	"time"
 
 	"github.com/ericlagergren/decimal"
 	uuid "github.com/gofrs/uuid/v5"
 )
 
+const GetNetDeposits = `-- name: GetNetDeposits :one
+SELECT COALESCE(SUM(credit_amount::numeric - debit_amount::numeric), 0)::numeric AS net_deposits
+FROM transactions
+WHERE user_id = $1
+  AND order_type IN ('Deposit', 'Withdraw')
+  AND status = 'completed'
+`
+
+func (q *Queries) GetNetDeposits(ctx context.Context, userID uuid.UUID) (decimal.Big, error) {
+	row := q.db.QueryRow(ctx, GetNetDeposits, userID)
+	var net_deposits decimal.Big
+	err := row.Scan(&net_deposits)
+	return net_deposits, err
+}
+
 const GetTransaction = `-- name: GetTransaction :one
 SELECT id, user_id, order_id, order_type, credit_amount, debit_amount, status, version, created_at, updated_at
 FROM transactions
@@ -36,6 +52,139 @@ func (q *Queries) GetTransaction(ctx context.Context, id uuid.UUID) (*Transactio
 	return &i, err
 }
 
+const ListOrderRebates = `-- name: ListOrderRebates :many
+SELECT id
+FROM transactions
+WHERE order_id = $1
+  AND order_type = 'Rebate'
+  AND status = 'created'
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListOrderRebates(ctx context.Context, orderID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, ListOrderRebates, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListOrderTransactions = `-- name: ListOrderTransactions :many
+SELECT id
+FROM transactions
+WHERE order_id = $1
+  AND status = 'completed'
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListOrderTransactions(ctx context.Context, orderID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, ListOrderTransactions, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListUnsettledRebates = `-- name: ListUnsettledRebates :many
+SELECT id
+FROM transactions
+WHERE order_type = 'Rebate'
+  AND status = 'created'
+  AND created_at < $1
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListUnsettledRebates(ctx context.Context, createdAt time.Time) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, ListUnsettledRebates, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListUserTransactions = `-- name: ListUserTransactions :many
+SELECT id, user_id, order_id, order_type, credit_amount, debit_amount, status, version, created_at, updated_at
+FROM transactions
+WHERE user_id = $1
+  AND status = 'completed'
+  AND created_at >= $2
+  AND created_at < $3
+ORDER BY created_at ASC
+`
+
+type ListUserTransactionsParams struct {
+	UserID    uuid.UUID
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+func (q *Queries) ListUserTransactions(ctx context.Context, arg *ListUserTransactionsParams) ([]*Transaction, error) {
+	rows, err := q.db.Query(ctx, ListUserTransactions, arg.UserID, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Transaction
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.OrderID,
+			&i.OrderType,
+			&i.CreditAmount,
+			&i.DebitAmount,
+			&i.Status,
+			&i.Version,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const UpsertTransaction = `-- name: UpsertTransaction :exec
 INSERT INTO transactions (id, user_id, order_id, order_type, credit_amount, debit_amount, status, version)
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
@@ -57,7 +57,7 @@ func (q *Queries) DeleteUserByID(ctx context.Context, id uuid.UUID) error {
 }
 
 const GetUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, first_name, last_name, email, phone, password, session_id, email_confirmed_at, phone_confirmed_at, created_at, updated_at, session_expired_at, deleted_at FROM users WHERE email = $1 AND deleted_at IS NULL
+SELECT id, first_name, last_name, email, phone, password, session_id, email_confirmed_at, phone_confirmed_at, created_at, updated_at, session_expired_at, deleted_at, broker_profile_id FROM users WHERE email = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetUserByEmail(ctx context.Context, email string) (*User, error) {
@@ -77,12 +77,13 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (*User, erro
 		&i.UpdatedAt,
 		&i.SessionExpiredAt,
 		&i.DeletedAt,
+		&i.BrokerProfileID,
 	)
 	return &i, err
 }
 
 const GetUserByID = `-- name: GetUserByID :one
-SELECT id, first_name, last_name, email, phone, password, session_id, email_confirmed_at, phone_confirmed_at, created_at, updated_at, session_expired_at, deleted_at FROM users WHERE id = $1 AND deleted_at IS NULL
+SELECT id, first_name, last_name, email, phone, password, session_id, email_confirmed_at, phone_confirmed_at, created_at, updated_at, session_expired_at, deleted_at, broker_profile_id FROM users WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (*User, error) {
@@ -102,12 +103,13 @@ func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (*User, error)
 		&i.UpdatedAt,
 		&i.SessionExpiredAt,
 		&i.DeletedAt,
+		&i.BrokerProfileID,
 	)
 	return &i, err
 }
 
 const GetUserByPhone = `-- name: GetUserByPhone :one
-SELECT id, first_name, last_name, email, phone, password, session_id, email_confirmed_at, phone_confirmed_at, created_at, updated_at, session_expired_at, deleted_at FROM users WHERE phone = $1 AND deleted_at IS NULL
+SELECT id, first_name, last_name, email, phone, password, session_id, email_confirmed_at, phone_confirmed_at, created_at, updated_at, session_expired_at, deleted_at, broker_profile_id FROM users WHERE phone = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetUserByPhone(ctx context.Context, phone string) (*User, error) {
@@ -127,12 +129,13 @@ func (q *Queries) GetUserByPhone(ctx context.Context, phone string) (*User, erro
 		&i.UpdatedAt,
 		&i.SessionExpiredAt,
 		&i.DeletedAt,
+		&i.BrokerProfileID,
 	)
 	return &i, err
 }
 
 const ListUsers = `-- name: ListUsers :many
-SELECT id, first_name, last_name, email, phone, password, session_id, email_confirmed_at, phone_confirmed_at, created_at, updated_at, session_expired_at, deleted_at
+SELECT id, first_name, last_name, email, phone, password, session_id, email_confirmed_at, phone_confirmed_at, created_at, updated_at, session_expired_at, deleted_at, broker_profile_id
 FROM users
 WHERE deleted_at IS NULL
 ORDER BY created_at DESC
@@ -167,6 +170,7 @@ func (q *Queries) ListUsers(ctx context.Context, arg *ListUsersParams) ([]*User,
 			&i.UpdatedAt,
 			&i.SessionExpiredAt,
 			&i.DeletedAt,
+			&i.BrokerProfileID,
 		); err != nil {
 			return nil, err
 		}
@@ -219,3 +223,17 @@ func (q *Queries) UpdateUser(ctx context.Context, arg *UpdateUserParams) error {
 	)
 	return err
 }
+
+const UpdateUserBrokerProfile = `-- name: UpdateUserBrokerProfile :exec
+UPDATE users SET broker_profile_id = $2 WHERE id = $1
+`
+
+type UpdateUserBrokerProfileParams struct {
+	ID              uuid.UUID
+	BrokerProfileID uuid.UUID
+}
+
+func (q *Queries) UpdateUserBrokerProfile(ctx context.Context, arg *UpdateUserBrokerProfileParams) error {
+	_, err := q.db.Exec(ctx, UpdateUserBrokerProfile, arg.ID, arg.BrokerProfileID)
+	return err
+}
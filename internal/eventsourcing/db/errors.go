@@ -119,3 +119,43 @@ func (cee *EventVersionConflictError) Error() string {
 	return fmt.Sprintf("event version conflicted: %s (event type %s, aggregate_id %d)",
 		cee.err, cee.event.EventType(), cee.event.GetAggregateID())
 }
+
+type SnapshotUnmarshalError struct {
+	err         error
+	aggregateID uuid.UUID
+}
+
+func (sue SnapshotUnmarshalError) Error() string {
+	return fmt.Sprintf("failed to unmarshal snapshot: %s (aggregate_id %s)", sue.err, sue.aggregateID)
+}
+
+type SnapshotMarshalError struct {
+	err         error
+	aggregateID uuid.UUID
+}
+
+func (sme SnapshotMarshalError) Error() string {
+	return fmt.Sprintf("failed to marshal snapshot: %s (aggregate_id %s)", sme.err, sme.aggregateID)
+}
+
+type ProjectionNotFoundError struct {
+	name string
+}
+
+func (pnfe ProjectionNotFoundError) Error() string {
+	return fmt.Sprintf("projection not found: %s", pnfe.name)
+}
+
+type ProjectionError struct {
+	err     error
+	name    string
+	retries int
+}
+
+func (pe ProjectionError) Error() string {
+	return fmt.Sprintf("projection failed: %s (projection %s, retries %d)", pe.err, pe.name, pe.retries)
+}
+
+func (pe ProjectionError) Unwrap() error {
+	return pe.err
+}
@@ -31,7 +31,10 @@ const insertEvent = `
 INSERT INTO %s (aggregate_id, version, parent_id, event_type, payload, created_at) VALUES ($1, $2, $3, $4, $5, $6)
 `
 
+// the event tables share the global position sequence, the projections tail
+// them in the order of the appending transaction and the position
 const createEventTable = `
+CREATE SEQUENCE IF NOT EXISTS %s;
 CREATE TABLE %s (
   aggregate_id uuid NOT NULL,
   version int NOT NULL,
@@ -39,10 +42,14 @@ CREATE TABLE %s (
   event_type VARCHAR (50),
   payload jsonb NOT NULL,
   created_at timestamp without time zone NOT NULL,
+  global_position bigint NOT NULL DEFAULT nextval('%s') UNIQUE,
+  transaction_id xid8 NOT NULL DEFAULT pg_current_xact_id(),
   PRIMARY KEY (aggregate_id, version)
 );
 `
 
+const globalPositionSequence = "event_global_position_seq"
+
 type EventStore struct {
 	eventTable string
 	registry   *eventsourcing.EventRegistry
@@ -159,5 +166,5 @@ func (es *EventStore) Append(ctx context.Context, events []eventsourcing.Event)
 
 // Migration returns a sql for creating the event table.
 func (es *EventStore) Migration() string {
-	return fmt.Sprintf(createEventTable, es.eventTable)
+	return fmt.Sprintf(createEventTable, globalPositionSequence, es.eventTable, globalPositionSequence)
 }
@@ -0,0 +1,241 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samwang0723/jarvis/internal/eventsourcing"
+)
+
+const outboxTable = "outbox_messages"
+
+// outboxClaimTimeout is how long a relay has to publish the messages it
+// claimed, the messages of a relay which did not finish in time are claimed
+// again.
+const outboxClaimTimeout = time.Minute
+
+const insertOutboxMessage = `
+INSERT INTO %s (topic, aggregate_id, parent_id, version, event_type, payload, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+// the advisory lock keeps a single relay claiming so the messages of an
+// aggregate leave in the order they were written
+const lockOutboxRelay = `SELECT pg_try_advisory_xact_lock(hashtext('%s'))`
+
+const outboxClaimed = `
+SELECT EXISTS (SELECT 1 FROM %s WHERE claimed_until > $1)
+`
+
+const claimOutboxMessages = `
+UPDATE %s
+SET claimed_until = $2
+WHERE id IN (SELECT id FROM %s ORDER BY id ASC LIMIT $1)
+RETURNING id,
+          topic,
+          aggregate_id,
+          parent_id,
+          version,
+          event_type,
+          payload,
+          created_at
+`
+
+const releaseOutboxMessages = `
+UPDATE %s SET claimed_until = NULL WHERE id = ANY($1)
+`
+
+const deleteOutboxMessages = `
+DELETE FROM %s WHERE id = ANY($1)
+`
+
+const createOutboxTable = `
+CREATE TABLE %s (
+  id bigserial PRIMARY KEY,
+  topic varchar(100) NOT NULL,
+  aggregate_id uuid NOT NULL,
+  parent_id uuid NOT NULL,
+  version int NOT NULL,
+  event_type varchar(50) NOT NULL,
+  payload jsonb NOT NULL,
+  created_at timestamp without time zone NOT NULL,
+  claimed_until timestamp without time zone
+);
+`
+
+// OutboxMessage is an event waiting to be published to the topic of its
+// aggregate.
+type OutboxMessage struct {
+	CreatedAt   time.Time
+	Topic       string
+	EventType   string
+	Payload     string
+	ID          int64
+	AggregateID uuid.UUID
+	ParentID    uuid.UUID
+	Version     int
+}
+
+// OutboxStore records the events to publish in the transaction appending
+// them, the relay publishes them afterwards at least once.
+type OutboxStore struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewOutboxStore(dbPool *pgxpool.Pool) *OutboxStore {
+	store := &OutboxStore{}
+	store.dbPool = dbPool
+
+	return store
+}
+
+// Append records the events to publish to the topic.
+func (ob *OutboxStore) Append(ctx context.Context, topic string, events []eventsourcing.Event) error {
+	return Transaction(ctx, ob.dbPool, func(ctx context.Context, tx pgx.Tx) error {
+		insertSQL := fmt.Sprintf(insertOutboxMessage, outboxTable)
+
+		for _, event := range events {
+			evModel, err := NewEventModelFromEvent(event)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Exec(ctx, insertSQL,
+				topic,
+				evModel.AggregateID,
+				evModel.ParentID,
+				evModel.Version,
+				evModel.EventType,
+				evModel.Payload,
+				evModel.CreatedAt)
+			if err != nil {
+				return fmt.Errorf("insert outbox message error: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// Relay hands the oldest messages to publish and removes them once published,
+// a failed publish leaves them to the next relay. It returns the number of
+// messages published, zero while another relay holds the outbox.
+//
+// The messages are claimed and removed in two short transactions, so no
+// transaction stays open while they are published. A relay stopping between
+// the publish and the removal has its messages published again once the
+// claim times out.
+func (ob *OutboxStore) Relay(
+	ctx context.Context,
+	limit int,
+	publish func(ctx context.Context, messages []*OutboxMessage) error,
+) (int, error) {
+	messages, err := ob.claim(ctx, limit)
+	if err != nil || len(messages) == 0 {
+		return 0, err
+	}
+
+	ids := make([]int64, 0, len(messages))
+	for _, message := range messages {
+		ids = append(ids, message.ID)
+	}
+
+	if err := publish(ctx, messages); err != nil {
+		// hand the messages to the next relay without waiting for the claim
+		// to time out
+		if rErr := ob.exec(ctx, releaseOutboxMessages, ids); rErr != nil {
+			return 0, errors.Join(err, rErr)
+		}
+
+		return 0, err
+	}
+
+	if err := ob.exec(ctx, deleteOutboxMessages, ids); err != nil {
+		return 0, err
+	}
+
+	return len(messages), nil
+}
+
+// claim marks the oldest messages as being published. Nothing is claimed
+// while another relay holds the outbox or publishes its claimed messages.
+func (ob *OutboxStore) claim(ctx context.Context, limit int) ([]*OutboxMessage, error) {
+	messages := []*OutboxMessage{}
+	err := Transaction(ctx, ob.dbPool, func(ctx context.Context, tx pgx.Tx) error {
+		var locked bool
+		if err := tx.QueryRow(ctx, fmt.Sprintf(lockOutboxRelay, outboxTable)).Scan(&locked); err != nil {
+			return fmt.Errorf("lock outbox error: %w", err)
+		}
+
+		if !locked {
+			return nil
+		}
+
+		now := time.Now().UTC()
+
+		var claimed bool
+		if err := tx.QueryRow(ctx, fmt.Sprintf(outboxClaimed, outboxTable), now).Scan(&claimed); err != nil {
+			return fmt.Errorf("check outbox claims error: %w", err)
+		}
+
+		if claimed {
+			return nil
+		}
+
+		rows, err := tx.Query(ctx, fmt.Sprintf(claimOutboxMessages, outboxTable, outboxTable),
+			limit, now.Add(outboxClaimTimeout))
+		if err != nil {
+			return fmt.Errorf("claim outbox messages error: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var message OutboxMessage
+			if err := rows.Scan(&message.ID,
+				&message.Topic,
+				&message.AggregateID,
+				&message.ParentID,
+				&message.Version,
+				&message.EventType,
+				&message.Payload,
+				&message.CreatedAt); err != nil {
+				return fmt.Errorf("scan outbox message error: %w", err)
+			}
+
+			messages = append(messages, &message)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the returned rows are not ordered
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].ID < messages[j].ID
+	})
+
+	return messages, nil
+}
+
+func (ob *OutboxStore) exec(ctx context.Context, query string, ids []int64) error {
+	return Transaction(ctx, ob.dbPool, func(ctx context.Context, tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(query, outboxTable), ids); err != nil {
+			return fmt.Errorf("update outbox messages error: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// Migration returns a sql for creating the outbox table.
+func (ob *OutboxStore) Migration() string {
+	return fmt.Sprintf(createOutboxTable, outboxTable)
+}
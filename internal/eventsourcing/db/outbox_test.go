@@ -0,0 +1,80 @@
+package db_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/common/remotetest"
+	"github.com/samwang0723/jarvis/internal/eventsourcing"
+	"github.com/samwang0723/jarvis/internal/eventsourcing/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutboxStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	pool := remotetest.SetupPostgresClient(t, false)
+
+	outbox := db.NewOutboxStore(pool)
+	_, err := pool.Exec(ctx, outbox.Migration())
+	assert.Nil(t, err)
+
+	aggregateID := uuid.Must(uuid.NewV4())
+	events := make([]eventsourcing.Event, 0, 3)
+	for version := 1; version <= 3; version++ {
+		event := &testedEvent{}
+		event.SetAggregateID(aggregateID)
+		event.SetVersion(version)
+		events = append(events, event)
+	}
+	assert.Nil(t, outbox.Append(ctx, "tested-v1", events))
+
+	// a failed publish hands the messages to the next relay right away
+	published, err := outbox.Relay(ctx, 2, func(context.Context, []*db.OutboxMessage) error {
+		return errors.New("broker unavailable")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 0, published)
+
+	published, err = outbox.Relay(ctx, 2, func(ctx context.Context, messages []*db.OutboxMessage) error {
+		assert.Len(t, messages, 2)
+		assert.Equal(t, 1, messages[0].Version)
+		assert.Equal(t, 2, messages[1].Version)
+
+		// no transaction is held while publishing, another relay waits for
+		// the claimed messages instead of publishing the next ones
+		other, err := outbox.Relay(ctx, 2, func(context.Context, []*db.OutboxMessage) error {
+			t.Error("messages claimed twice")
+
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, other)
+
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, published)
+
+	// the published messages are removed
+	published, err = outbox.Relay(ctx, 2, func(_ context.Context, messages []*db.OutboxMessage) error {
+		assert.Len(t, messages, 1)
+		assert.Equal(t, 3, messages[0].Version)
+
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, published)
+
+	published, err = outbox.Relay(ctx, 2, func(context.Context, []*db.OutboxMessage) error {
+		t.Error("empty outbox relayed")
+
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, published)
+}
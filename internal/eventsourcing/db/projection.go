@@ -0,0 +1,451 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"github.com/samwang0723/jarvis/internal/eventsourcing"
+)
+
+const checkpointTable = "projection_checkpoints"
+
+const (
+	defaultProjectionInterval  = time.Second
+	defaultProjectionBatchSize = 100
+	defaultProjectionBackoff   = time.Second
+	maxProjectionBackoff       = time.Minute
+)
+
+// the events are read in the order of the transactions appending them, only
+// the ones of transactions older than every transaction in flight. A
+// transaction committing late holds the later ones back rather than being
+// passed, a rolled back one leaves nothing behind.
+const listEventsAfterCheckpoint = `
+SELECT transaction_id::text::bigint AS transaction_id,
+       global_position,
+       aggregate_id,
+       version,
+       parent_id,
+       event_type,
+       payload,
+       created_at
+FROM %s
+WHERE (transaction_id, global_position) > ($1::bigint::text::xid8, $2)
+  AND transaction_id < pg_snapshot_xmin(pg_current_snapshot())
+`
+
+const insertCheckpoint = `
+INSERT INTO %s (name) VALUES ($1) ON CONFLICT (name) DO NOTHING
+`
+
+// a projection is run by a single runner at a time, the others skip it while
+// its checkpoint is locked
+const lockCheckpoint = `
+SELECT transaction_id::text::bigint, global_position FROM %s WHERE name = $1 FOR UPDATE SKIP LOCKED
+`
+
+const waitCheckpoint = `
+SELECT transaction_id::text::bigint, global_position FROM %s WHERE name = $1 FOR UPDATE
+`
+
+const updateCheckpoint = `
+UPDATE %s
+SET transaction_id = $2::bigint::text::xid8,
+  global_position = $3,
+  retries = 0,
+  last_error = NULL,
+  updated_at = CURRENT_TIMESTAMP
+WHERE name = $1
+`
+
+const failCheckpoint = `
+INSERT INTO %s (name, retries, last_error) VALUES ($1, 1, $2)
+ON CONFLICT (name) DO UPDATE
+SET retries = %s.retries + 1,
+  last_error = EXCLUDED.last_error,
+  updated_at = CURRENT_TIMESTAMP
+`
+
+const createCheckpointTable = `
+CREATE TABLE %s (
+  name varchar(100) PRIMARY KEY,
+  transaction_id xid8 NOT NULL DEFAULT '0',
+  global_position bigint NOT NULL DEFAULT 0,
+  retries int NOT NULL DEFAULT 0,
+  last_error text,
+  updated_at timestamp without time zone NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+`
+
+// checkpoint is the place of an event in the order the projections read
+// them, the transaction appending it and its global position.
+type checkpoint struct {
+	TransactionID  int64
+	GlobalPosition int64
+}
+
+// positionedEvent is an event model with its place in the projected order.
+type positionedEvent struct {
+	EventModel
+	checkpoint
+}
+
+type projection struct {
+	projector eventsourcing.Projector
+	events    map[eventsourcing.EventType]bool
+	// mu serializes the batches of the projection within the process, the
+	// checkpoint lock serializes them across processes.
+	mu      sync.Mutex
+	retries int
+}
+
+// ProjectionRunner feeds the events of the event tables to the projectors in
+// the order they committed in, outside of the transactions appending them. Each projection keeps its own checkpoint, so it can be added later
+// and backfilled from the recorded events, or rebuilt from scratch.
+type ProjectionRunner struct {
+	dbPool      *pgxpool.Pool
+	registry    *eventsourcing.EventRegistry
+	projections map[string]*projection
+	listSQL     string
+	interval    time.Duration
+	backoff     time.Duration
+	batchSize   int
+}
+
+type ProjectionRunnerOption func(*ProjectionRunner)
+
+// WithProjectionInterval configures the wait between the polls of a projection
+// that caught up.
+func WithProjectionInterval(interval time.Duration) ProjectionRunnerOption {
+	return func(pr *ProjectionRunner) {
+		pr.interval = interval
+	}
+}
+
+// WithProjectionBatchSize configures the number of events projected in a
+// transaction.
+func WithProjectionBatchSize(batchSize int) ProjectionRunnerOption {
+	return func(pr *ProjectionRunner) {
+		pr.batchSize = batchSize
+	}
+}
+
+// WithProjectionBackoff configures the wait before retrying a failed batch,
+// doubled on each consecutive failure up to a minute.
+func WithProjectionBackoff(backoff time.Duration) ProjectionRunnerOption {
+	return func(pr *ProjectionRunner) {
+		pr.backoff = backoff
+	}
+}
+
+// NewProjectionRunner tails the event tables of the aggregates, the tables
+// share the global position sequence so their events interleave in a single
+// order.
+func NewProjectionRunner(
+	dbPool *pgxpool.Pool, aggregates []eventsourcing.Aggregate,
+	options ...ProjectionRunnerOption,
+) *ProjectionRunner {
+	registry := eventsourcing.NewEventRegistry()
+	selects := make([]string, 0, len(aggregates))
+	for _, aggregate := range aggregates {
+		for _, transition := range aggregate.GetTransitions() {
+			registry.Register(transition.Event)
+		}
+
+		selects = append(selects, fmt.Sprintf(listEventsAfterCheckpoint, aggregate.EventTable()))
+	}
+
+	runner := &ProjectionRunner{}
+	runner.dbPool = dbPool
+	runner.registry = registry
+	runner.projections = make(map[string]*projection)
+	runner.listSQL = strings.Join(selects, "UNION ALL") + "ORDER BY transaction_id ASC, global_position ASC\nLIMIT $3\n"
+	runner.interval = defaultProjectionInterval
+	runner.batchSize = defaultProjectionBatchSize
+	runner.backoff = defaultProjectionBackoff
+
+	for _, option := range options {
+		option(runner)
+	}
+
+	return runner
+}
+
+// AddProjector registers the projector under the name of its checkpoint, it
+// is handed the events, or every event if none are given. Projectors have to
+// be added before running.
+func (pr *ProjectionRunner) AddProjector(
+	name string,
+	projector eventsourcing.Projector,
+	events ...eventsourcing.Event,
+) {
+	proj := &projection{
+		projector: projector,
+		events:    make(map[eventsourcing.EventType]bool, len(events)),
+	}
+	for _, event := range events {
+		proj.events[event.EventType()] = true
+	}
+
+	pr.projections[name] = proj
+}
+
+// Run projects until the context is cancelled. The projections catch up on
+// their own, so a failing one retries without holding the others back.
+func (pr *ProjectionRunner) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for name := range pr.projections {
+		wg.Add(1)
+
+		go func(name string) {
+			defer wg.Done()
+
+			pr.run(ctx, name)
+		}(name)
+	}
+
+	wg.Wait()
+}
+
+func (pr *ProjectionRunner) run(ctx context.Context, name string) {
+	logger := zerolog.Ctx(ctx).With().Str("projection", name).Logger()
+	logger.Info().Msg("projection starting")
+	defer logger.Info().Msg("projection exited")
+
+	var delay time.Duration
+	for {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return
+		case <-timer.C:
+		}
+
+		projected, err := pr.ProjectOnce(ctx, name)
+
+		var projectionErr ProjectionError
+		switch {
+		case errors.As(err, &projectionErr):
+			if ctx.Err() == nil {
+				logger.Error().Err(err).Int("retries", projectionErr.retries).Msg("projection failed")
+			}
+
+			delay = pr.retryDelay(projectionErr.retries)
+		case err != nil:
+			delay = pr.interval
+		case projected == pr.batchSize:
+			delay = 0
+		default:
+			delay = pr.interval
+		}
+	}
+}
+
+// retryDelay doubles the backoff with each consecutive failure.
+func (pr *ProjectionRunner) retryDelay(retries int) time.Duration {
+	delay := pr.backoff
+	for i := 1; i < retries && delay < maxProjectionBackoff; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxProjectionBackoff)
+}
+
+// CatchUp projects the events recorded so far and returns the number of events
+// projected.
+func (pr *ProjectionRunner) CatchUp(ctx context.Context, name string) (int, error) {
+	total := 0
+	for {
+		projected, err := pr.ProjectOnce(ctx, name)
+		total += projected
+		if err != nil || projected < pr.batchSize {
+			return total, err
+		}
+	}
+}
+
+// ProjectOnce hands the projector the next batch of events after its
+// checkpoint and advances the checkpoint in the same transaction, a projector
+// writing through the transaction of the context applies each event once. A
+// failed batch is rolled back and retried from the checkpoint. It returns the
+// number of events passed, zero while another runner holds the projection.
+func (pr *ProjectionRunner) ProjectOnce(ctx context.Context, name string) (int, error) {
+	proj, ok := pr.projections[name]
+	if !ok {
+		return 0, ProjectionNotFoundError{name: name}
+	}
+
+	proj.mu.Lock()
+	defer proj.mu.Unlock()
+
+	projected := 0
+	err := Transaction(ctx, pr.dbPool, func(ctx context.Context, tx pgx.Tx) error {
+		last, locked, err := pr.lockCheckpoint(ctx, tx, name, lockCheckpoint)
+		if err != nil || !locked {
+			return err
+		}
+
+		models, err := pr.listEvents(ctx, tx, last)
+		if err != nil {
+			return err
+		}
+
+		for _, model := range models {
+			if err := pr.project(ctx, proj, model); err != nil {
+				return err
+			}
+
+			last = model.checkpoint
+			projected++
+		}
+
+		if projected == 0 {
+			return nil
+		}
+
+		sql := fmt.Sprintf(updateCheckpoint, checkpointTable)
+		if _, err := tx.Exec(ctx, sql, name, last.TransactionID, last.GlobalPosition); err != nil {
+			return fmt.Errorf("update checkpoint error: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, pr.fail(ctx, name, proj, err)
+	}
+
+	proj.retries = 0
+
+	return projected, nil
+}
+
+// Rebuild resets the projector and moves its checkpoint back to the first
+// event, the runner projects every recorded event again. It waits for the
+// batch in progress.
+func (pr *ProjectionRunner) Rebuild(ctx context.Context, name string) error {
+	proj, ok := pr.projections[name]
+	if !ok {
+		return ProjectionNotFoundError{name: name}
+	}
+
+	proj.mu.Lock()
+	defer proj.mu.Unlock()
+
+	return Transaction(ctx, pr.dbPool, func(ctx context.Context, tx pgx.Tx) error {
+		if _, _, err := pr.lockCheckpoint(ctx, tx, name, waitCheckpoint); err != nil {
+			return err
+		}
+
+		if resettable, ok := proj.projector.(eventsourcing.ResettableProjector); ok {
+			if err := resettable.Reset(ctx); err != nil {
+				return fmt.Errorf("reset projector error: %w", err)
+			}
+		}
+
+		if _, err := tx.Exec(ctx, fmt.Sprintf(updateCheckpoint, checkpointTable), name, 0, 0); err != nil {
+			return fmt.Errorf("reset checkpoint error: %w", err)
+		}
+
+		proj.retries = 0
+
+		return nil
+	})
+}
+
+// lockCheckpoint creates the checkpoint of a new projection and locks it,
+// returning the last event projected so far.
+func (pr *ProjectionRunner) lockCheckpoint(
+	ctx context.Context, tx pgx.Tx, name, lockSQL string,
+) (checkpoint, bool, error) {
+	if _, err := tx.Exec(ctx, fmt.Sprintf(insertCheckpoint, checkpointTable), name); err != nil {
+		return checkpoint{}, false, fmt.Errorf("insert checkpoint error: %w", err)
+	}
+
+	var last checkpoint
+	err := tx.QueryRow(ctx, fmt.Sprintf(lockSQL, checkpointTable), name).
+		Scan(&last.TransactionID, &last.GlobalPosition)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return checkpoint{}, false, nil
+	}
+	if err != nil {
+		return checkpoint{}, false, fmt.Errorf("lock checkpoint error: %w", err)
+	}
+
+	return last, true, nil
+}
+
+func (pr *ProjectionRunner) listEvents(
+	ctx context.Context, tx pgx.Tx, last checkpoint,
+) ([]*positionedEvent, error) {
+	rows, err := tx.Query(ctx, pr.listSQL, last.TransactionID, last.GlobalPosition, pr.batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("list events error: %w", err)
+	}
+	defer rows.Close()
+
+	models := []*positionedEvent{}
+	for rows.Next() {
+		var model positionedEvent
+		if err := rows.Scan(&model.TransactionID,
+			&model.GlobalPosition,
+			&model.AggregateID,
+			&model.Version,
+			&model.ParentID,
+			&model.EventType,
+			&model.Payload,
+			&model.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan event model error: %w", err)
+		}
+
+		models = append(models, &model)
+	}
+
+	return models, rows.Err()
+}
+
+// project hands the event to the projector if it subscribed to it.
+func (pr *ProjectionRunner) project(ctx context.Context, proj *projection, model *positionedEvent) error {
+	if len(proj.events) > 0 && !proj.events[eventsourcing.EventType(model.EventType)] {
+		return nil
+	}
+
+	event, err := model.ToEvent(pr.registry)
+	if err != nil {
+		return err
+	}
+
+	if err := proj.projector.Handle(ctx, event); err != nil {
+		return &EventProjectorError{
+			err:   err,
+			event: event,
+		}
+	}
+
+	return nil
+}
+
+// fail records the failure on the checkpoint for inspection.
+func (pr *ProjectionRunner) fail(ctx context.Context, name string, proj *projection, err error) error {
+	proj.retries++
+
+	sql := fmt.Sprintf(failCheckpoint, checkpointTable, checkpointTable)
+	if _, recordErr := pr.dbPool.Exec(ctx, sql, name, err.Error()); recordErr != nil {
+		zerolog.Ctx(ctx).Warn().Err(recordErr).Str("projection", name).Msg("record projection failure error")
+	}
+
+	return ProjectionError{err: err, name: name, retries: proj.retries}
+}
+
+// Migration returns a sql for creating the checkpoint table.
+func (pr *ProjectionRunner) Migration() string {
+	return fmt.Sprintf(createCheckpointTable, checkpointTable)
+}
@@ -0,0 +1,177 @@
+package db_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/samwang0723/jarvis/internal/common/remotetest"
+	"github.com/samwang0723/jarvis/internal/eventsourcing"
+	"github.com/samwang0723/jarvis/internal/eventsourcing/db"
+	"github.com/stretchr/testify/assert"
+)
+
+// testProjector records the projected events, failing the first fail calls.
+type testProjector struct {
+	events []eventsourcing.Event
+	resets int
+	fail   int
+}
+
+func (tp *testProjector) Handle(_ context.Context, event eventsourcing.Event) error {
+	if tp.fail > 0 {
+		tp.fail--
+
+		return errors.New("read model unavailable")
+	}
+
+	tp.events = append(tp.events, event)
+
+	return nil
+}
+
+func (tp *testProjector) Reset(context.Context) error {
+	tp.events = nil
+	tp.resets++
+
+	return nil
+}
+
+func TestProjectionRunner(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	pool := remotetest.SetupPostgresClient(t, false)
+
+	registry := eventsourcing.NewEventRegistryFromStateMachine(&testAggregate{})
+	eventStore := db.NewEventStore((&testAggregate{}).EventTable(), registry, pool)
+
+	projector := &testProjector{fail: 1}
+	runner := db.NewProjectionRunner(pool, []eventsourcing.Aggregate{&testAggregate{}},
+		db.WithProjectionBatchSize(2))
+	runner.AddProjector("tested", projector, &testedEvent{})
+
+	// create event and checkpoint tables
+	_, err := pool.Exec(ctx, eventStore.Migration())
+	assert.Nil(t, err)
+	_, err = pool.Exec(ctx, runner.Migration())
+	assert.Nil(t, err)
+
+	first, second := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+	for _, aggregateID := range []uuid.UUID{first, second, first} {
+		events, err := eventStore.Load(ctx, aggregateID, 0)
+		assert.Nil(t, err)
+
+		event := &testedEvent{}
+		event.SetAggregateID(aggregateID)
+		event.SetVersion(len(events) + 1)
+		assert.Nil(t, eventStore.Append(ctx, []eventsourcing.Event{event}))
+	}
+
+	// the failed batch is rolled back and retried from the checkpoint
+	_, err = runner.ProjectOnce(ctx, "tested")
+	var projectionErr db.ProjectionError
+	assert.ErrorAs(t, err, &projectionErr)
+	assert.Empty(t, projector.events)
+
+	projected, err := runner.CatchUp(ctx, "tested")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, projected)
+
+	// events are projected in the order they were appended
+	assert.Len(t, projector.events, 3)
+	for idx, aggregateID := range []uuid.UUID{first, second, first} {
+		assert.Equal(t, aggregateID, projector.events[idx].GetAggregateID())
+	}
+
+	// the checkpoint is at the last event
+	projected, err = runner.ProjectOnce(ctx, "tested")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, projected)
+
+	// rebuilding replays every event
+	assert.Nil(t, runner.Rebuild(ctx, "tested"))
+	assert.Equal(t, 1, projector.resets)
+	assert.Empty(t, projector.events)
+
+	projected, err = runner.CatchUp(ctx, "tested")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, projected)
+	assert.Len(t, projector.events, 3)
+}
+
+func TestProjectionRunner_InFlightTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	pool := remotetest.SetupPostgresClient(t, false)
+
+	registry := eventsourcing.NewEventRegistryFromStateMachine(&testAggregate{})
+	eventStore := db.NewEventStore((&testAggregate{}).EventTable(), registry, pool)
+
+	projector := &testProjector{}
+	runner := db.NewProjectionRunner(pool, []eventsourcing.Aggregate{&testAggregate{}})
+	runner.AddProjector("tested", projector, &testedEvent{})
+
+	_, err := pool.Exec(ctx, eventStore.Migration())
+	assert.Nil(t, err)
+	_, err = pool.Exec(ctx, runner.Migration())
+	assert.Nil(t, err)
+
+	newEvent := func() eventsourcing.Event {
+		event := &testedEvent{}
+		event.SetAggregateID(uuid.Must(uuid.NewV4()))
+		event.SetVersion(1)
+
+		return event
+	}
+
+	// the first position is taken by a transaction committing late
+	late, err := pool.Begin(ctx)
+	assert.Nil(t, err)
+	defer late.Rollback(ctx) //nolint: errcheck
+
+	lateEvent := newEvent()
+	assert.Nil(t, eventStore.Append(db.WithTx(ctx, late), []eventsourcing.Event{lateEvent}))
+
+	// a rolled back transaction leaves nothing to wait on
+	rolledBack, err := pool.Begin(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, eventStore.Append(db.WithTx(ctx, rolledBack), []eventsourcing.Event{newEvent()}))
+	assert.Nil(t, rolledBack.Rollback(ctx))
+
+	committed := newEvent()
+	assert.Nil(t, eventStore.Append(ctx, []eventsourcing.Event{committed}))
+
+	// the committed event waits on the transaction in flight
+	projected, err := runner.CatchUp(ctx, "tested")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, projected)
+
+	assert.Nil(t, late.Commit(ctx))
+
+	// both are projected in the order they committed in
+	projected, err = runner.CatchUp(ctx, "tested")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, projected)
+
+	assert.Len(t, projector.events, 2)
+	assert.Equal(t, lateEvent.GetAggregateID(), projector.events[0].GetAggregateID())
+	assert.Equal(t, committed.GetAggregateID(), projector.events[1].GetAggregateID())
+}
+
+func TestProjectionRunner_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	runner := db.NewProjectionRunner(nil, []eventsourcing.Aggregate{&testAggregate{}})
+
+	_, err := runner.ProjectOnce(ctx, "missing")
+	assert.ErrorAs(t, err, &db.ProjectionNotFoundError{})
+
+	err = runner.Rebuild(ctx, "missing")
+	assert.ErrorAs(t, err, &db.ProjectionNotFoundError{})
+}
@@ -15,7 +15,16 @@ type AggregateRepository struct {
 	aggregateLoader eventsourcing.AggregateLoader
 	aggregateSaver  eventsourcing.AggregateSaver
 	projectors      map[eventsourcing.EventType][]eventsourcing.Projector
+	snapshotStore   *SnapshotStore
+	outboxStore     *OutboxStore
 	eventStore      EventStore
+	// outboxTopic publishes the outboxEvents, or every event if none are
+	// listed, through the outbox. Empty disables publishing.
+	outboxTopic  string
+	outboxEvents map[eventsourcing.EventType]bool
+	// snapshotFrequency is the number of events between snapshots, zero
+	// disables snapshotting.
+	snapshotFrequency int
 }
 
 type AggregateRepositoryOption func(*AggregateRepository)
@@ -34,6 +43,27 @@ func WithAggregateSaver(aggregateSaver eventsourcing.AggregateSaver) AggregateRe
 	}
 }
 
+// WithSnapshotFrequency snapshots the aggregate every frequency events, the
+// snapshots table of the event table has to exist. Loading through an
+// AggregateLoader ignores the snapshots.
+func WithSnapshotFrequency(frequency int) AggregateRepositoryOption {
+	return func(ar *AggregateRepository) {
+		ar.snapshotFrequency = frequency
+	}
+}
+
+// WithOutbox publishes the events, or every event of the aggregate if none
+// are given, to the topic through the outbox table.
+func WithOutbox(topic string, events ...eventsourcing.Event) AggregateRepositoryOption {
+	return func(ar *AggregateRepository) {
+		ar.outboxTopic = topic
+		ar.outboxEvents = make(map[eventsourcing.EventType]bool, len(events))
+		for _, event := range events {
+			ar.outboxEvents[event.EventType()] = true
+		}
+	}
+}
+
 func NewAggregateRepository(
 	aggregate eventsourcing.Aggregate, db *pgxpool.Pool,
 	options ...AggregateRepositoryOption,
@@ -45,6 +75,8 @@ func NewAggregateRepository(
 	repo := &AggregateRepository{}
 	repo.aggregateType = reflect.TypeOf(aggregate).Elem()
 	repo.eventStore = *NewEventStore(eventTable, eventRegistry, db)
+	repo.snapshotStore = NewSnapshotStore(eventTable, db)
+	repo.outboxStore = NewOutboxStore(db)
 	repo.projectors = make(map[eventsourcing.EventType][]eventsourcing.Projector)
 
 	for _, option := range options {
@@ -84,6 +116,9 @@ func (ar *AggregateRepository) loadFromAggregateLoader(
 }
 
 // loadFromEventStore loads a aggregate from event store.
+//
+// With snapshotting enabled, it starts from the latest snapshot and only
+// replays the newer events.
 func (ar *AggregateRepository) loadFromEventStore(
 	ctx context.Context, aggregateID uuid.UUID,
 ) (eventsourcing.Aggregate, error) {
@@ -91,12 +126,20 @@ func (ar *AggregateRepository) loadFromEventStore(
 
 	aggregate.SetAggregateID(aggregateID)
 
-	events, err := ar.eventStore.Load(ctx, aggregateID, 0)
+	snapshotted := false
+	if ar.snapshotFrequency > 0 {
+		var err error
+		if snapshotted, err = ar.snapshotStore.Load(ctx, aggregate); err != nil {
+			return aggregate, err
+		}
+	}
+
+	events, err := ar.eventStore.Load(ctx, aggregateID, aggregate.GetVersion()+1)
 	if err != nil {
 		return aggregate, err
 	}
 
-	if len(events) == 0 {
+	if len(events) == 0 && !snapshotted {
 		return aggregate, &AggregateNotFoundError{
 			err:         err,
 			aggregateID: aggregateID,
@@ -126,6 +169,13 @@ func (ar *AggregateRepository) Save(ctx context.Context, aggregate eventsourcing
 			return err
 		}
 
+		// save events to publish
+		if published := ar.outboxChanges(changes); len(published) > 0 {
+			if err := ar.outboxStore.Append(ctx, ar.outboxTopic, published); err != nil {
+				return err
+			}
+		}
+
 		// save aggregate
 		if ar.aggregateSaver != nil {
 			if err := ar.aggregateSaver.Save(ctx, aggregate); err != nil {
@@ -143,10 +193,47 @@ func (ar *AggregateRepository) Save(ctx context.Context, aggregate eventsourcing
 			}
 		}
 
+		// save snapshot
+		if ar.snapshotDue(aggregate.GetVersion(), len(changes)) {
+			if err := ar.snapshotStore.Save(ctx, aggregate); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 }
 
+// outboxChanges filters the changes published through the outbox.
+func (ar *AggregateRepository) outboxChanges(changes []eventsourcing.Event) []eventsourcing.Event {
+	if ar.outboxTopic == "" {
+		return nil
+	}
+
+	if len(ar.outboxEvents) == 0 {
+		return changes
+	}
+
+	published := make([]eventsourcing.Event, 0, len(changes))
+	for _, event := range changes {
+		if ar.outboxEvents[event.EventType()] {
+			published = append(published, event)
+		}
+	}
+
+	return published
+}
+
+// snapshotDue reports whether appending the changes crossed a multiple of
+// the snapshot frequency.
+func (ar *AggregateRepository) snapshotDue(version, changes int) bool {
+	if ar.snapshotFrequency <= 0 || changes == 0 {
+		return false
+	}
+
+	return version/ar.snapshotFrequency > (version-changes)/ar.snapshotFrequency
+}
+
 // project runs projectors.
 func (ar *AggregateRepository) project(ctx context.Context, event eventsourcing.Event) error {
 	for _, projector := range ar.projectors[event.EventType()] {
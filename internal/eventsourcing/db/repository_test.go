@@ -120,3 +120,47 @@ func TestRepository_AggregateLoader(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	pool := remotetest.SetupPostgresClient(t, true)
+
+	registry := eventsourcing.NewEventRegistryFromStateMachine(&testAggregate{})
+
+	repository := db.NewAggregateRepository(&testAggregate{}, pool, db.WithSnapshotFrequency(2))
+	eventStore := db.NewEventStore((&testAggregate{}).EventTable(), registry, pool)
+	snapshotStore := db.NewSnapshotStore((&testAggregate{}).EventTable(), pool)
+	aggregateID := uuid.Must(uuid.NewV4())
+
+	// create event and snapshot tables
+	_, err := pool.Exec(ctx, eventStore.Migration())
+	assert.Nil(t, err)
+	_, err = pool.Exec(ctx, snapshotStore.Migration())
+	assert.Nil(t, err)
+
+	// the second event takes a snapshot, the third one is replayed
+	for version := 1; version <= 3; version++ {
+		aggregate := &testAggregate{}
+		aggregate.SetAggregateID(aggregateID)
+
+		event := &testedEvent{}
+		event.SetAggregateID(aggregateID)
+		event.SetVersion(version)
+		aggregate.Apply(event)
+		aggregate.AppendChanges(event)
+
+		err = repository.Save(ctx, aggregate)
+		assert.Nil(t, err)
+	}
+
+	// events covered by the snapshot are no longer replayed
+	_, err = pool.Exec(ctx, "DELETE FROM "+(&testAggregate{}).EventTable()+" WHERE version <= 2")
+	assert.Nil(t, err)
+
+	loadedAgg, err := repository.Load(ctx, aggregateID)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, loadedAgg.GetVersion())
+}
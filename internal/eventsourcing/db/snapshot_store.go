@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/samwang0723/jarvis/internal/eventsourcing"
+)
+
+const getLatestSnapshot = `
+SELECT version,
+       payload
+FROM %s
+WHERE aggregate_id = $1
+`
+
+const upsertSnapshot = `
+INSERT INTO %s (aggregate_id, version, payload, created_at) VALUES ($1, $2, $3, $4)
+ON CONFLICT (aggregate_id) DO UPDATE
+SET version = EXCLUDED.version,
+  payload = EXCLUDED.payload,
+  created_at = EXCLUDED.created_at
+WHERE %s.version < EXCLUDED.version
+`
+
+const createSnapshotTable = `
+CREATE TABLE %s (
+  aggregate_id uuid NOT NULL,
+  version int NOT NULL,
+  payload jsonb NOT NULL,
+  created_at timestamp without time zone NOT NULL,
+  PRIMARY KEY (aggregate_id)
+);
+`
+
+// SnapshotTable names the snapshots table of an event table, balance_events
+// keeps its snapshots in balance_snapshots.
+func SnapshotTable(eventTable string) string {
+	return strings.TrimSuffix(eventTable, "_events") + "_snapshots"
+}
+
+// SnapshotStore keeps the latest state of each aggregate so loading only
+// replays the events appended after it.
+type SnapshotStore struct {
+	snapshotTable string
+	dbPool        *pgxpool.Pool
+}
+
+func NewSnapshotStore(eventTable string, dbPool *pgxpool.Pool) *SnapshotStore {
+	store := &SnapshotStore{}
+	store.snapshotTable = SnapshotTable(eventTable)
+	store.dbPool = dbPool
+
+	return store
+}
+
+// Load restores the latest snapshot of the aggregate into aggregate, false
+// if the aggregate has none.
+func (ss *SnapshotStore) Load(
+	ctx context.Context, aggregate eventsourcing.Aggregate,
+) (bool, error) {
+	found := false
+	err := Transaction(ctx, ss.dbPool, func(ctx context.Context, tx pgx.Tx) error {
+		var version int
+		var payload string
+
+		sql := fmt.Sprintf(getLatestSnapshot, ss.snapshotTable)
+		err := tx.QueryRow(ctx, sql, aggregate.GetAggregateID()).Scan(&version, &payload)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("load snapshot failed: %w", err)
+		}
+
+		aggregateID := aggregate.GetAggregateID()
+		json := jsoniter.ConfigCompatibleWithStandardLibrary
+		if err := json.UnmarshalFromString(payload, aggregate); err != nil {
+			return &SnapshotUnmarshalError{err: err, aggregateID: aggregateID}
+		}
+
+		aggregate.SetAggregateID(aggregateID)
+		aggregate.SetVersion(version)
+		found = true
+
+		return nil
+	})
+
+	return found, err
+}
+
+// Save records the current state of the aggregate unless a newer snapshot
+// was taken.
+func (ss *SnapshotStore) Save(ctx context.Context, aggregate eventsourcing.Aggregate) error {
+	json := jsoniter.ConfigCompatibleWithStandardLibrary
+	payload, err := json.MarshalToString(aggregate)
+	if err != nil {
+		return &SnapshotMarshalError{err: err, aggregateID: aggregate.GetAggregateID()}
+	}
+
+	return Transaction(ctx, ss.dbPool, func(ctx context.Context, tx pgx.Tx) error {
+		sql := fmt.Sprintf(upsertSnapshot, ss.snapshotTable, ss.snapshotTable)
+		_, err := tx.Exec(ctx, sql,
+			aggregate.GetAggregateID(),
+			aggregate.GetVersion(),
+			payload,
+			time.Now().UTC())
+		if err != nil {
+			return fmt.Errorf("save snapshot failed: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// Migration returns a sql for creating the snapshot table.
+func (ss *SnapshotStore) Migration() string {
+	return fmt.Sprintf(createSnapshotTable, ss.snapshotTable)
+}
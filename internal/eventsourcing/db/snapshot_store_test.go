@@ -0,0 +1,30 @@
+package db_test
+
+import (
+	"testing"
+
+	"github.com/samwang0723/jarvis/internal/eventsourcing/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotTable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		eventTable string
+		want       string
+	}{
+		{eventTable: "balance_events", want: "balance_snapshots"},
+		{eventTable: "order_events", want: "order_snapshots"},
+		{eventTable: "test_event", want: "test_event_snapshots"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.eventTable, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, db.SnapshotTable(tt.eventTable))
+		})
+	}
+}
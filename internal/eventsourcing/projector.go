@@ -5,3 +5,11 @@ import "context"
 type Projector interface {
 	Handle(ctx context.Context, event Event) error
 }
+
+// ResettableProjector clears its read model before the projection is rebuilt
+// from the first event.
+type ResettableProjector interface {
+	Projector
+	// Reset removes everything projected so far.
+	Reset(ctx context.Context) error
+}
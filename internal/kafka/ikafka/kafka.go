@@ -22,6 +22,12 @@ const (
 	StocksV1             = "stocks-v1"
 	ThreePrimaryV1       = "threeprimary-v1"
 	StakeConcentrationV1 = "stakeconcentration-v1"
+	CorporateActionsV1   = "corporateactions-v1"
+
+	// domain events published through the outbox, keyed by aggregate id
+	OrderEventsV1       = "orderevents-v1"
+	TransactionEventsV1 = "transactionevents-v1"
+	BalanceEventsV1     = "balanceevents-v1"
 )
 
 type IKafka interface {
@@ -33,3 +39,17 @@ type ReceivedMessage struct {
 	Topic   string
 	Message []byte
 }
+
+type IProducer interface {
+	WriteMessages(ctx context.Context, msgs ...Message) error
+	Close() error
+}
+
+// Message is written to the partition of its key, so the messages sharing a
+// key keep their order.
+type Message struct {
+	Headers map[string]string
+	Topic   string
+	Key     []byte
+	Message []byte
+}
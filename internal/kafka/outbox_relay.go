@@ -0,0 +1,115 @@
+package kafka
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog"
+	esdb "github.com/samwang0723/jarvis/internal/eventsourcing/db"
+	"github.com/samwang0723/jarvis/internal/kafka/ikafka"
+)
+
+const (
+	defaultRelayInterval  = time.Second
+	defaultRelayBatchSize = 100
+)
+
+// OutboxSource hands the outbox messages to publish, removing them once the
+// publish succeeds.
+type OutboxSource interface {
+	RelayOutbox(
+		ctx context.Context,
+		limit int,
+		publish func(ctx context.Context, messages []*esdb.OutboxMessage) error,
+	) (int, error)
+}
+
+// OutboxRelayConfig encapsulates the settings for configuring the relay.
+type OutboxRelayConfig struct {
+	Logger *zerolog.Logger
+
+	// Interval between the polls of an empty outbox.
+	Interval  time.Duration
+	BatchSize int
+}
+
+// OutboxRelay publishes the outbox to Kafka at least once. The messages are
+// keyed by aggregate id so each aggregate keeps its order.
+type OutboxRelay struct {
+	source   OutboxSource
+	producer ikafka.IProducer
+	cfg      OutboxRelayConfig
+}
+
+func NewOutboxRelay(cfg OutboxRelayConfig, source OutboxSource, producer ikafka.IProducer) *OutboxRelay {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultRelayInterval
+	}
+
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultRelayBatchSize
+	}
+
+	return &OutboxRelay{
+		source:   source,
+		producer: producer,
+		cfg:      cfg,
+	}
+}
+
+// Run relays until the context is cancelled, draining the outbox on each
+// tick.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	r.cfg.Logger.Info().Str("component", "outbox").Msg("relay starting")
+	defer r.cfg.Logger.Info().Str("component", "outbox").Msg("relay exited")
+
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				published, err := r.RelayOnce(ctx)
+				if err != nil {
+					if ctx.Err() == nil {
+						r.cfg.Logger.Error().Str("component", "outbox").Err(err).Msg("relay failed")
+					}
+
+					break
+				}
+
+				if published < r.cfg.BatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// RelayOnce publishes a batch of the outbox, returning its size.
+func (r *OutboxRelay) RelayOnce(ctx context.Context) (int, error) {
+	return r.source.RelayOutbox(ctx, r.cfg.BatchSize, r.publish)
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, messages []*esdb.OutboxMessage) error {
+	msgs := make([]ikafka.Message, 0, len(messages))
+	for _, message := range messages {
+		msgs = append(msgs, ikafka.Message{
+			Topic:   message.Topic,
+			Key:     []byte(message.AggregateID.String()),
+			Message: []byte(message.Payload),
+			Headers: map[string]string{
+				"event_type": message.EventType,
+				"version":    strconv.Itoa(message.Version),
+				"parent_id":  message.ParentID.String(),
+				"created_at": message.CreatedAt.UTC().Format(time.RFC3339Nano),
+			},
+		})
+	}
+
+	return r.producer.WriteMessages(ctx, msgs...)
+}
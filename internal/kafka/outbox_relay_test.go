@@ -0,0 +1,168 @@
+package kafka_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/rs/zerolog"
+	"github.com/samwang0723/jarvis/internal/common/remotetest"
+	esdb "github.com/samwang0723/jarvis/internal/eventsourcing/db"
+	k "github.com/samwang0723/jarvis/internal/kafka"
+	kafka_mock "github.com/samwang0723/jarvis/internal/kafka/mocks"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeOutbox removes the messages once they are published like the outbox
+// table does.
+type fakeOutbox struct {
+	messages []*esdb.OutboxMessage
+}
+
+func (fo *fakeOutbox) RelayOutbox(
+	ctx context.Context,
+	limit int,
+	publish func(ctx context.Context, messages []*esdb.OutboxMessage) error,
+) (int, error) {
+	batch := fo.messages[:min(limit, len(fo.messages))]
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	if err := publish(ctx, batch); err != nil {
+		return 0, err
+	}
+	fo.messages = fo.messages[len(batch):]
+
+	return len(batch), nil
+}
+
+func outboxMessages(topic string, aggregateIDs ...uuid.UUID) []*esdb.OutboxMessage {
+	messages := make([]*esdb.OutboxMessage, 0, len(aggregateIDs))
+	for idx, aggregateID := range aggregateIDs {
+		messages = append(messages, &esdb.OutboxMessage{
+			ID:          int64(idx + 1),
+			Topic:       topic,
+			AggregateID: aggregateID,
+			EventType:   "order_created",
+			Payload:     `{"StockID":"2330"}`,
+			Version:     idx + 1,
+			CreatedAt:   time.Now(),
+		})
+	}
+
+	return messages
+}
+
+func TestOutboxRelayOnce(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := zerolog.New(zerolog.Nop())
+	mockWriter := kafka_mock.NewMockWriter(ctrl)
+	producer := k.NewProducer(k.ProducerConfig{Logger: &logger}, mockWriter)
+
+	aggregateID := uuid.Must(uuid.NewV4())
+	source := &fakeOutbox{messages: outboxMessages("orderevents-v1", aggregateID, aggregateID, aggregateID)}
+	relay := k.NewOutboxRelay(k.OutboxRelayConfig{Logger: &logger, BatchSize: 2}, source, producer)
+
+	var written []kafka.Message
+	mockWriter.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, msgs ...kafka.Message) error {
+			written = append(written, msgs...)
+
+			return nil
+		}).Times(2)
+
+	published, err := relay.RelayOnce(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, published)
+
+	published, err = relay.RelayOnce(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, published)
+	assert.Empty(t, source.messages)
+
+	assert.Len(t, written, 3)
+	for idx, msg := range written {
+		assert.Equal(t, "orderevents-v1", msg.Topic)
+		assert.Equal(t, aggregateID.String(), string(msg.Key))
+
+		headers := make(map[string]string, len(msg.Headers))
+		for _, header := range msg.Headers {
+			headers[header.Key] = string(header.Value)
+		}
+		assert.Equal(t, "order_created", headers["event_type"])
+		assert.Equal(t, strconv.Itoa(idx+1), headers["version"])
+	}
+}
+
+func TestOutboxRelayWriteFailed(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := zerolog.New(zerolog.Nop())
+	mockWriter := kafka_mock.NewMockWriter(ctrl)
+	producer := k.NewProducer(k.ProducerConfig{Logger: &logger}, mockWriter)
+
+	source := &fakeOutbox{messages: outboxMessages("orderevents-v1", uuid.Must(uuid.NewV4()))}
+	relay := k.NewOutboxRelay(k.OutboxRelayConfig{Logger: &logger}, source, producer)
+
+	mockWriter.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).Return(errors.New("leader not available"))
+
+	_, err := relay.RelayOnce(context.Background())
+	assert.Error(t, err)
+	// the messages stay in the outbox for the next relay
+	assert.Len(t, source.messages, 1)
+}
+
+func TestOutboxRelayKafka(t *testing.T) {
+	t.Parallel()
+
+	kc, err := remotetest.CreateKafkaContainer()
+	if err != nil {
+		t.Fatalf("create kafka error: %s", err)
+	}
+
+	topic := "outbox-" + uuid.Must(uuid.NewV4()).String()
+	if err = kc.CreateTopic(topic); err != nil {
+		t.Fatalf("create topic error: %s", err)
+	}
+	defer kc.Purge() //nolint:errcheck
+
+	logger := zerolog.New(zerolog.Nop())
+	producer := k.NewProducer(k.ProducerConfig{Logger: &logger, Brokers: []string{kc.Address}}, nil)
+	defer producer.Close()
+
+	first, second := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+	source := &fakeOutbox{messages: outboxMessages(topic, first, second, first)}
+	relay := k.NewOutboxRelay(k.OutboxRelayConfig{Logger: &logger}, source, producer)
+
+	published, err := relay.RelayOnce(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, published)
+
+	reader := kafka.NewReader(kafka.ReaderConfig{Brokers: []string{kc.Address}, Topic: topic})
+	defer reader.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, want := range []uuid.UUID{first, second, first} {
+		msg, err := reader.ReadMessage(ctx)
+		if err != nil {
+			t.Fatalf("read message error: %s", err)
+		}
+
+		assert.Equal(t, want.String(), string(msg.Key))
+	}
+}
@@ -0,0 +1,83 @@
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samwang0723/jarvis/internal/kafka/ikafka"
+	"github.com/segmentio/kafka-go"
+)
+
+// ProducerConfig encapsulates the settings for configuring the producer.
+type ProducerConfig struct {
+	Logger *zerolog.Logger
+
+	Brokers []string
+}
+
+type producerImpl struct {
+	instance Writer
+	cfg      ProducerConfig
+}
+
+const writeTimeout = 10 * time.Second
+
+//go:generate mockgen -source=producer.go -destination=mocks/producer.go -package=kafka
+type Writer interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// NewProducer writes synchronously and waits for every in-sync replica, the
+// messages are hashed to partitions by key.
+func NewProducer(cfg ProducerConfig, writer Writer) ikafka.IProducer {
+	if writer == nil {
+		writer = &kafka.Writer{
+			Addr:         kafka.TCP(cfg.Brokers...),
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			WriteTimeout: writeTimeout,
+		}
+	}
+
+	return &producerImpl{
+		instance: writer,
+		cfg:      cfg,
+	}
+}
+
+func (p *producerImpl) WriteMessages(ctx context.Context, msgs ...ikafka.Message) error {
+	messages := make([]kafka.Message, 0, len(msgs))
+	for _, msg := range msgs {
+		headers := make([]kafka.Header, 0, len(msg.Headers))
+		for key, value := range msg.Headers {
+			headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+		}
+
+		messages = append(messages, kafka.Message{
+			Topic:   msg.Topic,
+			Key:     msg.Key,
+			Value:   msg.Message,
+			Headers: headers,
+		})
+	}
+
+	err := p.instance.WriteMessages(ctx, messages...)
+	if err != nil {
+		p.cfg.Logger.Error().Str("component", "kafka").Err(err).Msgf("write %d messages failed", len(messages))
+	}
+
+	return err
+}
+
+func (p *producerImpl) Close() error {
+	p.cfg.Logger.Info().Str("component", "kafka").Msg("closing producer")
+
+	err := p.instance.Close()
+	if err != nil {
+		p.cfg.Logger.Error().Str("component", "kafka").Err(err).Msg("close producer failed")
+	}
+
+	return err
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 Wei (Sam) Wang <sam.wang.0723@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// Message is the content delivered for a triggered alert.
+type Message struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+//go:generate mockgen -source=notifier.go -destination=mocks/notifier.go -package=notifier
+type Notifier interface {
+	// Notify delivers the message to the target of the channel, e.g. a
+	// webhook URL or an email address.
+	Notify(ctx context.Context, target string, msg *Message) error
+}
+
+type webhookImpl struct {
+	client *http.Client
+}
+
+// NewWebhook posts the message as JSON to the target URL.
+func NewWebhook(client *http.Client) Notifier {
+	if client == nil {
+		client = &http.Client{Timeout: defaultWebhookTimeout}
+	}
+
+	return &webhookImpl{client: client}
+}
+
+func (w *webhookImpl) Notify(ctx context.Context, target string, msg *Message) error {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook notify failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook notify failed: status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// SMTPConfig encapsulates the settings of the outgoing mail server, the
+// credentials are optional for a relay trusting the host.
+type SMTPConfig struct {
+	Host     string
+	Username string
+	Password string
+	From     string
+	Port     int
+}
+
+type smtpImpl struct {
+	cfg SMTPConfig
+}
+
+// NewSMTP mails the message to the target address.
+func NewSMTP(cfg SMTPConfig) Notifier {
+	return &smtpImpl{cfg: cfg}
+}
+
+func (s *smtpImpl) Notify(_ context.Context, target string, msg *Message) error {
+	var auth smtp.Auth
+	if s.cfg.Username != "" {
+		auth = smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)
+	}
+
+	addr := s.cfg.Host + ":" + strconv.Itoa(s.cfg.Port)
+	if err := smtp.SendMail(addr, auth, s.cfg.From, []string{target}, s.mail(target, msg)); err != nil {
+		return fmt.Errorf("smtp notify failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *smtpImpl) mail(target string, msg *Message) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", s.cfg.From)
+	fmt.Fprintf(&b, "To: %s\r\n", target)
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	b.WriteString("\r\n")
+
+	return []byte(b.String())
+}
@@ -0,0 +1,157 @@
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"go.uber.org/goleak"
+)
+
+func TestMain(m *testing.M) {
+	leak := flag.Bool("leak", false, "use leak detector")
+
+	if *leak {
+		goleak.VerifyTestMain(m)
+
+		return
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestWebhookNotify(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "delivered", status: http.StatusNoContent},
+		{name: "rejected", status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			received := make(chan *Message, 1)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				msg := &Message{}
+				if err := json.NewDecoder(r.Body).Decode(msg); err == nil {
+					received <- msg
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			msg := &Message{Subject: "2330", Body: "price reached 1000"}
+			err := NewWebhook(srv.Client()).Notify(context.Background(), srv.URL, msg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expect error %v, got %v", tt.wantErr, err)
+			}
+
+			if got := <-received; *got != *msg {
+				t.Errorf("expect %+v, got %+v", msg, got)
+			}
+		})
+	}
+}
+
+func TestSMTPNotify(t *testing.T) {
+	t.Parallel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+
+	mails := make(chan string, 1)
+	go serveSMTP(lis, mails)
+
+	addr := lis.Addr().(*net.TCPAddr)
+	n := NewSMTP(SMTPConfig{
+		Host: "127.0.0.1",
+		Port: addr.Port,
+		From: "jarvis@localhost",
+	})
+
+	msg := &Message{Subject: "2330 alert", Body: "price reached 1000"}
+	if err = n.Notify(context.Background(), "trader@localhost", msg); err != nil {
+		t.Fatal(err)
+	}
+
+	mail := <-mails
+	for _, want := range []string{
+		"MAIL FROM:<jarvis@localhost>",
+		"RCPT TO:<trader@localhost>",
+		"Subject: 2330 alert",
+		"price reached 1000",
+	} {
+		if !strings.Contains(mail, want) {
+			t.Errorf("expect mail to contain %q, got:\n%s", want, mail)
+		}
+	}
+}
+
+// serveSMTP is a minimal stand-in server accepting a single mail.
+func serveSMTP(lis net.Listener, mails chan<- string) {
+	conn, err := lis.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	var transcript strings.Builder
+	reader := bufio.NewReader(conn)
+	reply := func(code int, text string) {
+		conn.Write([]byte(strconv.Itoa(code) + " " + text + "\r\n"))
+	}
+
+	reply(220, "localhost ready")
+
+	inData := false
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		transcript.WriteString(line)
+
+		if inData {
+			if line == ".\r\n" {
+				inData = false
+				reply(250, "queued")
+			}
+
+			continue
+		}
+
+		switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply(250, "localhost")
+		case strings.HasPrefix(cmd, "DATA"):
+			inData = true
+			reply(354, "end with .")
+		case strings.HasPrefix(cmd, "QUIT"):
+			reply(221, "bye")
+			mails <- transcript.String()
+
+			return
+		default:
+			reply(250, "ok")
+		}
+	}
+}